@@ -8,7 +8,9 @@ import (
 	fc "capnproto.org/go/capnp/v3/flowcontrol"
 	schemas "capnproto.org/go/capnp/v3/schemas"
 	server "capnproto.org/go/capnp/v3/server"
+	stream "capnproto.org/go/capnp/v3/std/capnp/stream"
 	context "context"
+	strconv "strconv"
 )
 
 type CapStore capnp.Client
@@ -27,7 +29,7 @@ func (c CapStore) Set(ctx context.Context, params func(CapStore_set_Params) erro
 		},
 	}
 	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 2}
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 2}
 		s.PlaceArgs = func(s capnp.Struct) error { return params(CapStore_set_Params(s)) }
 	}
 
@@ -56,6 +58,66 @@ func (c CapStore) Get(ctx context.Context, params func(CapStore_get_Params) erro
 
 }
 
+func (c CapStore) Delete(ctx context.Context, params func(CapStore_delete_Params) error) (CapStore_delete_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe2a50b868aef45c6,
+			MethodID:      2,
+			InterfaceName: "capstore.capnp:CapStore",
+			MethodName:    "delete",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(CapStore_delete_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return CapStore_delete_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c CapStore) List(ctx context.Context, params func(CapStore_list_Params) error) (CapStore_list_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe2a50b868aef45c6,
+			MethodID:      3,
+			InterfaceName: "capstore.capnp:CapStore",
+			MethodName:    "list",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(CapStore_list_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return CapStore_list_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c CapStore) Watch(ctx context.Context, params func(CapStore_watch_Params) error) (CapStore_watch_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe2a50b868aef45c6,
+			MethodID:      4,
+			InterfaceName: "capstore.capnp:CapStore",
+			MethodName:    "watch",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 2}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(CapStore_watch_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return CapStore_watch_Results_Future{Future: ans.Future()}, release
+
+}
+
 func (c CapStore) WaitStreaming() error {
 	return capnp.Client(c).WaitStreaming()
 }
@@ -132,6 +194,12 @@ type CapStore_Server interface {
 	Set(context.Context, CapStore_set) error
 
 	Get(context.Context, CapStore_get) error
+
+	Delete(context.Context, CapStore_delete) error
+
+	List(context.Context, CapStore_list) error
+
+	Watch(context.Context, CapStore_watch) error
 }
 
 // CapStore_NewServer creates a new Server from an implementation of CapStore_Server.
@@ -150,7 +218,7 @@ func CapStore_ServerToClient(s CapStore_Server) CapStore {
 // This can be used to create a more complicated Server.
 func CapStore_Methods(methods []server.Method, s CapStore_Server) []server.Method {
 	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 2)
+		methods = make([]server.Method, 0, 5)
 	}
 
 	methods = append(methods, server.Method{
@@ -177,6 +245,42 @@ func CapStore_Methods(methods []server.Method, s CapStore_Server) []server.Metho
 		},
 	})
 
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe2a50b868aef45c6,
+			MethodID:      2,
+			InterfaceName: "capstore.capnp:CapStore",
+			MethodName:    "delete",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Delete(ctx, CapStore_delete{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe2a50b868aef45c6,
+			MethodID:      3,
+			InterfaceName: "capstore.capnp:CapStore",
+			MethodName:    "list",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.List(ctx, CapStore_list{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe2a50b868aef45c6,
+			MethodID:      4,
+			InterfaceName: "capstore.capnp:CapStore",
+			MethodName:    "watch",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Watch(ctx, CapStore_watch{call})
+		},
+	})
+
 	return methods
 }
 
@@ -193,7 +297,7 @@ func (c CapStore_set) Args() CapStore_set_Params {
 
 // AllocResults allocates the results struct.
 func (c CapStore_set) AllocResults() (CapStore_set_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
 	return CapStore_set_Results(r), err
 }
 
@@ -214,383 +318,2068 @@ func (c CapStore_get) AllocResults() (CapStore_get_Results, error) {
 	return CapStore_get_Results(r), err
 }
 
-// CapStore_List is a list of CapStore.
-type CapStore_List = capnp.CapList[CapStore]
-
-// NewCapStore creates a new list of CapStore.
-func NewCapStore_List(s *capnp.Segment, sz int32) (CapStore_List, error) {
-	l, err := capnp.NewPointerList(s, sz)
-	return capnp.CapList[CapStore](l), err
-}
-
-type CapStore_set_Params capnp.Struct
-
-// CapStore_set_Params_TypeID is the unique identifier for the type CapStore_set_Params.
-const CapStore_set_Params_TypeID = 0x936bc1015cfb6c3c
-
-func NewCapStore_set_Params(s *capnp.Segment) (CapStore_set_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return CapStore_set_Params(st), err
+// CapStore_delete holds the state for a server call to CapStore.delete.
+// See server.Call for documentation.
+type CapStore_delete struct {
+	*server.Call
 }
 
-func NewRootCapStore_set_Params(s *capnp.Segment) (CapStore_set_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return CapStore_set_Params(st), err
+// Args returns the call's arguments.
+func (c CapStore_delete) Args() CapStore_delete_Params {
+	return CapStore_delete_Params(c.Call.Args())
 }
 
-func ReadRootCapStore_set_Params(msg *capnp.Message) (CapStore_set_Params, error) {
-	root, err := msg.Root()
-	return CapStore_set_Params(root.Struct()), err
+// AllocResults allocates the results struct.
+func (c CapStore_delete) AllocResults() (CapStore_delete_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapStore_delete_Results(r), err
 }
 
-func (s CapStore_set_Params) String() string {
-	str, _ := text.Marshal(0x936bc1015cfb6c3c, capnp.Struct(s))
-	return str
+// CapStore_list holds the state for a server call to CapStore.list.
+// See server.Call for documentation.
+type CapStore_list struct {
+	*server.Call
 }
 
-func (s CapStore_set_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+// Args returns the call's arguments.
+func (c CapStore_list) Args() CapStore_list_Params {
+	return CapStore_list_Params(c.Call.Args())
 }
 
-func (CapStore_set_Params) DecodeFromPtr(p capnp.Ptr) CapStore_set_Params {
-	return CapStore_set_Params(capnp.Struct{}.DecodeFromPtr(p))
+// AllocResults allocates the results struct.
+func (c CapStore_list) AllocResults() (CapStore_list_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapStore_list_Results(r), err
 }
 
-func (s CapStore_set_Params) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s CapStore_set_Params) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+// CapStore_watch holds the state for a server call to CapStore.watch.
+// See server.Call for documentation.
+type CapStore_watch struct {
+	*server.Call
 }
 
-func (s CapStore_set_Params) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+// Args returns the call's arguments.
+func (c CapStore_watch) Args() CapStore_watch_Params {
+	return CapStore_watch_Params(c.Call.Args())
 }
 
-func (s CapStore_set_Params) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s CapStore_set_Params) Id() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+// AllocResults allocates the results struct.
+func (c CapStore_watch) AllocResults() (CapStore_watch_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapStore_watch_Results(r), err
 }
 
-func (s CapStore_set_Params) HasId() bool {
-	return capnp.Struct(s).HasPtr(0)
-}
+// CapStore_List is a list of CapStore.
+type CapStore_List = capnp.CapList[CapStore]
 
-func (s CapStore_set_Params) IdBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+// NewCapStore creates a new list of CapStore.
+func NewCapStore_List(s *capnp.Segment, sz int32) (CapStore_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[CapStore](l), err
 }
 
-func (s CapStore_set_Params) SetId(v string) error {
-	return capnp.Struct(s).SetText(0, v)
-}
+type CapStore_Lease capnp.Client
 
-func (s CapStore_set_Params) Cap() capnp.Client {
-	p, _ := capnp.Struct(s).Ptr(1)
-	return p.Interface().Client()
-}
+// CapStore_Lease_TypeID is the unique identifier for the type CapStore_Lease.
+const CapStore_Lease_TypeID = 0x85a320e41ad34c9f
 
-func (s CapStore_set_Params) HasCap() bool {
-	return capnp.Struct(s).HasPtr(1)
-}
+func (c CapStore_Lease) Renew(ctx context.Context, params func(CapStore_Lease_renew_Params) error) (CapStore_Lease_renew_Results_Future, capnp.ReleaseFunc) {
 
-func (s CapStore_set_Params) SetCap(c capnp.Client) error {
-	if !c.IsValid() {
-		return capnp.Struct(s).SetPtr(1, capnp.Ptr{})
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x85a320e41ad34c9f,
+			MethodID:      0,
+			InterfaceName: "capstore.capnp:CapStore.Lease",
+			MethodName:    "renew",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(CapStore_Lease_renew_Params(s)) }
 	}
-	seg := s.Segment()
-	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(c))
-	return capnp.Struct(s).SetPtr(1, in.ToPtr())
-}
 
-// CapStore_set_Params_List is a list of CapStore_set_Params.
-type CapStore_set_Params_List = capnp.StructList[CapStore_set_Params]
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return CapStore_Lease_renew_Results_Future{Future: ans.Future()}, release
 
-// NewCapStore_set_Params creates a new list of CapStore_set_Params.
-func NewCapStore_set_Params_List(s *capnp.Segment, sz int32) (CapStore_set_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
-	return capnp.StructList[CapStore_set_Params](l), err
 }
 
-// CapStore_set_Params_Future is a wrapper for a CapStore_set_Params promised by a client call.
-type CapStore_set_Params_Future struct{ *capnp.Future }
+func (c CapStore_Lease) Cancel(ctx context.Context, params func(CapStore_Lease_cancel_Params) error) (CapStore_Lease_cancel_Results_Future, capnp.ReleaseFunc) {
 
-func (f CapStore_set_Params_Future) Struct() (CapStore_set_Params, error) {
-	p, err := f.Future.Ptr()
-	return CapStore_set_Params(p.Struct()), err
-}
-func (p CapStore_set_Params_Future) Cap() capnp.Client {
-	return p.Future.Field(1, nil).Client()
-}
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x85a320e41ad34c9f,
+			MethodID:      1,
+			InterfaceName: "capstore.capnp:CapStore.Lease",
+			MethodName:    "cancel",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(CapStore_Lease_cancel_Params(s)) }
+	}
 
-type CapStore_set_Results capnp.Struct
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return CapStore_Lease_cancel_Results_Future{Future: ans.Future()}, release
 
-// CapStore_set_Results_TypeID is the unique identifier for the type CapStore_set_Results.
-const CapStore_set_Results_TypeID = 0xbbb39de5aadd52fa
+}
 
-func NewCapStore_set_Results(s *capnp.Segment) (CapStore_set_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return CapStore_set_Results(st), err
+func (c CapStore_Lease) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
 }
 
-func NewRootCapStore_set_Results(s *capnp.Segment) (CapStore_set_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return CapStore_set_Results(st), err
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c CapStore_Lease) String() string {
+	return "CapStore_Lease(" + capnp.Client(c).String() + ")"
 }
 
-func ReadRootCapStore_set_Results(msg *capnp.Message) (CapStore_set_Results, error) {
-	root, err := msg.Root()
-	return CapStore_set_Results(root.Struct()), err
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c CapStore_Lease) AddRef() CapStore_Lease {
+	return CapStore_Lease(capnp.Client(c).AddRef())
 }
 
-func (s CapStore_set_Results) String() string {
-	str, _ := text.Marshal(0xbbb39de5aadd52fa, capnp.Struct(s))
-	return str
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c CapStore_Lease) Release() {
+	capnp.Client(c).Release()
 }
 
-func (s CapStore_set_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c CapStore_Lease) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
 }
 
-func (CapStore_set_Results) DecodeFromPtr(p capnp.Ptr) CapStore_set_Results {
-	return CapStore_set_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (c CapStore_Lease) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
 }
 
-func (s CapStore_set_Results) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+func (CapStore_Lease) DecodeFromPtr(p capnp.Ptr) CapStore_Lease {
+	return CapStore_Lease(capnp.Client{}.DecodeFromPtr(p))
 }
-func (s CapStore_set_Results) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c CapStore_Lease) IsValid() bool {
+	return capnp.Client(c).IsValid()
 }
 
-func (s CapStore_set_Results) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c CapStore_Lease) IsSame(other CapStore_Lease) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
 }
 
-func (s CapStore_set_Results) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c CapStore_Lease) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c CapStore_Lease) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A CapStore_Lease_Server is a CapStore_Lease with a local implementation.
+type CapStore_Lease_Server interface {
+	Renew(context.Context, CapStore_Lease_renew) error
+
+	Cancel(context.Context, CapStore_Lease_cancel) error
+}
+
+// CapStore_Lease_NewServer creates a new Server from an implementation of CapStore_Lease_Server.
+func CapStore_Lease_NewServer(s CapStore_Lease_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(CapStore_Lease_Methods(nil, s), s, c)
+}
+
+// CapStore_Lease_ServerToClient creates a new Client from an implementation of CapStore_Lease_Server.
+// The caller is responsible for calling Release on the returned Client.
+func CapStore_Lease_ServerToClient(s CapStore_Lease_Server) CapStore_Lease {
+	return CapStore_Lease(capnp.NewClient(CapStore_Lease_NewServer(s)))
+}
+
+// CapStore_Lease_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func CapStore_Lease_Methods(methods []server.Method, s CapStore_Lease_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 2)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x85a320e41ad34c9f,
+			MethodID:      0,
+			InterfaceName: "capstore.capnp:CapStore.Lease",
+			MethodName:    "renew",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Renew(ctx, CapStore_Lease_renew{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x85a320e41ad34c9f,
+			MethodID:      1,
+			InterfaceName: "capstore.capnp:CapStore.Lease",
+			MethodName:    "cancel",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Cancel(ctx, CapStore_Lease_cancel{call})
+		},
+	})
+
+	return methods
+}
+
+// CapStore_Lease_renew holds the state for a server call to CapStore_Lease.renew.
+// See server.Call for documentation.
+type CapStore_Lease_renew struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c CapStore_Lease_renew) Args() CapStore_Lease_renew_Params {
+	return CapStore_Lease_renew_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c CapStore_Lease_renew) AllocResults() (CapStore_Lease_renew_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapStore_Lease_renew_Results(r), err
+}
+
+// CapStore_Lease_cancel holds the state for a server call to CapStore_Lease.cancel.
+// See server.Call for documentation.
+type CapStore_Lease_cancel struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c CapStore_Lease_cancel) Args() CapStore_Lease_cancel_Params {
+	return CapStore_Lease_cancel_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c CapStore_Lease_cancel) AllocResults() (CapStore_Lease_cancel_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapStore_Lease_cancel_Results(r), err
+}
+
+// CapStore_Lease_List is a list of CapStore_Lease.
+type CapStore_Lease_List = capnp.CapList[CapStore_Lease]
+
+// NewCapStore_Lease creates a new list of CapStore_Lease.
+func NewCapStore_Lease_List(s *capnp.Segment, sz int32) (CapStore_Lease_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[CapStore_Lease](l), err
+}
+
+type CapStore_Lease_renew_Params capnp.Struct
+
+// CapStore_Lease_renew_Params_TypeID is the unique identifier for the type CapStore_Lease_renew_Params.
+const CapStore_Lease_renew_Params_TypeID = 0xfeaad97f7b4db370
+
+func NewCapStore_Lease_renew_Params(s *capnp.Segment) (CapStore_Lease_renew_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return CapStore_Lease_renew_Params(st), err
+}
+
+func NewRootCapStore_Lease_renew_Params(s *capnp.Segment) (CapStore_Lease_renew_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return CapStore_Lease_renew_Params(st), err
+}
+
+func ReadRootCapStore_Lease_renew_Params(msg *capnp.Message) (CapStore_Lease_renew_Params, error) {
+	root, err := msg.Root()
+	return CapStore_Lease_renew_Params(root.Struct()), err
+}
+
+func (s CapStore_Lease_renew_Params) String() string {
+	str, _ := text.Marshal(0xfeaad97f7b4db370, capnp.Struct(s))
+	return str
+}
+
+func (s CapStore_Lease_renew_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CapStore_Lease_renew_Params) DecodeFromPtr(p capnp.Ptr) CapStore_Lease_renew_Params {
+	return CapStore_Lease_renew_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CapStore_Lease_renew_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CapStore_Lease_renew_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CapStore_Lease_renew_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CapStore_Lease_renew_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s CapStore_Lease_renew_Params) Ttl() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s CapStore_Lease_renew_Params) SetTtl(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+// CapStore_Lease_renew_Params_List is a list of CapStore_Lease_renew_Params.
+type CapStore_Lease_renew_Params_List = capnp.StructList[CapStore_Lease_renew_Params]
+
+// NewCapStore_Lease_renew_Params creates a new list of CapStore_Lease_renew_Params.
+func NewCapStore_Lease_renew_Params_List(s *capnp.Segment, sz int32) (CapStore_Lease_renew_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[CapStore_Lease_renew_Params](l), err
+}
+
+// CapStore_Lease_renew_Params_Future is a wrapper for a CapStore_Lease_renew_Params promised by a client call.
+type CapStore_Lease_renew_Params_Future struct{ *capnp.Future }
+
+func (f CapStore_Lease_renew_Params_Future) Struct() (CapStore_Lease_renew_Params, error) {
+	p, err := f.Future.Ptr()
+	return CapStore_Lease_renew_Params(p.Struct()), err
+}
+
+type CapStore_Lease_renew_Results capnp.Struct
+
+// CapStore_Lease_renew_Results_TypeID is the unique identifier for the type CapStore_Lease_renew_Results.
+const CapStore_Lease_renew_Results_TypeID = 0xcbf13c916659de47
+
+func NewCapStore_Lease_renew_Results(s *capnp.Segment) (CapStore_Lease_renew_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapStore_Lease_renew_Results(st), err
+}
+
+func NewRootCapStore_Lease_renew_Results(s *capnp.Segment) (CapStore_Lease_renew_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapStore_Lease_renew_Results(st), err
+}
+
+func ReadRootCapStore_Lease_renew_Results(msg *capnp.Message) (CapStore_Lease_renew_Results, error) {
+	root, err := msg.Root()
+	return CapStore_Lease_renew_Results(root.Struct()), err
+}
+
+func (s CapStore_Lease_renew_Results) String() string {
+	str, _ := text.Marshal(0xcbf13c916659de47, capnp.Struct(s))
+	return str
+}
+
+func (s CapStore_Lease_renew_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CapStore_Lease_renew_Results) DecodeFromPtr(p capnp.Ptr) CapStore_Lease_renew_Results {
+	return CapStore_Lease_renew_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CapStore_Lease_renew_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CapStore_Lease_renew_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CapStore_Lease_renew_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CapStore_Lease_renew_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// CapStore_Lease_renew_Results_List is a list of CapStore_Lease_renew_Results.
+type CapStore_Lease_renew_Results_List = capnp.StructList[CapStore_Lease_renew_Results]
+
+// NewCapStore_Lease_renew_Results creates a new list of CapStore_Lease_renew_Results.
+func NewCapStore_Lease_renew_Results_List(s *capnp.Segment, sz int32) (CapStore_Lease_renew_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[CapStore_Lease_renew_Results](l), err
+}
+
+// CapStore_Lease_renew_Results_Future is a wrapper for a CapStore_Lease_renew_Results promised by a client call.
+type CapStore_Lease_renew_Results_Future struct{ *capnp.Future }
+
+func (f CapStore_Lease_renew_Results_Future) Struct() (CapStore_Lease_renew_Results, error) {
+	p, err := f.Future.Ptr()
+	return CapStore_Lease_renew_Results(p.Struct()), err
+}
+
+type CapStore_Lease_cancel_Params capnp.Struct
+
+// CapStore_Lease_cancel_Params_TypeID is the unique identifier for the type CapStore_Lease_cancel_Params.
+const CapStore_Lease_cancel_Params_TypeID = 0x873d25242d68ef4d
+
+func NewCapStore_Lease_cancel_Params(s *capnp.Segment) (CapStore_Lease_cancel_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapStore_Lease_cancel_Params(st), err
+}
+
+func NewRootCapStore_Lease_cancel_Params(s *capnp.Segment) (CapStore_Lease_cancel_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapStore_Lease_cancel_Params(st), err
+}
+
+func ReadRootCapStore_Lease_cancel_Params(msg *capnp.Message) (CapStore_Lease_cancel_Params, error) {
+	root, err := msg.Root()
+	return CapStore_Lease_cancel_Params(root.Struct()), err
+}
+
+func (s CapStore_Lease_cancel_Params) String() string {
+	str, _ := text.Marshal(0x873d25242d68ef4d, capnp.Struct(s))
+	return str
+}
+
+func (s CapStore_Lease_cancel_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CapStore_Lease_cancel_Params) DecodeFromPtr(p capnp.Ptr) CapStore_Lease_cancel_Params {
+	return CapStore_Lease_cancel_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CapStore_Lease_cancel_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CapStore_Lease_cancel_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CapStore_Lease_cancel_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CapStore_Lease_cancel_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// CapStore_Lease_cancel_Params_List is a list of CapStore_Lease_cancel_Params.
+type CapStore_Lease_cancel_Params_List = capnp.StructList[CapStore_Lease_cancel_Params]
+
+// NewCapStore_Lease_cancel_Params creates a new list of CapStore_Lease_cancel_Params.
+func NewCapStore_Lease_cancel_Params_List(s *capnp.Segment, sz int32) (CapStore_Lease_cancel_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[CapStore_Lease_cancel_Params](l), err
+}
+
+// CapStore_Lease_cancel_Params_Future is a wrapper for a CapStore_Lease_cancel_Params promised by a client call.
+type CapStore_Lease_cancel_Params_Future struct{ *capnp.Future }
+
+func (f CapStore_Lease_cancel_Params_Future) Struct() (CapStore_Lease_cancel_Params, error) {
+	p, err := f.Future.Ptr()
+	return CapStore_Lease_cancel_Params(p.Struct()), err
+}
+
+type CapStore_Lease_cancel_Results capnp.Struct
+
+// CapStore_Lease_cancel_Results_TypeID is the unique identifier for the type CapStore_Lease_cancel_Results.
+const CapStore_Lease_cancel_Results_TypeID = 0x847177a63fa06581
+
+func NewCapStore_Lease_cancel_Results(s *capnp.Segment) (CapStore_Lease_cancel_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapStore_Lease_cancel_Results(st), err
+}
+
+func NewRootCapStore_Lease_cancel_Results(s *capnp.Segment) (CapStore_Lease_cancel_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapStore_Lease_cancel_Results(st), err
+}
+
+func ReadRootCapStore_Lease_cancel_Results(msg *capnp.Message) (CapStore_Lease_cancel_Results, error) {
+	root, err := msg.Root()
+	return CapStore_Lease_cancel_Results(root.Struct()), err
+}
+
+func (s CapStore_Lease_cancel_Results) String() string {
+	str, _ := text.Marshal(0x847177a63fa06581, capnp.Struct(s))
+	return str
+}
+
+func (s CapStore_Lease_cancel_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CapStore_Lease_cancel_Results) DecodeFromPtr(p capnp.Ptr) CapStore_Lease_cancel_Results {
+	return CapStore_Lease_cancel_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CapStore_Lease_cancel_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CapStore_Lease_cancel_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CapStore_Lease_cancel_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CapStore_Lease_cancel_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// CapStore_Lease_cancel_Results_List is a list of CapStore_Lease_cancel_Results.
+type CapStore_Lease_cancel_Results_List = capnp.StructList[CapStore_Lease_cancel_Results]
+
+// NewCapStore_Lease_cancel_Results creates a new list of CapStore_Lease_cancel_Results.
+func NewCapStore_Lease_cancel_Results_List(s *capnp.Segment, sz int32) (CapStore_Lease_cancel_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[CapStore_Lease_cancel_Results](l), err
+}
+
+// CapStore_Lease_cancel_Results_Future is a wrapper for a CapStore_Lease_cancel_Results promised by a client call.
+type CapStore_Lease_cancel_Results_Future struct{ *capnp.Future }
+
+func (f CapStore_Lease_cancel_Results_Future) Struct() (CapStore_Lease_cancel_Results, error) {
+	p, err := f.Future.Ptr()
+	return CapStore_Lease_cancel_Results(p.Struct()), err
+}
+
+type CapStore_Handler capnp.Client
+
+// CapStore_Handler_TypeID is the unique identifier for the type CapStore_Handler.
+const CapStore_Handler_TypeID = 0xd178668b58424298
+
+func (c CapStore_Handler) Recv(ctx context.Context, params func(CapStore_Handler_recv_Params) error) error {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xd178668b58424298,
+			MethodID:      0,
+			InterfaceName: "capstore.capnp:CapStore.Handler",
+			MethodName:    "recv",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(CapStore_Handler_recv_Params(s)) }
+	}
+
+	return capnp.Client(c).SendStreamCall(ctx, s)
+
+}
+
+func (c CapStore_Handler) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c CapStore_Handler) String() string {
+	return "CapStore_Handler(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c CapStore_Handler) AddRef() CapStore_Handler {
+	return CapStore_Handler(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c CapStore_Handler) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c CapStore_Handler) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c CapStore_Handler) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (CapStore_Handler) DecodeFromPtr(p capnp.Ptr) CapStore_Handler {
+	return CapStore_Handler(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c CapStore_Handler) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c CapStore_Handler) IsSame(other CapStore_Handler) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c CapStore_Handler) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c CapStore_Handler) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A CapStore_Handler_Server is a CapStore_Handler with a local implementation.
+type CapStore_Handler_Server interface {
+	Recv(context.Context, CapStore_Handler_recv) error
+}
+
+// CapStore_Handler_NewServer creates a new Server from an implementation of CapStore_Handler_Server.
+func CapStore_Handler_NewServer(s CapStore_Handler_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(CapStore_Handler_Methods(nil, s), s, c)
+}
+
+// CapStore_Handler_ServerToClient creates a new Client from an implementation of CapStore_Handler_Server.
+// The caller is responsible for calling Release on the returned Client.
+func CapStore_Handler_ServerToClient(s CapStore_Handler_Server) CapStore_Handler {
+	return CapStore_Handler(capnp.NewClient(CapStore_Handler_NewServer(s)))
+}
+
+// CapStore_Handler_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func CapStore_Handler_Methods(methods []server.Method, s CapStore_Handler_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 1)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xd178668b58424298,
+			MethodID:      0,
+			InterfaceName: "capstore.capnp:CapStore.Handler",
+			MethodName:    "recv",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Recv(ctx, CapStore_Handler_recv{call})
+		},
+	})
+
+	return methods
+}
+
+// CapStore_Handler_recv holds the state for a server call to CapStore_Handler.recv.
+// See server.Call for documentation.
+type CapStore_Handler_recv struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c CapStore_Handler_recv) Args() CapStore_Handler_recv_Params {
+	return CapStore_Handler_recv_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c CapStore_Handler_recv) AllocResults() (stream.StreamResult, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return stream.StreamResult(r), err
+}
+
+// CapStore_Handler_List is a list of CapStore_Handler.
+type CapStore_Handler_List = capnp.CapList[CapStore_Handler]
+
+// NewCapStore_Handler creates a new list of CapStore_Handler.
+func NewCapStore_Handler_List(s *capnp.Segment, sz int32) (CapStore_Handler_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[CapStore_Handler](l), err
+}
+
+type CapStore_Handler_recv_Params capnp.Struct
+
+// CapStore_Handler_recv_Params_TypeID is the unique identifier for the type CapStore_Handler_recv_Params.
+const CapStore_Handler_recv_Params_TypeID = 0xf39959c3defd9b61
+
+func NewCapStore_Handler_recv_Params(s *capnp.Segment) (CapStore_Handler_recv_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapStore_Handler_recv_Params(st), err
+}
+
+func NewRootCapStore_Handler_recv_Params(s *capnp.Segment) (CapStore_Handler_recv_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapStore_Handler_recv_Params(st), err
+}
+
+func ReadRootCapStore_Handler_recv_Params(msg *capnp.Message) (CapStore_Handler_recv_Params, error) {
+	root, err := msg.Root()
+	return CapStore_Handler_recv_Params(root.Struct()), err
+}
+
+func (s CapStore_Handler_recv_Params) String() string {
+	str, _ := text.Marshal(0xf39959c3defd9b61, capnp.Struct(s))
+	return str
+}
+
+func (s CapStore_Handler_recv_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CapStore_Handler_recv_Params) DecodeFromPtr(p capnp.Ptr) CapStore_Handler_recv_Params {
+	return CapStore_Handler_recv_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CapStore_Handler_recv_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CapStore_Handler_recv_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CapStore_Handler_recv_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CapStore_Handler_recv_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s CapStore_Handler_recv_Params) Event() (CapStore_Event, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return CapStore_Event(p.Struct()), err
+}
+
+func (s CapStore_Handler_recv_Params) HasEvent() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s CapStore_Handler_recv_Params) SetEvent(v CapStore_Event) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewEvent sets the event field to a newly
+// allocated CapStore_Event struct, preferring placement in s's segment.
+func (s CapStore_Handler_recv_Params) NewEvent() (CapStore_Event, error) {
+	ss, err := NewCapStore_Event(capnp.Struct(s).Segment())
+	if err != nil {
+		return CapStore_Event{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// CapStore_Handler_recv_Params_List is a list of CapStore_Handler_recv_Params.
+type CapStore_Handler_recv_Params_List = capnp.StructList[CapStore_Handler_recv_Params]
+
+// NewCapStore_Handler_recv_Params creates a new list of CapStore_Handler_recv_Params.
+func NewCapStore_Handler_recv_Params_List(s *capnp.Segment, sz int32) (CapStore_Handler_recv_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[CapStore_Handler_recv_Params](l), err
+}
+
+// CapStore_Handler_recv_Params_Future is a wrapper for a CapStore_Handler_recv_Params promised by a client call.
+type CapStore_Handler_recv_Params_Future struct{ *capnp.Future }
+
+func (f CapStore_Handler_recv_Params_Future) Struct() (CapStore_Handler_recv_Params, error) {
+	p, err := f.Future.Ptr()
+	return CapStore_Handler_recv_Params(p.Struct()), err
+}
+func (p CapStore_Handler_recv_Params_Future) Event() CapStore_Event_Future {
+	return CapStore_Event_Future{Future: p.Future.Field(0, nil)}
+}
+
+type CapStore_Event capnp.Struct
+type CapStore_Event_Which uint16
+
+const (
+	CapStore_Event_Which_set    CapStore_Event_Which = 0
+	CapStore_Event_Which_delete CapStore_Event_Which = 1
+	CapStore_Event_Which_expire CapStore_Event_Which = 2
+)
+
+func (w CapStore_Event_Which) String() string {
+	const s = "setdeleteexpire"
+	switch w {
+	case CapStore_Event_Which_set:
+		return s[0:3]
+	case CapStore_Event_Which_delete:
+		return s[3:9]
+	case CapStore_Event_Which_expire:
+		return s[9:15]
+
+	}
+	return "CapStore_Event_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+}
+
+// CapStore_Event_TypeID is the unique identifier for the type CapStore_Event.
+const CapStore_Event_TypeID = 0xf30d1f753e1f0ea9
+
+func NewCapStore_Event(s *capnp.Segment) (CapStore_Event, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return CapStore_Event(st), err
+}
+
+func NewRootCapStore_Event(s *capnp.Segment) (CapStore_Event, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return CapStore_Event(st), err
+}
+
+func ReadRootCapStore_Event(msg *capnp.Message) (CapStore_Event, error) {
+	root, err := msg.Root()
+	return CapStore_Event(root.Struct()), err
+}
+
+func (s CapStore_Event) String() string {
+	str, _ := text.Marshal(0xf30d1f753e1f0ea9, capnp.Struct(s))
+	return str
+}
+
+func (s CapStore_Event) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CapStore_Event) DecodeFromPtr(p capnp.Ptr) CapStore_Event {
+	return CapStore_Event(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CapStore_Event) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+
+func (s CapStore_Event) Which() CapStore_Event_Which {
+	return CapStore_Event_Which(capnp.Struct(s).Uint16(0))
+}
+func (s CapStore_Event) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CapStore_Event) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CapStore_Event) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s CapStore_Event) Id() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s CapStore_Event) HasId() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s CapStore_Event) IdBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s CapStore_Event) SetId(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s CapStore_Event) SetSet() {
+	capnp.Struct(s).SetUint16(0, 0)
+
+}
+
+func (s CapStore_Event) SetDelete() {
+	capnp.Struct(s).SetUint16(0, 1)
+
+}
+
+func (s CapStore_Event) SetExpire() {
+	capnp.Struct(s).SetUint16(0, 2)
+
+}
+
+// CapStore_Event_List is a list of CapStore_Event.
+type CapStore_Event_List = capnp.StructList[CapStore_Event]
+
+// NewCapStore_Event creates a new list of CapStore_Event.
+func NewCapStore_Event_List(s *capnp.Segment, sz int32) (CapStore_Event_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[CapStore_Event](l), err
+}
+
+// CapStore_Event_Future is a wrapper for a CapStore_Event promised by a client call.
+type CapStore_Event_Future struct{ *capnp.Future }
+
+func (f CapStore_Event_Future) Struct() (CapStore_Event, error) {
+	p, err := f.Future.Ptr()
+	return CapStore_Event(p.Struct()), err
+}
+
+type CapStore_set_Params capnp.Struct
+
+// CapStore_set_Params_TypeID is the unique identifier for the type CapStore_set_Params.
+const CapStore_set_Params_TypeID = 0x936bc1015cfb6c3c
+
+func NewCapStore_set_Params(s *capnp.Segment) (CapStore_set_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return CapStore_set_Params(st), err
+}
+
+func NewRootCapStore_set_Params(s *capnp.Segment) (CapStore_set_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return CapStore_set_Params(st), err
+}
+
+func ReadRootCapStore_set_Params(msg *capnp.Message) (CapStore_set_Params, error) {
+	root, err := msg.Root()
+	return CapStore_set_Params(root.Struct()), err
+}
+
+func (s CapStore_set_Params) String() string {
+	str, _ := text.Marshal(0x936bc1015cfb6c3c, capnp.Struct(s))
+	return str
+}
+
+func (s CapStore_set_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CapStore_set_Params) DecodeFromPtr(p capnp.Ptr) CapStore_set_Params {
+	return CapStore_set_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CapStore_set_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CapStore_set_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CapStore_set_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CapStore_set_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s CapStore_set_Params) Id() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s CapStore_set_Params) HasId() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s CapStore_set_Params) IdBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s CapStore_set_Params) SetId(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s CapStore_set_Params) Cap() capnp.Client {
+	p, _ := capnp.Struct(s).Ptr(1)
+	return p.Interface().Client()
+}
+
+func (s CapStore_set_Params) HasCap() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s CapStore_set_Params) SetCap(c capnp.Client) error {
+	if !c.IsValid() {
+		return capnp.Struct(s).SetPtr(1, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(c))
+	return capnp.Struct(s).SetPtr(1, in.ToPtr())
+}
+func (s CapStore_set_Params) Ttl() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s CapStore_set_Params) SetTtl(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+// CapStore_set_Params_List is a list of CapStore_set_Params.
+type CapStore_set_Params_List = capnp.StructList[CapStore_set_Params]
+
+// NewCapStore_set_Params creates a new list of CapStore_set_Params.
+func NewCapStore_set_Params_List(s *capnp.Segment, sz int32) (CapStore_set_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[CapStore_set_Params](l), err
+}
+
+// CapStore_set_Params_Future is a wrapper for a CapStore_set_Params promised by a client call.
+type CapStore_set_Params_Future struct{ *capnp.Future }
+
+func (f CapStore_set_Params_Future) Struct() (CapStore_set_Params, error) {
+	p, err := f.Future.Ptr()
+	return CapStore_set_Params(p.Struct()), err
+}
+func (p CapStore_set_Params_Future) Cap() capnp.Client {
+	return p.Future.Field(1, nil).Client()
+}
+
+type CapStore_set_Results capnp.Struct
+
+// CapStore_set_Results_TypeID is the unique identifier for the type CapStore_set_Results.
+const CapStore_set_Results_TypeID = 0xbbb39de5aadd52fa
+
+func NewCapStore_set_Results(s *capnp.Segment) (CapStore_set_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapStore_set_Results(st), err
+}
+
+func NewRootCapStore_set_Results(s *capnp.Segment) (CapStore_set_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapStore_set_Results(st), err
+}
+
+func ReadRootCapStore_set_Results(msg *capnp.Message) (CapStore_set_Results, error) {
+	root, err := msg.Root()
+	return CapStore_set_Results(root.Struct()), err
+}
+
+func (s CapStore_set_Results) String() string {
+	str, _ := text.Marshal(0xbbb39de5aadd52fa, capnp.Struct(s))
+	return str
+}
+
+func (s CapStore_set_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CapStore_set_Results) DecodeFromPtr(p capnp.Ptr) CapStore_set_Results {
+	return CapStore_set_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CapStore_set_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CapStore_set_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CapStore_set_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CapStore_set_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s CapStore_set_Results) Lease() CapStore_Lease {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return CapStore_Lease(p.Interface().Client())
+}
+
+func (s CapStore_set_Results) HasLease() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s CapStore_set_Results) SetLease(v CapStore_Lease) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
 }
 
 // CapStore_set_Results_List is a list of CapStore_set_Results.
 type CapStore_set_Results_List = capnp.StructList[CapStore_set_Results]
 
-// NewCapStore_set_Results creates a new list of CapStore_set_Results.
-func NewCapStore_set_Results_List(s *capnp.Segment, sz int32) (CapStore_set_Results_List, error) {
+// NewCapStore_set_Results creates a new list of CapStore_set_Results.
+func NewCapStore_set_Results_List(s *capnp.Segment, sz int32) (CapStore_set_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[CapStore_set_Results](l), err
+}
+
+// CapStore_set_Results_Future is a wrapper for a CapStore_set_Results promised by a client call.
+type CapStore_set_Results_Future struct{ *capnp.Future }
+
+func (f CapStore_set_Results_Future) Struct() (CapStore_set_Results, error) {
+	p, err := f.Future.Ptr()
+	return CapStore_set_Results(p.Struct()), err
+}
+func (p CapStore_set_Results_Future) Lease() CapStore_Lease {
+	return CapStore_Lease(p.Future.Field(0, nil).Client())
+}
+
+type CapStore_get_Params capnp.Struct
+
+// CapStore_get_Params_TypeID is the unique identifier for the type CapStore_get_Params.
+const CapStore_get_Params_TypeID = 0x84593aa9eaf7e35f
+
+func NewCapStore_get_Params(s *capnp.Segment) (CapStore_get_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapStore_get_Params(st), err
+}
+
+func NewRootCapStore_get_Params(s *capnp.Segment) (CapStore_get_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapStore_get_Params(st), err
+}
+
+func ReadRootCapStore_get_Params(msg *capnp.Message) (CapStore_get_Params, error) {
+	root, err := msg.Root()
+	return CapStore_get_Params(root.Struct()), err
+}
+
+func (s CapStore_get_Params) String() string {
+	str, _ := text.Marshal(0x84593aa9eaf7e35f, capnp.Struct(s))
+	return str
+}
+
+func (s CapStore_get_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CapStore_get_Params) DecodeFromPtr(p capnp.Ptr) CapStore_get_Params {
+	return CapStore_get_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CapStore_get_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CapStore_get_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CapStore_get_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CapStore_get_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s CapStore_get_Params) Id() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s CapStore_get_Params) HasId() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s CapStore_get_Params) IdBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s CapStore_get_Params) SetId(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+// CapStore_get_Params_List is a list of CapStore_get_Params.
+type CapStore_get_Params_List = capnp.StructList[CapStore_get_Params]
+
+// NewCapStore_get_Params creates a new list of CapStore_get_Params.
+func NewCapStore_get_Params_List(s *capnp.Segment, sz int32) (CapStore_get_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[CapStore_get_Params](l), err
+}
+
+// CapStore_get_Params_Future is a wrapper for a CapStore_get_Params promised by a client call.
+type CapStore_get_Params_Future struct{ *capnp.Future }
+
+func (f CapStore_get_Params_Future) Struct() (CapStore_get_Params, error) {
+	p, err := f.Future.Ptr()
+	return CapStore_get_Params(p.Struct()), err
+}
+
+type CapStore_get_Results capnp.Struct
+
+// CapStore_get_Results_TypeID is the unique identifier for the type CapStore_get_Results.
+const CapStore_get_Results_TypeID = 0xd18d94ef56d454f3
+
+func NewCapStore_get_Results(s *capnp.Segment) (CapStore_get_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapStore_get_Results(st), err
+}
+
+func NewRootCapStore_get_Results(s *capnp.Segment) (CapStore_get_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapStore_get_Results(st), err
+}
+
+func ReadRootCapStore_get_Results(msg *capnp.Message) (CapStore_get_Results, error) {
+	root, err := msg.Root()
+	return CapStore_get_Results(root.Struct()), err
+}
+
+func (s CapStore_get_Results) String() string {
+	str, _ := text.Marshal(0xd18d94ef56d454f3, capnp.Struct(s))
+	return str
+}
+
+func (s CapStore_get_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CapStore_get_Results) DecodeFromPtr(p capnp.Ptr) CapStore_get_Results {
+	return CapStore_get_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CapStore_get_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CapStore_get_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CapStore_get_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CapStore_get_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s CapStore_get_Results) Cap() capnp.Client {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return p.Interface().Client()
+}
+
+func (s CapStore_get_Results) HasCap() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s CapStore_get_Results) SetCap(c capnp.Client) error {
+	if !c.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(c))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// CapStore_get_Results_List is a list of CapStore_get_Results.
+type CapStore_get_Results_List = capnp.StructList[CapStore_get_Results]
+
+// NewCapStore_get_Results creates a new list of CapStore_get_Results.
+func NewCapStore_get_Results_List(s *capnp.Segment, sz int32) (CapStore_get_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[CapStore_get_Results](l), err
+}
+
+// CapStore_get_Results_Future is a wrapper for a CapStore_get_Results promised by a client call.
+type CapStore_get_Results_Future struct{ *capnp.Future }
+
+func (f CapStore_get_Results_Future) Struct() (CapStore_get_Results, error) {
+	p, err := f.Future.Ptr()
+	return CapStore_get_Results(p.Struct()), err
+}
+func (p CapStore_get_Results_Future) Cap() capnp.Client {
+	return p.Future.Field(0, nil).Client()
+}
+
+type CapStore_delete_Params capnp.Struct
+
+// CapStore_delete_Params_TypeID is the unique identifier for the type CapStore_delete_Params.
+const CapStore_delete_Params_TypeID = 0xd6052bf9089e6f88
+
+func NewCapStore_delete_Params(s *capnp.Segment) (CapStore_delete_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapStore_delete_Params(st), err
+}
+
+func NewRootCapStore_delete_Params(s *capnp.Segment) (CapStore_delete_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapStore_delete_Params(st), err
+}
+
+func ReadRootCapStore_delete_Params(msg *capnp.Message) (CapStore_delete_Params, error) {
+	root, err := msg.Root()
+	return CapStore_delete_Params(root.Struct()), err
+}
+
+func (s CapStore_delete_Params) String() string {
+	str, _ := text.Marshal(0xd6052bf9089e6f88, capnp.Struct(s))
+	return str
+}
+
+func (s CapStore_delete_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CapStore_delete_Params) DecodeFromPtr(p capnp.Ptr) CapStore_delete_Params {
+	return CapStore_delete_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CapStore_delete_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CapStore_delete_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CapStore_delete_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CapStore_delete_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s CapStore_delete_Params) Id() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s CapStore_delete_Params) HasId() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s CapStore_delete_Params) IdBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s CapStore_delete_Params) SetId(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+// CapStore_delete_Params_List is a list of CapStore_delete_Params.
+type CapStore_delete_Params_List = capnp.StructList[CapStore_delete_Params]
+
+// NewCapStore_delete_Params creates a new list of CapStore_delete_Params.
+func NewCapStore_delete_Params_List(s *capnp.Segment, sz int32) (CapStore_delete_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[CapStore_delete_Params](l), err
+}
+
+// CapStore_delete_Params_Future is a wrapper for a CapStore_delete_Params promised by a client call.
+type CapStore_delete_Params_Future struct{ *capnp.Future }
+
+func (f CapStore_delete_Params_Future) Struct() (CapStore_delete_Params, error) {
+	p, err := f.Future.Ptr()
+	return CapStore_delete_Params(p.Struct()), err
+}
+
+type CapStore_delete_Results capnp.Struct
+
+// CapStore_delete_Results_TypeID is the unique identifier for the type CapStore_delete_Results.
+const CapStore_delete_Results_TypeID = 0xcde28e606e738269
+
+func NewCapStore_delete_Results(s *capnp.Segment) (CapStore_delete_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapStore_delete_Results(st), err
+}
+
+func NewRootCapStore_delete_Results(s *capnp.Segment) (CapStore_delete_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapStore_delete_Results(st), err
+}
+
+func ReadRootCapStore_delete_Results(msg *capnp.Message) (CapStore_delete_Results, error) {
+	root, err := msg.Root()
+	return CapStore_delete_Results(root.Struct()), err
+}
+
+func (s CapStore_delete_Results) String() string {
+	str, _ := text.Marshal(0xcde28e606e738269, capnp.Struct(s))
+	return str
+}
+
+func (s CapStore_delete_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CapStore_delete_Results) DecodeFromPtr(p capnp.Ptr) CapStore_delete_Results {
+	return CapStore_delete_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CapStore_delete_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CapStore_delete_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CapStore_delete_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CapStore_delete_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// CapStore_delete_Results_List is a list of CapStore_delete_Results.
+type CapStore_delete_Results_List = capnp.StructList[CapStore_delete_Results]
+
+// NewCapStore_delete_Results creates a new list of CapStore_delete_Results.
+func NewCapStore_delete_Results_List(s *capnp.Segment, sz int32) (CapStore_delete_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[CapStore_delete_Results](l), err
+}
+
+// CapStore_delete_Results_Future is a wrapper for a CapStore_delete_Results promised by a client call.
+type CapStore_delete_Results_Future struct{ *capnp.Future }
+
+func (f CapStore_delete_Results_Future) Struct() (CapStore_delete_Results, error) {
+	p, err := f.Future.Ptr()
+	return CapStore_delete_Results(p.Struct()), err
+}
+
+type CapStore_list_Params capnp.Struct
+
+// CapStore_list_Params_TypeID is the unique identifier for the type CapStore_list_Params.
+const CapStore_list_Params_TypeID = 0xf2ca5a2e9eeda9ee
+
+func NewCapStore_list_Params(s *capnp.Segment) (CapStore_list_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapStore_list_Params(st), err
+}
+
+func NewRootCapStore_list_Params(s *capnp.Segment) (CapStore_list_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapStore_list_Params(st), err
+}
+
+func ReadRootCapStore_list_Params(msg *capnp.Message) (CapStore_list_Params, error) {
+	root, err := msg.Root()
+	return CapStore_list_Params(root.Struct()), err
+}
+
+func (s CapStore_list_Params) String() string {
+	str, _ := text.Marshal(0xf2ca5a2e9eeda9ee, capnp.Struct(s))
+	return str
+}
+
+func (s CapStore_list_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CapStore_list_Params) DecodeFromPtr(p capnp.Ptr) CapStore_list_Params {
+	return CapStore_list_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CapStore_list_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CapStore_list_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CapStore_list_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CapStore_list_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// CapStore_list_Params_List is a list of CapStore_list_Params.
+type CapStore_list_Params_List = capnp.StructList[CapStore_list_Params]
+
+// NewCapStore_list_Params creates a new list of CapStore_list_Params.
+func NewCapStore_list_Params_List(s *capnp.Segment, sz int32) (CapStore_list_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[CapStore_set_Results](l), err
+	return capnp.StructList[CapStore_list_Params](l), err
 }
 
-// CapStore_set_Results_Future is a wrapper for a CapStore_set_Results promised by a client call.
-type CapStore_set_Results_Future struct{ *capnp.Future }
+// CapStore_list_Params_Future is a wrapper for a CapStore_list_Params promised by a client call.
+type CapStore_list_Params_Future struct{ *capnp.Future }
 
-func (f CapStore_set_Results_Future) Struct() (CapStore_set_Results, error) {
+func (f CapStore_list_Params_Future) Struct() (CapStore_list_Params, error) {
 	p, err := f.Future.Ptr()
-	return CapStore_set_Results(p.Struct()), err
+	return CapStore_list_Params(p.Struct()), err
 }
 
-type CapStore_get_Params capnp.Struct
+type CapStore_list_Results capnp.Struct
 
-// CapStore_get_Params_TypeID is the unique identifier for the type CapStore_get_Params.
-const CapStore_get_Params_TypeID = 0x84593aa9eaf7e35f
+// CapStore_list_Results_TypeID is the unique identifier for the type CapStore_list_Results.
+const CapStore_list_Results_TypeID = 0xa0b9cf336a4c346f
 
-func NewCapStore_get_Params(s *capnp.Segment) (CapStore_get_Params, error) {
+func NewCapStore_list_Results(s *capnp.Segment) (CapStore_list_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return CapStore_get_Params(st), err
+	return CapStore_list_Results(st), err
 }
 
-func NewRootCapStore_get_Params(s *capnp.Segment) (CapStore_get_Params, error) {
+func NewRootCapStore_list_Results(s *capnp.Segment) (CapStore_list_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return CapStore_get_Params(st), err
+	return CapStore_list_Results(st), err
 }
 
-func ReadRootCapStore_get_Params(msg *capnp.Message) (CapStore_get_Params, error) {
+func ReadRootCapStore_list_Results(msg *capnp.Message) (CapStore_list_Results, error) {
 	root, err := msg.Root()
-	return CapStore_get_Params(root.Struct()), err
+	return CapStore_list_Results(root.Struct()), err
 }
 
-func (s CapStore_get_Params) String() string {
-	str, _ := text.Marshal(0x84593aa9eaf7e35f, capnp.Struct(s))
+func (s CapStore_list_Results) String() string {
+	str, _ := text.Marshal(0xa0b9cf336a4c346f, capnp.Struct(s))
 	return str
 }
 
-func (s CapStore_get_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s CapStore_list_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (CapStore_get_Params) DecodeFromPtr(p capnp.Ptr) CapStore_get_Params {
-	return CapStore_get_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (CapStore_list_Results) DecodeFromPtr(p capnp.Ptr) CapStore_list_Results {
+	return CapStore_list_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s CapStore_get_Params) ToPtr() capnp.Ptr {
+func (s CapStore_list_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s CapStore_get_Params) IsValid() bool {
+func (s CapStore_list_Results) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s CapStore_get_Params) Message() *capnp.Message {
+func (s CapStore_list_Results) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s CapStore_get_Params) Segment() *capnp.Segment {
+func (s CapStore_list_Results) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s CapStore_get_Params) Id() (string, error) {
+func (s CapStore_list_Results) Ids() (capnp.TextList, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return capnp.TextList(p.List()), err
+}
+
+func (s CapStore_list_Results) HasIds() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s CapStore_list_Results) SetIds(v capnp.TextList) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewIds sets the ids field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s CapStore_list_Results) NewIds(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+
+// CapStore_list_Results_List is a list of CapStore_list_Results.
+type CapStore_list_Results_List = capnp.StructList[CapStore_list_Results]
+
+// NewCapStore_list_Results creates a new list of CapStore_list_Results.
+func NewCapStore_list_Results_List(s *capnp.Segment, sz int32) (CapStore_list_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[CapStore_list_Results](l), err
+}
+
+// CapStore_list_Results_Future is a wrapper for a CapStore_list_Results promised by a client call.
+type CapStore_list_Results_Future struct{ *capnp.Future }
+
+func (f CapStore_list_Results_Future) Struct() (CapStore_list_Results, error) {
+	p, err := f.Future.Ptr()
+	return CapStore_list_Results(p.Struct()), err
+}
+
+type CapStore_watch_Params capnp.Struct
+
+// CapStore_watch_Params_TypeID is the unique identifier for the type CapStore_watch_Params.
+const CapStore_watch_Params_TypeID = 0xbc7e8666d698c875
+
+func NewCapStore_watch_Params(s *capnp.Segment) (CapStore_watch_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return CapStore_watch_Params(st), err
+}
+
+func NewRootCapStore_watch_Params(s *capnp.Segment) (CapStore_watch_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return CapStore_watch_Params(st), err
+}
+
+func ReadRootCapStore_watch_Params(msg *capnp.Message) (CapStore_watch_Params, error) {
+	root, err := msg.Root()
+	return CapStore_watch_Params(root.Struct()), err
+}
+
+func (s CapStore_watch_Params) String() string {
+	str, _ := text.Marshal(0xbc7e8666d698c875, capnp.Struct(s))
+	return str
+}
+
+func (s CapStore_watch_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CapStore_watch_Params) DecodeFromPtr(p capnp.Ptr) CapStore_watch_Params {
+	return CapStore_watch_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CapStore_watch_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CapStore_watch_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CapStore_watch_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CapStore_watch_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s CapStore_watch_Params) Id() (string, error) {
 	p, err := capnp.Struct(s).Ptr(0)
 	return p.Text(), err
 }
 
-func (s CapStore_get_Params) HasId() bool {
+func (s CapStore_watch_Params) HasId() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s CapStore_get_Params) IdBytes() ([]byte, error) {
+func (s CapStore_watch_Params) IdBytes() ([]byte, error) {
 	p, err := capnp.Struct(s).Ptr(0)
 	return p.TextBytes(), err
 }
 
-func (s CapStore_get_Params) SetId(v string) error {
+func (s CapStore_watch_Params) SetId(v string) error {
 	return capnp.Struct(s).SetText(0, v)
 }
 
-// CapStore_get_Params_List is a list of CapStore_get_Params.
-type CapStore_get_Params_List = capnp.StructList[CapStore_get_Params]
+func (s CapStore_watch_Params) Handler() CapStore_Handler {
+	p, _ := capnp.Struct(s).Ptr(1)
+	return CapStore_Handler(p.Interface().Client())
+}
 
-// NewCapStore_get_Params creates a new list of CapStore_get_Params.
-func NewCapStore_get_Params_List(s *capnp.Segment, sz int32) (CapStore_get_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[CapStore_get_Params](l), err
+func (s CapStore_watch_Params) HasHandler() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
 
-// CapStore_get_Params_Future is a wrapper for a CapStore_get_Params promised by a client call.
-type CapStore_get_Params_Future struct{ *capnp.Future }
+func (s CapStore_watch_Params) SetHandler(v CapStore_Handler) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(1, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(1, in.ToPtr())
+}
 
-func (f CapStore_get_Params_Future) Struct() (CapStore_get_Params, error) {
+// CapStore_watch_Params_List is a list of CapStore_watch_Params.
+type CapStore_watch_Params_List = capnp.StructList[CapStore_watch_Params]
+
+// NewCapStore_watch_Params creates a new list of CapStore_watch_Params.
+func NewCapStore_watch_Params_List(s *capnp.Segment, sz int32) (CapStore_watch_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[CapStore_watch_Params](l), err
+}
+
+// CapStore_watch_Params_Future is a wrapper for a CapStore_watch_Params promised by a client call.
+type CapStore_watch_Params_Future struct{ *capnp.Future }
+
+func (f CapStore_watch_Params_Future) Struct() (CapStore_watch_Params, error) {
 	p, err := f.Future.Ptr()
-	return CapStore_get_Params(p.Struct()), err
+	return CapStore_watch_Params(p.Struct()), err
+}
+func (p CapStore_watch_Params_Future) Handler() CapStore_Handler {
+	return CapStore_Handler(p.Future.Field(1, nil).Client())
 }
 
-type CapStore_get_Results capnp.Struct
+type CapStore_watch_Results capnp.Struct
 
-// CapStore_get_Results_TypeID is the unique identifier for the type CapStore_get_Results.
-const CapStore_get_Results_TypeID = 0xd18d94ef56d454f3
+// CapStore_watch_Results_TypeID is the unique identifier for the type CapStore_watch_Results.
+const CapStore_watch_Results_TypeID = 0x8bc563f67f4bed84
 
-func NewCapStore_get_Results(s *capnp.Segment) (CapStore_get_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return CapStore_get_Results(st), err
+func NewCapStore_watch_Results(s *capnp.Segment) (CapStore_watch_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapStore_watch_Results(st), err
 }
 
-func NewRootCapStore_get_Results(s *capnp.Segment) (CapStore_get_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return CapStore_get_Results(st), err
+func NewRootCapStore_watch_Results(s *capnp.Segment) (CapStore_watch_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapStore_watch_Results(st), err
 }
 
-func ReadRootCapStore_get_Results(msg *capnp.Message) (CapStore_get_Results, error) {
+func ReadRootCapStore_watch_Results(msg *capnp.Message) (CapStore_watch_Results, error) {
 	root, err := msg.Root()
-	return CapStore_get_Results(root.Struct()), err
+	return CapStore_watch_Results(root.Struct()), err
 }
 
-func (s CapStore_get_Results) String() string {
-	str, _ := text.Marshal(0xd18d94ef56d454f3, capnp.Struct(s))
+func (s CapStore_watch_Results) String() string {
+	str, _ := text.Marshal(0x8bc563f67f4bed84, capnp.Struct(s))
 	return str
 }
 
-func (s CapStore_get_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s CapStore_watch_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (CapStore_get_Results) DecodeFromPtr(p capnp.Ptr) CapStore_get_Results {
-	return CapStore_get_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (CapStore_watch_Results) DecodeFromPtr(p capnp.Ptr) CapStore_watch_Results {
+	return CapStore_watch_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s CapStore_get_Results) ToPtr() capnp.Ptr {
+func (s CapStore_watch_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s CapStore_get_Results) IsValid() bool {
+func (s CapStore_watch_Results) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s CapStore_get_Results) Message() *capnp.Message {
+func (s CapStore_watch_Results) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s CapStore_get_Results) Segment() *capnp.Segment {
+func (s CapStore_watch_Results) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s CapStore_get_Results) Cap() capnp.Client {
-	p, _ := capnp.Struct(s).Ptr(0)
-	return p.Interface().Client()
+
+// CapStore_watch_Results_List is a list of CapStore_watch_Results.
+type CapStore_watch_Results_List = capnp.StructList[CapStore_watch_Results]
+
+// NewCapStore_watch_Results creates a new list of CapStore_watch_Results.
+func NewCapStore_watch_Results_List(s *capnp.Segment, sz int32) (CapStore_watch_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[CapStore_watch_Results](l), err
 }
 
-func (s CapStore_get_Results) HasCap() bool {
-	return capnp.Struct(s).HasPtr(0)
+// CapStore_watch_Results_Future is a wrapper for a CapStore_watch_Results promised by a client call.
+type CapStore_watch_Results_Future struct{ *capnp.Future }
+
+func (f CapStore_watch_Results_Future) Struct() (CapStore_watch_Results, error) {
+	p, err := f.Future.Ptr()
+	return CapStore_watch_Results(p.Struct()), err
 }
 
-func (s CapStore_get_Results) SetCap(c capnp.Client) error {
-	if !c.IsValid() {
-		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+type SturdyRef capnp.Struct
+type SturdyRef_Which uint16
+
+const (
+	SturdyRef_Which_anchor   SturdyRef_Which = 0
+	SturdyRef_Which_executor SturdyRef_Which = 1
+)
+
+func (w SturdyRef_Which) String() string {
+	const s = "anchorexecutor"
+	switch w {
+	case SturdyRef_Which_anchor:
+		return s[0:6]
+	case SturdyRef_Which_executor:
+		return s[6:14]
+
 	}
-	seg := s.Segment()
-	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(c))
-	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+	return "SturdyRef_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-// CapStore_get_Results_List is a list of CapStore_get_Results.
-type CapStore_get_Results_List = capnp.StructList[CapStore_get_Results]
+// SturdyRef_TypeID is the unique identifier for the type SturdyRef.
+const SturdyRef_TypeID = 0xfff7dd6ac78091ac
 
-// NewCapStore_get_Results creates a new list of CapStore_get_Results.
-func NewCapStore_get_Results_List(s *capnp.Segment, sz int32) (CapStore_get_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[CapStore_get_Results](l), err
+func NewSturdyRef(s *capnp.Segment) (SturdyRef, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return SturdyRef(st), err
 }
 
-// CapStore_get_Results_Future is a wrapper for a CapStore_get_Results promised by a client call.
-type CapStore_get_Results_Future struct{ *capnp.Future }
+func NewRootSturdyRef(s *capnp.Segment) (SturdyRef, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return SturdyRef(st), err
+}
 
-func (f CapStore_get_Results_Future) Struct() (CapStore_get_Results, error) {
+func ReadRootSturdyRef(msg *capnp.Message) (SturdyRef, error) {
+	root, err := msg.Root()
+	return SturdyRef(root.Struct()), err
+}
+
+func (s SturdyRef) String() string {
+	str, _ := text.Marshal(0xfff7dd6ac78091ac, capnp.Struct(s))
+	return str
+}
+
+func (s SturdyRef) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (SturdyRef) DecodeFromPtr(p capnp.Ptr) SturdyRef {
+	return SturdyRef(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s SturdyRef) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+
+func (s SturdyRef) Which() SturdyRef_Which {
+	return SturdyRef_Which(capnp.Struct(s).Uint16(0))
+}
+func (s SturdyRef) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s SturdyRef) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s SturdyRef) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s SturdyRef) Anchor() (string, error) {
+	if capnp.Struct(s).Uint16(0) != 0 {
+		panic("Which() != anchor")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s SturdyRef) HasAnchor() bool {
+	if capnp.Struct(s).Uint16(0) != 0 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s SturdyRef) AnchorBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s SturdyRef) SetAnchor(v string) error {
+	capnp.Struct(s).SetUint16(0, 0)
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s SturdyRef) SetExecutor() {
+	capnp.Struct(s).SetUint16(0, 1)
+
+}
+
+// SturdyRef_List is a list of SturdyRef.
+type SturdyRef_List = capnp.StructList[SturdyRef]
+
+// NewSturdyRef creates a new list of SturdyRef.
+func NewSturdyRef_List(s *capnp.Segment, sz int32) (SturdyRef_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[SturdyRef](l), err
+}
+
+// SturdyRef_Future is a wrapper for a SturdyRef promised by a client call.
+type SturdyRef_Future struct{ *capnp.Future }
+
+func (f SturdyRef_Future) Struct() (SturdyRef, error) {
 	p, err := f.Future.Ptr()
-	return CapStore_get_Results(p.Struct()), err
+	return SturdyRef(p.Struct()), err
 }
-func (p CapStore_get_Results_Future) Cap() capnp.Client {
-	return p.Future.Field(0, nil).Client()
+
+type Record capnp.Struct
+
+// Record_TypeID is the unique identifier for the type Record.
+const Record_TypeID = 0xe55acb092050bf69
+
+func NewRecord(s *capnp.Segment) (Record, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Record(st), err
+}
+
+func NewRootRecord(s *capnp.Segment) (Record, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Record(st), err
+}
+
+func ReadRootRecord(msg *capnp.Message) (Record, error) {
+	root, err := msg.Root()
+	return Record(root.Struct()), err
+}
+
+func (s Record) String() string {
+	str, _ := text.Marshal(0xe55acb092050bf69, capnp.Struct(s))
+	return str
+}
+
+func (s Record) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-const schema_bbe22aa2756d2943 = "x\xda\x84\x921H#A\x18\x85\xff7\xb3\xb9\x09\\" +
-	"\xc2\xdddspIs\xb0\xe4\xe0r\xdc\x85\x0bwU" +
-	"80\x10\xec3Q\x04A\x90%.ALt\xc9n" +
-	"\xb0\xb2\x13\x05\xc1J\xdb4B\x0am-Sia/" +
-	"\x88\x9dM\xc4B\x9b \x16\x82\x16+cL\x8cB\xb4" +
-	"\x19\x98\xf9\xdf|\x8f\xc7\xfb?\x9f\xe6\x8dl\xf4\x1b'" +
-	"\xa6R\xa1\x0f\xc1\xec\xf9\xed\xd5nnz\x95\xe4W\x10" +
-	"\x85 \x88\xfe6\x90\x04\xc1\\\xc1\x18!\xf8_\xbd\x9f" +
-	"\xc1\xc1\xc2\xd6\x93\x80iA\xb3'ha\x99\x10\xdc\x95" +
-	"\xce\xf6.\x9a\xfb\xed\x9e\xc0\xd0s0\x0bd\x047\x93" +
-	"'S\xdd\xed\xcd\xe3a\xf6%,\xfd\xf5\xfa\x91}4" +
-	"\xde\xddX\xfb\xd8\xea\x90\xfc\xc4\x83B\xba\xd6\xd8\xf9\xd9" +
-	"i\x13\xc1\x94\xec\xd0Lh+\xf3\x0b[7\x1d&\xe8" +
-	"WP\xb6]\xcf_\xaa;<S\xb6\xddE7W\xb0" +
-	"\xdd\x09}\xcfT\x1c?U\xb4\xeb6\xafy\xca\xe0\x06" +
-	"\x91\x01\"\x19M\x12\xa90\x87\x8a3\xf0\xf99D\x88" +
-	"!B\x18\x8d\xf1\x860\xe1\x01&\xad1)\x0e\xf5\x87" +
-	"A\x02q\xe8\xc7\xdf\x16\x91\xfa\xc1\xa1\xfe\xbd`\x8b\xb2" +
-	"\xed\"fp\x02b\xef9\x95\x1c\xaf!\xaa\xbe\xf7v" +
-	"\xac\xbej8\x97\xf5\x9ck\x84!{\x85\xa2\"\xa0\xc2" +
-	"<D4h\x13\xfd\xd6d\xd6\"&\xbf\x0b`\xb0\x0a" +
-	"\xe8\xf7&\x13z\x16\x15\xc2s\xfc<DE\x9fE\xe0" +
-	"!\x00\x00\xff\xffZ\xb1\x96\xdd"
+func (Record) DecodeFromPtr(p capnp.Ptr) Record {
+	return Record(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Record) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Record) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Record) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Record) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Record) Ref() (SturdyRef, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return SturdyRef(p.Struct()), err
+}
+
+func (s Record) HasRef() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Record) SetRef(v SturdyRef) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewRef sets the ref field to a newly
+// allocated SturdyRef struct, preferring placement in s's segment.
+func (s Record) NewRef() (SturdyRef, error) {
+	ss, err := NewSturdyRef(capnp.Struct(s).Segment())
+	if err != nil {
+		return SturdyRef{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s Record) Expires() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s Record) SetExpires(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+// Record_List is a list of Record.
+type Record_List = capnp.StructList[Record]
+
+// NewRecord creates a new list of Record.
+func NewRecord_List(s *capnp.Segment, sz int32) (Record_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Record](l), err
+}
+
+// Record_Future is a wrapper for a Record promised by a client call.
+type Record_Future struct{ *capnp.Future }
+
+func (f Record_Future) Struct() (Record, error) {
+	p, err := f.Future.Ptr()
+	return Record(p.Struct()), err
+}
+func (p Record_Future) Ref() SturdyRef_Future {
+	return SturdyRef_Future{Future: p.Future.Field(0, nil)}
+}
+
+const schema_bbe22aa2756d2943 = "x\xda\x8cUo\x88TU\x14?\xe7\xddy\xef\xce\xd0" +
+	"\x8e\xbb\xd7\xb7\x82\xf9\xc1\x91a\x84\xb4\\tW\xa8\x16" +
+	"m\x86\xb5E\xad]\x98;Z\xb4\xa2\xe8s\xe6\xae;" +
+	"6;;\xbe\xf7\xc6U\x82\xb6\xc2\xd5J\x0c\xa4>h" +
+	"\xa1IQ\xb0\x92D\x89\x1f\xc2\xa0\x7f\xf4\x07EBJ" +
+	"\xc1\x0f\x0aYK\x10\x98\xa4\x90X\xe8\x8b\xfb\xde\xbc7" +
+	"o\xc6\xdd\xd6/\xcb\xec\xbb\x87s~\xe7w\xce\xefw" +
+	"\x96\xf6\x93LdY\xdc\x89\x81\xc2mUs6\xffz" +
+	"\xeb\x8f\x89\xee\x81=\xc0\xe6\"\x80\x8a\x14\xa0k22" +
+	"\x0f\x01\xf5k\x914\xa0\xf3\xb28\x96\xfept\xc7\x1e" +
+	"`\xf3\x11 \"\xdf\xe3\xeav\x84\x88\xf3n\xdfO\xf3" +
+	"~[\xf0\xfe8\xb0\xb9\xc4\xf9\xae\xf7\xfa\xeb{\x1f\xf8" +
+	"\xe0*\x00\xea\xb7#7tU\xa5\x00:\xaa\xfbt." +
+	"\x7f9\xfd\xd7\x87\x96\xa4\x16\xae\xdc\x17J\xf3\xb8\xbaU" +
+	"\xa6\xd9s\xed\xe9\xb1\xbf\xf3\xdf\xee\xf7\x10\xb8/\x0b\xd5" +
+	"N\xf9\xb2\xa2\xf4\xefF\xfc\xea\xf97\x81\xcfE\x09N" +
+	"\xf1\x8a\xbb\xe0\xe6\xa8\x1f\x03:#\xcb\xfb\xb6w\xfd\xf8" +
+	"\xd9\xb10\xfa\x13\xeab\x19pJ\x95\xe8\xff\xc9]>" +
+	">y\xe4\xe4\xe9\x86\xf6\xd4\xa4\xdb\x9e\x1bP\xfd\xe1\xd0" +
+	"\xc5\xc1\xbd/~^\x0b\xf0Jhn\x869\xda(\xa0" +
+	"\xb3\xfa\xca\xc0\xe0\xc1\x15\x7f\x9d\x0d\x01\xdf\xa1\xb9\xc0\x8b" +
+	"\xafX\xe5-o\\=\x17\x02\xbeI\xeb\x96/\x87z" +
+	"z\x9e\xdb?\xb8\xeb|33]\xbd\x9a\x82:\xd7$" +
+	"5\xfd\xdaj\xbd*\x7f97\xd7\xff\xfc\xec\xf5\xb7\x0e" +
+	"\x9c\x0fc\xdc\xa4\xb9\x18\x85&1\xbe:r4z\xfb" +
+	"a\xf5b8`\\\xeb\x94\x01\x07\xdc\x80\xa0\x04k%" +
+	"\xce\xaaE\xc3\xd5\xf7\x16_=-'qB\xfbZ?" +
+	"\xa5=\x0a\xd0uA[\x8d\xfa\x11*\xeb\x15\xbf\xc8." +
+	"\x88\x9d\xdd0\x09\xbc\x15\xb1\x1e\xee\xe6\xd5\xc7\xe9\xa7\xfa" +
+	"\x01\x19\xa6\xbfFe\xfb\x7fN\\;\xda\xb1\xe1\xcc\x8d" +
+	"P\x93\x934)\x9b\x9c\x98\x95x\xa2\x9a\x88\xdft\xa7" +
+	"S\x87\xd0\x8b\x94\x00\xe8\xe7\xe8\x0d\xfd\x92\x9b\xe7\x02\xfd" +
+	"\x1d\xd01\xde\xb9s\xe5\x9b\x81\xc37\x81-\x08z\x98" +
+	"\x88nu'\x15\x95=TN\xf6\xbf0v\xe9\xf8]" +
+	"\xe0\xf3\xd1\xaft!\xbaA\x06\xfc\xe2\x06|t\xf0\xa5" +
+	"\xef\xb7_\xbe\xe54\xc1\xeeE\xaa\xc8M\x8b\x9d\xd1\xe3" +
+	"1Y/\x16\x1b\x85\x01'oT,{\xc4\x14\xa4#" +
+	"oT\xca\x95\xeeUFe\x9d\xfc\xbfc\x9b\xb0SY" +
+	"\xc34\xc8\xb0\xc5#$\x02\x10A\x00\x16\x9f\x07\xc0\xa3" +
+	"\x04y\xbb\x82\xa4X\xc0\x16P\xb0\x050H\x13iN" +
+	"\xd3'\x0cKt\xe4\x8dr^\x94R9a\xb5VK" +
+	"\xb6\x15\x84+\xcd\xe1\x097>\x8b\xc8\xa3D\x05\x08\xba" +
+	"E\x7f\xbd\xd8\xb2NP\xd8B\x8a\x18H\x05}\xe9\xb1" +
+	"\x07\xbbAaq\x9a0EY\x8cf0\xed\x95\xcd`" +
+	"\x16\xef\x17a\xd60\xa91lMO\xcb\xa8a\xe7\x87" +
+	"R9\x91\xb0\x1a\x1a\xb9'\xce\x0a\xd1\xd7\x12\xd0\xd7+" +
+	"\xe9\xcb\x10\xe4}\x0a2\xc4v\x94\x1f\xd7&\x01\xf8\x93" +
+	"\x04yVAT\xdaQ\x01`\xfd\xf2\xdb\x1a\x82|}" +
+	"\x03\xcf4oTpv\x84\x00\xe2l@j\xdb%\x8c" +
+	"\x82\x82Q\xc0\xe9\x91\x94\x8a\x96\x1d0\x1f\x1ee\xb26" +
+	"\xca\x94\x82\xb4X\xb0p\x16`\x96\xa0[j\x16\xe0\xff" +
+	"\xb7\x96\x13V\x956\xe5\xeb\xac\xafF\xa2$IEV" +
+	"\xf7>@d\x803\xf1\x9a5ZMc\xd8\xe2\xd1 " +
+	"\xeb\"\xc9X\x8a _\x1ablI\x0f\x00\x7f\x88 " +
+	"_\xde\xc0\xce\xd8\x90Q.\x94\x84\x89\xac\xee,Mu" +
+	"\xa7\x99\xbe\xbb0AS\xd3\xa3,\x88\x92\xb0E*\x97" +
+	"\x163\x8c\x7f\x8d\x87\x04\xe4&G\xdcM\xf6\x85\x8d\xe5" +
+	"O\xbe\x1c\xedz{\xf3a\xc6\x16\x83\xc2T\xdaj\x8a" +
+	"\xfc\xce\xc6\x1d\x9dR\x8cSQ\x9e\xacS\xde\xb8\x1a3" +
+	"\xb6\x90M\x18.\xd3\xf7/\xedf\xad\x02oA\x0c\x1d" +
+	"7\xd6\x19\xf2\xf3xO\xdd\xf7X\xac\xd3\x93\xf5X\x8d" +
+	"\x95D\xefNQ\xb6y\xbb\xcb\x8b\x7f\xbc\xd0?A\xec" +
+	"`\x12\x146.\x15\xee\x1f]\xf4\xad\x9f\xed\x96o\xc3" +
+	"\x14\x95\xc0\xec\xd1?/\xcc\x90\xea\x7f\x86\"\x09\xcc\x18" +
+	"\xfd\xbb\xc7\xd6J\xaeWR\x8c\x04\x87\x0c\xfd{\xea;" +
+	"\x0a\xb5\x84\x9dA\xbaM\xfeM{,e\xb0U\xaa'" +
+	"\x83\x09w=\x1b\x87\x845>r\x09\x91\x1f1\x0b\x9e" +
+	"g\x05k\x9b\xac\xaf\xedT[KM1\x88mu\xaf" +
+	"\x06\xc46\xc01\xb1\xabR4\x85\x85*(\xa8\xce(" +
+	"\xeaf\xb3\xba\xd7M]\xa2%\xb2\xb6\x00\x99!\xc7\xbc" +
+	"\x91 \x1fR0\x8e\x8e\xe3a\x13\x12\xef\x16\x82\xbc\xa4" +
+	"`\\\xb9\xebx.T\xec\x06\xe0\x05\x82\xbc\xa2`\x9c" +
+	"\xdcq\xda\x91\x00\xb0a\xf9u\x88 \xb7\x1b\xbd\xc9\x12" +
+	"6h5\xe6@K{\xbd\x806\xbd\xf6j\xeb\xd0!" +
+	"\x05\xe073\x9d\xa1\x08\xd9\x09\xb6\xd5\xb7\xcac\xec\xfe" +
+	"\x84=\xc5\x1d\x0b+g*\x1b\xf5\xb9\\gW\xcd\xc2" +
+	"\xee\x9c\x18\x04\xa8M\xb8\xc5q\xbc\x11w\xd7G\x1c\xc7" +
+	"\xbb5\"\x97<\x05\xc0\x1f!\xc8\x1fS0m\x94\xf3" +
+	"C#f\xa0$\xb1K\xe4\xab\xf6\x88\x09@\xff\x1b\x00" +
+	"\xba\xd4\xde\x8e"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
 		String: schema_bbe22aa2756d2943,
 		Nodes: []uint64{
 			0x84593aa9eaf7e35f,
+			0x847177a63fa06581,
+			0x85a320e41ad34c9f,
+			0x873d25242d68ef4d,
+			0x8bc563f67f4bed84,
 			0x936bc1015cfb6c3c,
+			0xa0b9cf336a4c346f,
 			0xbbb39de5aadd52fa,
+			0xbc7e8666d698c875,
+			0xcbf13c916659de47,
+			0xcde28e606e738269,
+			0xd178668b58424298,
 			0xd18d94ef56d454f3,
+			0xd6052bf9089e6f88,
 			0xe2a50b868aef45c6,
+			0xe55acb092050bf69,
+			0xf2ca5a2e9eeda9ee,
+			0xf30d1f753e1f0ea9,
+			0xf39959c3defd9b61,
+			0xfeaad97f7b4db370,
+			0xfff7dd6ac78091ac,
 		},
 		Compressed: true,
 	})
@@ -9,6 +9,7 @@ import (
 	schemas "capnproto.org/go/capnp/v3/schemas"
 	server "capnproto.org/go/capnp/v3/server"
 	context "context"
+	strconv "strconv"
 )
 
 type Closer capnp.Client
@@ -2174,71 +2175,650 @@ func (p Chan_newRecver_Results_Future) Recver() Recver {
 	return Recver(p.Future.Field(0, nil).Client())
 }
 
-const schema_872a451f9aa74ebf = "x\xda\x94VQh\x1cU\x17>gg\xee\xdc\xfc!" +
-	"\xdb\xc9\xdd\x9b\xbfR\xad\xacHk!\xe0\x92m\xa1\x91" +
-	"\xa8d\xc7m\x89\x0a)\x99\xb5\x01-\xf5aH'\x16" +
-	"\xdd\x8c\xedN\xd3\xdaJ\x1e\xf2\x14T\x8a/\xf5\xc1 " +
-	"\x14A\xacM\x11\xfb\xa0\xa8\xa4 \x98\x17M#\xe8\x93" +
-	"\x16\\J\xa1\xa5m4\x95\x80E\x14\xc2\xc8\x9d\xdd;" +
-	"sww\xbau\x9f\x12\xee\xfd\xe6;\xe7|\xe7;\xf7" +
-	"\xec\xc0\xc5TA\xcf\xa7\xff\xa0\x90\xb2\x0f\x12#X~" +
-	"c\xed\xe9\x0f.M\xce\x02\xfb?\x02\x10\xa4\xbd\xb8\xab" +
-	"\xaau# \xbf\xa1\x0d\x03\x06_\xec\xbe0y\xea\xe1" +
-	"\x87\xde\x02\xc6\x11@\x17\xf7D\x7f\x14A\x0f\xec\xbf&" +
-	"\xaf\xdc<\xfb\xdd{\xc06i\xc17\xfb\xce\xcdg\xf7" +
-	"\xf6\xcf\x01@/\xf25m\x9e\xdf\xd5(\x00_\xd7\xe6" +
-	"\xf8\xfb\xfa\x0e\xfe?b\x06\xdfW6\xa5\xbf\x9d}\xfb" +
-	"l\x8d\xaa\x16kC\xef\x17\xb1\x08\x11\xb1\xcel\x1e\xea" +
-	"\x1e}\xf7\xd6E5\x99q\x92\x11\x80\x97C\xc0o\x9f" +
-	"?\xb2\xf2;\xe6\x17k\x800\x99\x19q\xaf\x07\xe7\x9f" +
-	"\xfc\xe8\xe8Jzp\x11\xd8\x16y\xe3\x92\x92\xb8\x99\xbd" +
-	"\x80E\xec=\xba\\\xbb\xa9\x91\xda\xe4@L:\xfe\x09" +
-	"\xa6\x7f\x18\xbetYM\xebS\xf2\xbc\x00|\x1d\x02V" +
-	"\xff<\xf5\xceg\x07\xef\\\x96Q\x01v\xa5\x8d\x07\x05" +
-	"\xf7\xeac\xdb\xd2\xbf\xce\x0cV\x95|\xee\x92nqC" +
-	"{\xb6\xbe\x92>O\xae&\x88S%\x0b\xfc\x06\x11\xe2" +
-	"\\##<oP\x9e7\xcc\xe0\xf4\xc7\xaf])\x9e" +
-	"9}])`\xbb\x11\x16pu\xe9\xe77\xafU_" +
-	"\xbd\xa9t\x80\x19\xcf\x88\x9b;\x9bW\x9f\xe8[[\xbc" +
-	"\x95\x10\xe4o\xb2\xc0\xd1\x10A6\xc8\x08\x1f5(\x1f" +
-	"5\xcc`\xdf\xed\xbe\x99\xf9\x9f\xce\xddN\xf8\xc02\x96" +
-	"\x05\x0c\x80?g\xcc\xf1uc\x07\xcfS3X\xdf\xb2" +
-	"\xf4\xe5\xc9Bu]\x15o;\x0d\xc5\xcbS\xa1\xcd\xd2" +
-	"\xc9\xc1\xf4W\xd7\x7f\xfc\xa7\x89\x11\xb9M\x17\xf8KT" +
-	"\xf0\x8d\xd3\x11>#\xfe\x0b\x9ezq\xf7\xca\x87\xbf\xd0" +
-	"\x0dEH\x97f\x10\x06\x82\x89\xc3\x8e\xe7\xb9\xe5\x9c6" +
-	"\xe1\x1c\xf1\x8e\x0c\xbd\xe0z\x87\xdcJ\xcew\xbdC\xdb" +
-	"\xc6\x9c\x8a3\xe5\x03\xd8\xba\xa6\x03\xe8\x08\xc0\xd2;\x01" +
-	"\xec.\x0d\xed\xbe\x14f\x8f;\xe5i\x173\x88\xb1\x1c" +
-	"\x00\x98\x01l&-\x1ev\xbc\x9c\xe7\x9e(\xb9\x13\xc7" +
-	"\xdd\x8a\xe0\xa5\xce\x94\x1f\xa1P\xa24\xc7\x1bC\xb4\xbb" +
-	"4\x02\x10\x89\x8f\xd2%,_\x01\xb0\x06\xd0* \xb3" +
-	")\xc6\x03\x82\xd2\xdelo\x09\xc0\xda\x83\xd6~d." +
-	"\x0d<\xf7\x84(\xa7\x08\xd9\xf2\xeb\xbe[a\x98\xb5\xf5" +
-	"\x14\xc6\xd3\x83\xa2\xa2\xa4\xc3\x02\x062[\xc0\x8e\xbe\xb3" +
-	"\xbb\x10\xe3^[]\x18\xdb\xd1\x1a@\xf9Q\x04\x10<" +
-	"\x0f\xd8:\"\xe2\x98\x86\xa1\x94\x11a\x1c#\xa2h\x0f" +
-	"\xb7td\x98\xc1\xfd\xf7Q\xbf\xe4\xfa\xe6t\xf9\x98\xaf" +
-	"vu\xa8\xde\xd5=)\x1c\xae\x840dj\xd8\x02v" +
-	"\x98\x0b\xb2V\x17\xd4\xe2\xe7\x04\xbfHb\xba|\x0c\xfd" +
-	"\xfb[K\x09\x98d-\xd5\xaf\x924\xc2\xe81\xa6\x18" +
-	"Z W\xb7D\xcd\x84\xce\xd4\x7f\xc4\x86\xc4\xda\xbd%" +
-	"\xf3C\x182u\x0eb\xc9\x94\xc3\x16\xc9\x14'4H" +
-	"\xa67\xb6.\xce\xaa^d\xd3T\x1e\x00\xb0{4\xb4" +
-	"\x9fMa\xe0\xd7\xb1\xa0\x85))\x11\x0a\x9d\x1a0\xa9" +
-	"\x8buq&\xc4\x9fH\x98v\xad\x96\xafH\xf3\xb8\x97" +
-	"\\S\x80\xc4\xc0\xeb\xe1\xc0\xcb'\x1d\xe52b\xac\x1f" +
-	"\xc0\xeaAk+\xb2\xc7\xa9)\xd8Zl\xa8\xceb\xc3" +
-	"a\x01\xc7\x10[F\"\xb1\xcbue\x9b\x1da\xdc\xb3" +
-	"\x07\xf5\x92$Pr\x9a\xc2\x05q=r\xc1\xa3\xdc\x9d" +
-	"M\xf5\x88F\xb5xD\xad\xa7\xe10\xb9\x9eTs=" +
-	"\x00\xf1\x0b*73\xcaE\xcc\xf2\xa5\xc6\x17T\xae>" +
-	"\x94\xdb&\xf9\x05U_\xc1\xc8:j\xa6\x0d\x87\xb5\xd7" +
-	"3L\xa7\xc3\xef\xc2\xd73\xaaZ\xbc\x9e\xd1\x92KP" +
-	"\xa5\xfd8u\xd4\xfav\x03\xde\x97\xc2\xe1\xd0\xedb\x9a" +
-	"\xe2|\xb0a8\xe4\x0a+\x9b\x02\x18{@\xee]\x94" +
-	"\xbfd\x18\xdb\x09)Fh6\xa4\x0c\x9b\xdav\xc2\xea" +
-	"\xa6\xfc7\x00\x00\xff\xff\xda\x9e\xfa="
+type Selector capnp.Client
+
+// Selector_TypeID is the unique identifier for the type Selector.
+const Selector_TypeID = 0xf33b8ecc59b9ef01
+
+func (c Selector) Select(ctx context.Context, params func(Selector_select_Params) error) (Selector_select_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xf33b8ecc59b9ef01,
+			MethodID:      0,
+			InterfaceName: "channel.capnp:Selector",
+			MethodName:    "select",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Selector_select_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Selector_select_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c Selector) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c Selector) String() string {
+	return "Selector(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c Selector) AddRef() Selector {
+	return Selector(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c Selector) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c Selector) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c Selector) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (Selector) DecodeFromPtr(p capnp.Ptr) Selector {
+	return Selector(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c Selector) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c Selector) IsSame(other Selector) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c Selector) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c Selector) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A Selector_Server is a Selector with a local implementation.
+type Selector_Server interface {
+	Select(context.Context, Selector_select) error
+}
+
+// Selector_NewServer creates a new Server from an implementation of Selector_Server.
+func Selector_NewServer(s Selector_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Selector_Methods(nil, s), s, c)
+}
+
+// Selector_ServerToClient creates a new Client from an implementation of Selector_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Selector_ServerToClient(s Selector_Server) Selector {
+	return Selector(capnp.NewClient(Selector_NewServer(s)))
+}
+
+// Selector_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Selector_Methods(methods []server.Method, s Selector_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 1)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf33b8ecc59b9ef01,
+			MethodID:      0,
+			InterfaceName: "channel.capnp:Selector",
+			MethodName:    "select",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Select(ctx, Selector_select{call})
+		},
+	})
+
+	return methods
+}
+
+// Selector_select holds the state for a server call to Selector.select.
+// See server.Call for documentation.
+type Selector_select struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Selector_select) Args() Selector_select_Params {
+	return Selector_select_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Selector_select) AllocResults() (Selector_select_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Selector_select_Results(r), err
+}
+
+// Selector_List is a list of Selector.
+type Selector_List = capnp.CapList[Selector]
+
+// NewSelector creates a new list of Selector.
+func NewSelector_List(s *capnp.Segment, sz int32) (Selector_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[Selector](l), err
+}
+
+type Selector_Case capnp.Struct
+type Selector_Case_recv Selector_Case
+type Selector_Case_send Selector_Case
+type Selector_Case_Which uint16
+
+const (
+	Selector_Case_Which_recv Selector_Case_Which = 0
+	Selector_Case_Which_send Selector_Case_Which = 1
+)
+
+func (w Selector_Case_Which) String() string {
+	const s = "recvsend"
+	switch w {
+	case Selector_Case_Which_recv:
+		return s[0:4]
+	case Selector_Case_Which_send:
+		return s[4:8]
+
+	}
+	return "Selector_Case_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+}
+
+// Selector_Case_TypeID is the unique identifier for the type Selector_Case.
+const Selector_Case_TypeID = 0xc9a3ba48aefbff33
+
+func NewSelector_Case(s *capnp.Segment) (Selector_Case, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Selector_Case(st), err
+}
+
+func NewRootSelector_Case(s *capnp.Segment) (Selector_Case, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Selector_Case(st), err
+}
+
+func ReadRootSelector_Case(msg *capnp.Message) (Selector_Case, error) {
+	root, err := msg.Root()
+	return Selector_Case(root.Struct()), err
+}
+
+func (s Selector_Case) String() string {
+	str, _ := text.Marshal(0xc9a3ba48aefbff33, capnp.Struct(s))
+	return str
+}
+
+func (s Selector_Case) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Selector_Case) DecodeFromPtr(p capnp.Ptr) Selector_Case {
+	return Selector_Case(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Selector_Case) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+
+func (s Selector_Case) Which() Selector_Case_Which {
+	return Selector_Case_Which(capnp.Struct(s).Uint16(0))
+}
+func (s Selector_Case) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Selector_Case) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Selector_Case) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Selector_Case) Recv() Selector_Case_recv { return Selector_Case_recv(s) }
+
+func (s Selector_Case) SetRecv() {
+	capnp.Struct(s).SetUint16(0, 0)
+}
+
+func (s Selector_Case_recv) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Selector_Case_recv) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Selector_Case_recv) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Selector_Case_recv) Recver() Recver {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return Recver(p.Interface().Client())
+}
+
+func (s Selector_Case_recv) HasRecver() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Selector_Case_recv) SetRecver(v Recver) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+func (s Selector_Case) Send() Selector_Case_send { return Selector_Case_send(s) }
+
+func (s Selector_Case) SetSend() {
+	capnp.Struct(s).SetUint16(0, 1)
+}
+
+func (s Selector_Case_send) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Selector_Case_send) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Selector_Case_send) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Selector_Case_send) Sender() Sender {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return Sender(p.Interface().Client())
+}
+
+func (s Selector_Case_send) HasSender() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Selector_Case_send) SetSender(v Sender) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+func (s Selector_Case_send) Value() (capnp.Ptr, error) {
+	return capnp.Struct(s).Ptr(1)
+}
+
+func (s Selector_Case_send) HasValue() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s Selector_Case_send) SetValue(v capnp.Ptr) error {
+	return capnp.Struct(s).SetPtr(1, v)
+}
+
+// Selector_Case_List is a list of Selector_Case.
+type Selector_Case_List = capnp.StructList[Selector_Case]
+
+// NewSelector_Case creates a new list of Selector_Case.
+func NewSelector_Case_List(s *capnp.Segment, sz int32) (Selector_Case_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[Selector_Case](l), err
+}
+
+// Selector_Case_Future is a wrapper for a Selector_Case promised by a client call.
+type Selector_Case_Future struct{ *capnp.Future }
+
+func (f Selector_Case_Future) Struct() (Selector_Case, error) {
+	p, err := f.Future.Ptr()
+	return Selector_Case(p.Struct()), err
+}
+func (p Selector_Case_Future) Recv() Selector_Case_recv_Future {
+	return Selector_Case_recv_Future{p.Future}
+}
+
+// Selector_Case_recv_Future is a wrapper for a Selector_Case_recv promised by a client call.
+type Selector_Case_recv_Future struct{ *capnp.Future }
+
+func (f Selector_Case_recv_Future) Struct() (Selector_Case_recv, error) {
+	p, err := f.Future.Ptr()
+	return Selector_Case_recv(p.Struct()), err
+}
+func (p Selector_Case_recv_Future) Recver() Recver {
+	return Recver(p.Future.Field(0, nil).Client())
+}
+
+func (p Selector_Case_Future) Send() Selector_Case_send_Future {
+	return Selector_Case_send_Future{p.Future}
+}
+
+// Selector_Case_send_Future is a wrapper for a Selector_Case_send promised by a client call.
+type Selector_Case_send_Future struct{ *capnp.Future }
+
+func (f Selector_Case_send_Future) Struct() (Selector_Case_send, error) {
+	p, err := f.Future.Ptr()
+	return Selector_Case_send(p.Struct()), err
+}
+func (p Selector_Case_send_Future) Sender() Sender {
+	return Sender(p.Future.Field(0, nil).Client())
+}
+
+func (p Selector_Case_send_Future) Value() *capnp.Future {
+	return p.Future.Field(1, nil)
+}
+
+type Selector_select_Params capnp.Struct
+
+// Selector_select_Params_TypeID is the unique identifier for the type Selector_select_Params.
+const Selector_select_Params_TypeID = 0xb03ffa0660ca4cf6
+
+func NewSelector_select_Params(s *capnp.Segment) (Selector_select_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Selector_select_Params(st), err
+}
+
+func NewRootSelector_select_Params(s *capnp.Segment) (Selector_select_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Selector_select_Params(st), err
+}
+
+func ReadRootSelector_select_Params(msg *capnp.Message) (Selector_select_Params, error) {
+	root, err := msg.Root()
+	return Selector_select_Params(root.Struct()), err
+}
+
+func (s Selector_select_Params) String() string {
+	str, _ := text.Marshal(0xb03ffa0660ca4cf6, capnp.Struct(s))
+	return str
+}
+
+func (s Selector_select_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Selector_select_Params) DecodeFromPtr(p capnp.Ptr) Selector_select_Params {
+	return Selector_select_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Selector_select_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Selector_select_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Selector_select_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Selector_select_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Selector_select_Params) Cases() (Selector_Case_List, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return Selector_Case_List(p.List()), err
+}
+
+func (s Selector_select_Params) HasCases() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Selector_select_Params) SetCases(v Selector_Case_List) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewCases sets the cases field to a newly
+// allocated Selector_Case_List, preferring placement in s's segment.
+func (s Selector_select_Params) NewCases(n int32) (Selector_Case_List, error) {
+	l, err := NewSelector_Case_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return Selector_Case_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+func (s Selector_select_Params) Nonblocking() bool {
+	return capnp.Struct(s).Bit(0)
+}
+
+func (s Selector_select_Params) SetNonblocking(v bool) {
+	capnp.Struct(s).SetBit(0, v)
+}
+
+// Selector_select_Params_List is a list of Selector_select_Params.
+type Selector_select_Params_List = capnp.StructList[Selector_select_Params]
+
+// NewSelector_select_Params creates a new list of Selector_select_Params.
+func NewSelector_select_Params_List(s *capnp.Segment, sz int32) (Selector_select_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Selector_select_Params](l), err
+}
+
+// Selector_select_Params_Future is a wrapper for a Selector_select_Params promised by a client call.
+type Selector_select_Params_Future struct{ *capnp.Future }
+
+func (f Selector_select_Params_Future) Struct() (Selector_select_Params, error) {
+	p, err := f.Future.Ptr()
+	return Selector_select_Params(p.Struct()), err
+}
+
+type Selector_select_Results capnp.Struct
+
+// Selector_select_Results_TypeID is the unique identifier for the type Selector_select_Results.
+const Selector_select_Results_TypeID = 0xecdc50ec062316bb
+
+func NewSelector_select_Results(s *capnp.Segment) (Selector_select_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Selector_select_Results(st), err
+}
+
+func NewRootSelector_select_Results(s *capnp.Segment) (Selector_select_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Selector_select_Results(st), err
+}
+
+func ReadRootSelector_select_Results(msg *capnp.Message) (Selector_select_Results, error) {
+	root, err := msg.Root()
+	return Selector_select_Results(root.Struct()), err
+}
+
+func (s Selector_select_Results) String() string {
+	str, _ := text.Marshal(0xecdc50ec062316bb, capnp.Struct(s))
+	return str
+}
+
+func (s Selector_select_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Selector_select_Results) DecodeFromPtr(p capnp.Ptr) Selector_select_Results {
+	return Selector_select_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Selector_select_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Selector_select_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Selector_select_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Selector_select_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Selector_select_Results) Index() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s Selector_select_Results) SetIndex(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s Selector_select_Results) Value() (capnp.Ptr, error) {
+	return capnp.Struct(s).Ptr(0)
+}
+
+func (s Selector_select_Results) HasValue() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Selector_select_Results) SetValue(v capnp.Ptr) error {
+	return capnp.Struct(s).SetPtr(0, v)
+}
+func (s Selector_select_Results) Closed() bool {
+	return capnp.Struct(s).Bit(32)
+}
+
+func (s Selector_select_Results) SetClosed(v bool) {
+	capnp.Struct(s).SetBit(32, v)
+}
+
+// Selector_select_Results_List is a list of Selector_select_Results.
+type Selector_select_Results_List = capnp.StructList[Selector_select_Results]
+
+// NewSelector_select_Results creates a new list of Selector_select_Results.
+func NewSelector_select_Results_List(s *capnp.Segment, sz int32) (Selector_select_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Selector_select_Results](l), err
+}
+
+// Selector_select_Results_Future is a wrapper for a Selector_select_Results promised by a client call.
+type Selector_select_Results_Future struct{ *capnp.Future }
+
+func (f Selector_select_Results_Future) Struct() (Selector_select_Results, error) {
+	p, err := f.Future.Ptr()
+	return Selector_select_Results(p.Struct()), err
+}
+func (p Selector_select_Results_Future) Value() *capnp.Future {
+	return p.Future.Field(0, nil)
+}
+
+const schema_872a451f9aa74ebf = "x\xda\x94W]l\x14\xd5\x17?gfvgv\xd3" +
+	"e{{\x0b\x84\xff\x1f\xb2D\xab\xd8\x9a6-\x15\x90" +
+	"\x02\xd9]\x96\xa6`\x80\xec\xac\x98(\xb1\x89\xc3v\x80" +
+	"\xca2\x85\x9d\x96\x02\x86\x87>\x10\x82\x06}\x81\x98\x10" +
+	"\x13B4\"\x10\x85\x07\x88JIL\xc4\x07)5Q" +
+	"_\x14CC4\x10>\x04\x0c~\x06L\x1ds\xef\xec" +
+	"|\xec\xee\xd0\xda'\xc8\x9d\xb3\xe7\x9c\xdf\xef\xfc\xceG" +
+	"[O\x8b)\xa9-fE@P\xfbCakd\xe7" +
+	"\xdde\xef\x9c\xdf8\x04d:\x02\x84P\xae\xc5\xf6\xeb" +
+	"R\x14\x01\xe9])\x09h\x9d]xr\xe3\xee9\xff" +
+	"\xdf\x0f\x84\"\x80\xc4\xbe\xc7B\x8f!H\x96\xfa\xd7\xc6" +
+	"\xcb7\x8e|y\x08\xc84\xd1\xfal\xed\xb1\xc3\x89\xce" +
+	"\xa6}\x00P\x8b\xf4\x0f\xe90\x1d\x97d\x00\xfa@\xda" +
+	"G\x8f\x86\xe6Q\x12\x8e[\x1f\xee>\xf3\xc3\xd8\xaf\x0f" +
+	"\xde\x06u6\xa2\xd5n\xfd\xfd\xd1\xcas\xef^\x84\xe9" +
+	"\x82\x8c\x00\xed\xa10\x8f\x1a\x0b\xb3\xa8\x17\x8b\xd3b\x9f" +
+	"\x0f\xbd~\xc4\x8ej\xa7\xd5\x1cnb\x06\x0b\xb8\xc1\x9f" +
+	"\xabG^\x09?L\x9e\x06u&\xda\x16\x00\xed{m" +
+	"\x8b\x03\xe1A@\xeb\xe0\x8c\x8e\xe8\x9a\xb7n\x9e\xf6#" +
+	"\x1b\x0f\xd71\x83\x90\xcc\\\xfc|f\xee\xe8\x1dl\x1b" +
+	"\xb6\x0d8\xb2'\xe4:\x86\xec\xf8\x92\xf7\xb6\x8f\xc6\x16" +
+	"\x0d\x03\x99\xe5b\x96s\xec\x0b\xc6\x9e^~\xf6\xdb\xcc" +
+	"\x17\xc1\x10\x1e\xd8\x10P\x1e\x04\xdfG\x96\xa1\x85\xbf|" +
+	"\xfa\xd2\xe8\x9bK~\x83NA\x16\x00h\xb7\xfc\x13\xed" +
+	"\x95\x19C:\xb7\x1e:\x89\x19\xac\xdd>b\x87\xb4\xb3" +
+	"\xdd/\xafg\xee\x0e\xf1l_\xf8\x00c_%\xcf_" +
+	"\xf23r]~\x8e\x19\xdc\xe7\x06\xb7\x7f\xdf\xfd\xc6\xa9" +
+	"\x97\xef]r\xe0\x00\xb4w*\xffcI\xdf~\xb2!" +
+	"ve\xcf\xa21\x1f\xd0f%\xca\xbe\xc85\xb37\xc5" +
+	"\x8e\x87\xae\x06\x94p\xbar\x82\xceQX\x82\xb3\x94." +
+	"\xda\xab\xc8\xb4W\x89[\x07\xde\xdfr9s\xf0\xc05" +
+	"\x1f3\xdd\x0ag\xe6\xea\x85\xef^\xfbq\xec\xd5\x1b>" +
+	"\x9d\xacR\x96\xb3/\xf7f\xdc~\xb6\xfe\xee\xf0\xcd\x80" +
+	" m\xca\x09\xba\x98\x07Y\xa0t\xd1\xbd\x8aL\xf7*" +
+	"qk\xed\xad\xfa=\x87\xbf9v+\xe0\x07\xbb\x94\x11" +
+	"f\x06@\x87\x94}\xb412\x8f\xf6F\xe2\xd6\xf0\x8c" +
+	"\xc7\xc3w\xb2W\xee\x94\x89\xa1;2\x9f\x91\xa3GN" +
+	"\x01Z\xf7g]\xf8xWj\xec\xbe\x9f\xde\xf1\x08\xa7" +
+	"7\x12M\x82\xaf>\xe51\x916F\xcf\xd1\xb6\xe8L" +
+	"\x00\xba8\xdaE\xb5\xa8\x0c`]\xd8\xb5(\xf6\xc9\xb5" +
+	"\xaf\x1fV\x19\xaf\x8a\x9e\xa0*3\xa1k\xa2]t\x80" +
+	"\x1b/}q\xe1\xe8\xd1\xef\xe5q_]\xba\xa3u\x08" +
+	"\xadV~\xb3f\x18z\xa1E\xcck\xdb\x8cm\x1d\xcf" +
+	"\xebF\x8f^l1u\xa3\xa7!\xab\x15\xb5\xad&\x80" +
+	"*\x89\x12\x80\x84\x00$6\x1f@UDT\xeb\x05L" +
+	"\xec\xd0\x0a\x03:\xd6!z\xec\x02`\x1d`\xa5\xd3\xcc" +
+	"f\xcdh1\xf4\xc1\x9c\x9e\xdf\xa1\x17\x99_Y\xdbj" +
+	"\xbaV\xe8X\x89\x9a\x91ET\x151\x04\xe0\xd6\x12\x1d" +
+	"\xd1\x91\xb6\"@\xba\x15\xd3)$\xaa\x8c\xdeT@\xa7" +
+	"QIg\x0e \xbd\x02\xd3\xeb\x90\xe8\xb2e\xe8\x83\x0c" +
+	"N\x06\x12\x85>S/\x12L\xa8\x92\x80\xde\xc8@\x86" +
+	"(\xe81\x85\x96\x93-\xe0\x94~\xa7*\x88\x9et\xd2" +
+	"\x0az\xeaN\xb7\xa2\xf3#\xd7\x80\xf9\x99\xa9J\x88\x88" +
+	"Y\x119\x95\xaeC/\x86\xebbb\xf3\xb4\x84\x04\xeb" +
+	"p]uI\x0bz\xbe\xbf\xaf\xd8\x92\xd1L\xbd\xa5\xa8" +
+	"\xe7w\x94\x97\xb4\xc3+i\xb2\xc8+\x84\xc4\x17\x13\x91" +
+	"LZ\xd1\x9cn\xc6\x07\x0a\xfdf\x90\xdb\x15\xc1n!" +
+	"\x85S\xc4\x17\x94\x87\x8b\xcd\xe4\xffi\xc8jq\xa6Y" +
+	"Uq\xf3hd\x8am\x10QM\x09\x88X\xcfk\xb7" +
+	"l\x03\x80\xbaTDu\xa5\x80\x89\xbcf\xea&N\x03" +
+	"\x1e\xb2\xd6\x9b\x98\x80\xec\xd12\xfa\x8c\x0d\x85\xbe\xfc\x16" +
+	"\x90{\x8dM\x88  V\xa7a\xd3\xc0\xb9e\\\x0c" +
+	"\x14\xfa\xd1\x9c\xbck|\xb8\x83\xba\xc6\xdf\x8a\x8eS\xd7" +
+	"F\xf2l2\\\xdd-%\xb5\xdb\xfd\xa5mE\xb3\xda" +
+	"\x9f_\x07\xcc-\xcfJ\x02\xb0Yi\xec(1\xd5*" +
+	" aT\x09\x00\xa4\x99\xa5\xfe\x94\x88\xea3\x02&M" +
+	"\xee\x1f\x89\xaf\xdf\xb9:\x1cL \x94\xe1\x10*\xe3\xca" +
+	"\x9a\xa9\xdb\x0d.\xd5X\x16\xa2\xb7\x8cIc\x13\x081" +
+	"\xfc\x87=\xba\xeb\x8d\x90&\x10\xe2\x8c\xd48\x8b\xfc\x9f" +
+	"\xb0s\xa2\xc4G+1\x00\x82O\x89\xbe\xc7*%\xfa" +
+	"\x9a\xb6L\x89RyGxY\x95\x8aV1@\xd7\x03" +
+	"\xa85\xb6\xf4,\xb3d\x0b\"O\xc9\x17!5\xd5Y" +
+	"\x11\xd8\xa469y\xf6\x8fK\xccD\xd2u\x06~\xe5" +
+	"d\xce\xe9qf\xc4J'\xf1\xd9\xec,st\xee\x1b" +
+	"V*H\xd7`z6\x92f\x99\xd7\xac\xaa\xbb\xfdc" +
+	"\xb3\xec1\x85Y\xc4\xaa\xe9\x15X\xe5\x12\xb3\x95\x0a\x0f" +
+	"?\xb2\x06%H\x8e\xa1\xe33n\xf4\xf8\xf18\x07(" +
+	":\xe7X\x05\x1eV\xa8*\x8d\xf8\xf1\x94=\x06\xe3\x11" +
+	"*\xf1\x00x\xcb\xce9\xf6\xd09\xc1H[\xae|\xd9" +
+	"9G\x0f:WD\xf0\xb2\xf3/,W:\xfeL\xcb" +
+	"\x1e\xedE\xc7\xd3\x99\xe2\xef\xf8\xa2sQ\xb3E\xe7\xde" +
+	"#\x01\xacL\xdcN\x93.\xae\xd2p\xcf\xe9\x09\xdeP" +
+	"j\x8d\xdbN\x9dl<\xa5DTW{\xd3}\x15{" +
+	"[!\xa2\x9a\x15\x90\x08s\xed9\xb6\x86\x0d\x81\x95\"" +
+	"\xaa\xeb\x04L\xf4\x1a=\xfaN\x94@@\xa9rx%" +
+	"y\xb7\xf4T\x0d\xfa\x89\xd48\xd1\xcc\xa9\x17J.Y" +
+	"\x83{\x14\x95/Ut\x9c'm\xc0\x8c)o\x11\x11" +
+	"l\x8a\xb3\x91]\xd2\xaa\xf3G\x07:\x07'!\x1d " +
+	"\x90\x90\x9c\xb4i\xe2\xea\xab\xba\xad\x0aq\x96\x83\xa7x" +
+	"\xe7 D\xe7b'd>\xf7\x92\xe0\xd9\x96;\x09\x9a" +
+	"'\xa5\x16\xfcw\x00\xd1\xe7\xed\x07"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -2247,10 +2827,14 @@ func RegisterSchema(reg *schemas.Registry) {
 			0x8166bc9c3ded78ca,
 			0x891b1d7a66ab36b5,
 			0x95c89fe7d966f751,
+			0x97f9f2dddab47aad,
 			0x9f8a81c20d0e72c9,
+			0xb03ffa0660ca4cf6,
 			0xb0e88f4d0a3a1694,
 			0xbb3101eccc20b4eb,
 			0xbb370dcc71a43ba9,
+			0xc443d2b5422b0d01,
+			0xc9a3ba48aefbff33,
 			0xca7110014301ab81,
 			0xcbbc3fcd0d01a855,
 			0xcbee5caf8b7af4ea,
@@ -2260,7 +2844,9 @@ func RegisterSchema(reg *schemas.Registry) {
 			0xe76adde17bd7c3df,
 			0xe8bbed1438ea16ee,
 			0xe9a7d19a7d14e94e,
+			0xecdc50ec062316bb,
 			0xf1dd4079b7c319f1,
+			0xf33b8ecc59b9ef01,
 			0xfad0e4b80d3779c3,
 			0xfd07d8a1cc36583c,
 		},
@@ -10,6 +10,7 @@ import (
 	server "capnproto.org/go/capnp/v3/server"
 	stream "capnproto.org/go/capnp/v3/std/capnp/stream"
 	context "context"
+	math "math"
 	strconv "strconv"
 )
 
@@ -334,12 +335,12 @@ type Heartbeat capnp.Struct
 const Heartbeat_TypeID = 0xa97471079836f720
 
 func NewHeartbeat(s *capnp.Segment) (Heartbeat, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 4})
 	return Heartbeat(st), err
 }
 
 func NewRootHeartbeat(s *capnp.Segment) (Heartbeat, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 4})
 	return Heartbeat(st), err
 }
 
@@ -432,13 +433,57 @@ func (s Heartbeat) NewMeta(n int32) (capnp.TextList, error) {
 	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
 	return l, err
 }
+func (s Heartbeat) Resources() (Resources, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return Resources(p.Struct()), err
+}
+
+func (s Heartbeat) HasResources() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s Heartbeat) SetResources(v Resources) error {
+	return capnp.Struct(s).SetPtr(2, capnp.Struct(v).ToPtr())
+}
+
+// NewResources sets the resources field to a newly
+// allocated Resources struct, preferring placement in s's segment.
+func (s Heartbeat) NewResources() (Resources, error) {
+	ss, err := NewResources(capnp.Struct(s).Segment())
+	if err != nil {
+		return Resources{}, err
+	}
+	err = capnp.Struct(s).SetPtr(2, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s Heartbeat) Auth() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return []byte(p.Data()), err
+}
+
+func (s Heartbeat) HasAuth() bool {
+	return capnp.Struct(s).HasPtr(3)
+}
+
+func (s Heartbeat) SetAuth(v []byte) error {
+	return capnp.Struct(s).SetData(3, v)
+}
+
+func (s Heartbeat) Leaving() bool {
+	return capnp.Struct(s).Bit(32)
+}
+
+func (s Heartbeat) SetLeaving(v bool) {
+	capnp.Struct(s).SetBit(32, v)
+}
 
 // Heartbeat_List is a list of Heartbeat.
 type Heartbeat_List = capnp.StructList[Heartbeat]
 
 // NewHeartbeat creates a new list of Heartbeat.
 func NewHeartbeat_List(s *capnp.Segment, sz int32) (Heartbeat_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 4}, sz)
 	return capnp.StructList[Heartbeat](l), err
 }
 
@@ -449,73 +494,272 @@ func (f Heartbeat_Future) Struct() (Heartbeat, error) {
 	p, err := f.Future.Ptr()
 	return Heartbeat(p.Struct()), err
 }
+func (p Heartbeat_Future) Resources() Resources_Future {
+	return Resources_Future{Future: p.Future.Field(2, nil)}
+}
 
-type View capnp.Client
+type Resources capnp.Struct
 
-// View_TypeID is the unique identifier for the type View.
-const View_TypeID = 0x8a1df0335afc249a
+// Resources_TypeID is the unique identifier for the type Resources.
+const Resources_TypeID = 0xda4a0b60c66ec81d
 
-func (c View) Lookup(ctx context.Context, params func(View_lookup_Params) error) (View_lookup_Results_Future, capnp.ReleaseFunc) {
+func NewResources(s *capnp.Segment) (Resources, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 0})
+	return Resources(st), err
+}
+
+func NewRootResources(s *capnp.Segment) (Resources, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 0})
+	return Resources(st), err
+}
+
+func ReadRootResources(msg *capnp.Message) (Resources, error) {
+	root, err := msg.Root()
+	return Resources(root.Struct()), err
+}
+
+func (s Resources) String() string {
+	str, _ := text.Marshal(0xda4a0b60c66ec81d, capnp.Struct(s))
+	return str
+}
+
+func (s Resources) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Resources) DecodeFromPtr(p capnp.Ptr) Resources {
+	return Resources(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Resources) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Resources) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Resources) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Resources) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Resources) Cpus() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s Resources) SetCpus(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+func (s Resources) CpuLoad() float64 {
+	return math.Float64frombits(capnp.Struct(s).Uint64(8))
+}
+
+func (s Resources) SetCpuLoad(v float64) {
+	capnp.Struct(s).SetUint64(8, math.Float64bits(v))
+}
+
+func (s Resources) MemTotal() uint64 {
+	return capnp.Struct(s).Uint64(16)
+}
+
+func (s Resources) SetMemTotal(v uint64) {
+	capnp.Struct(s).SetUint64(16, v)
+}
+
+func (s Resources) MemFree() uint64 {
+	return capnp.Struct(s).Uint64(24)
+}
+
+func (s Resources) SetMemFree(v uint64) {
+	capnp.Struct(s).SetUint64(24, v)
+}
+
+func (s Resources) Procs() uint32 {
+	return capnp.Struct(s).Uint32(4)
+}
+
+func (s Resources) SetProcs(v uint32) {
+	capnp.Struct(s).SetUint32(4, v)
+}
+
+func (s Resources) Capacity() uint32 {
+	return capnp.Struct(s).Uint32(32)
+}
+
+func (s Resources) SetCapacity(v uint32) {
+	capnp.Struct(s).SetUint32(32, v)
+}
+
+// Resources_List is a list of Resources.
+type Resources_List = capnp.StructList[Resources]
+
+// NewResources creates a new list of Resources.
+func NewResources_List(s *capnp.Segment, sz int32) (Resources_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 40, PointerCount: 0}, sz)
+	return capnp.StructList[Resources](l), err
+}
+
+// Resources_Future is a wrapper for a Resources promised by a client call.
+type Resources_Future struct{ *capnp.Future }
+
+func (f Resources_Future) Struct() (Resources, error) {
+	p, err := f.Future.Ptr()
+	return Resources(p.Struct()), err
+}
+
+type Resource uint16
+
+// Resource_TypeID is the unique identifier for the type Resource.
+const Resource_TypeID = 0xe5559cf892c7e446
+
+// Values of Resource.
+const (
+	Resource_cpus     Resource = 0
+	Resource_cpuLoad  Resource = 1
+	Resource_memTotal Resource = 2
+	Resource_memFree  Resource = 3
+	Resource_procs    Resource = 4
+	Resource_capacity Resource = 5
+)
+
+// String returns the enum's constant name.
+func (c Resource) String() string {
+	switch c {
+	case Resource_cpus:
+		return "cpus"
+	case Resource_cpuLoad:
+		return "cpuLoad"
+	case Resource_memTotal:
+		return "memTotal"
+	case Resource_memFree:
+		return "memFree"
+	case Resource_procs:
+		return "procs"
+	case Resource_capacity:
+		return "capacity"
+
+	default:
+		return ""
+	}
+}
+
+// ResourceFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func ResourceFromString(c string) Resource {
+	switch c {
+	case "cpus":
+		return Resource_cpus
+	case "cpuLoad":
+		return Resource_cpuLoad
+	case "memTotal":
+		return Resource_memTotal
+	case "memFree":
+		return Resource_memFree
+	case "procs":
+		return Resource_procs
+	case "capacity":
+		return Resource_capacity
+
+	default:
+		return 0
+	}
+}
+
+type Resource_List = capnp.EnumList[Resource]
+
+func NewResource_List(s *capnp.Segment, sz int32) (Resource_List, error) {
+	return capnp.NewEnumList[Resource](s, sz)
+}
+
+type Host capnp.Client
+
+// Host_TypeID is the unique identifier for the type Host.
+const Host_TypeID = 0x957cbefc645fd307
+
+func (c Host) SetMeta(ctx context.Context, params func(Host_setMeta_Params) error) (Host_setMeta_Results_Future, capnp.ReleaseFunc) {
 
 	s := capnp.Send{
 		Method: capnp.Method{
-			InterfaceID:   0x8a1df0335afc249a,
+			InterfaceID:   0x957cbefc645fd307,
 			MethodID:      0,
-			InterfaceName: "cluster.capnp:View",
-			MethodName:    "lookup",
+			InterfaceName: "cluster.capnp:Host",
+			MethodName:    "setMeta",
 		},
 	}
 	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 2}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(View_lookup_Params(s)) }
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Host_setMeta_Params(s)) }
 	}
 
 	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return View_lookup_Results_Future{Future: ans.Future()}, release
+	return Host_setMeta_Results_Future{Future: ans.Future()}, release
 
 }
 
-func (c View) Iter(ctx context.Context, params func(View_iter_Params) error) (View_iter_Results_Future, capnp.ReleaseFunc) {
+func (c Host) UnsetMeta(ctx context.Context, params func(Host_unsetMeta_Params) error) (Host_unsetMeta_Results_Future, capnp.ReleaseFunc) {
 
 	s := capnp.Send{
 		Method: capnp.Method{
-			InterfaceID:   0x8a1df0335afc249a,
+			InterfaceID:   0x957cbefc645fd307,
 			MethodID:      1,
-			InterfaceName: "cluster.capnp:View",
-			MethodName:    "iter",
+			InterfaceName: "cluster.capnp:Host",
+			MethodName:    "unsetMeta",
 		},
 	}
 	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 3}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(View_iter_Params(s)) }
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Host_unsetMeta_Params(s)) }
 	}
 
 	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return View_iter_Results_Future{Future: ans.Future()}, release
+	return Host_unsetMeta_Results_Future{Future: ans.Future()}, release
 
 }
 
-func (c View) Reverse(ctx context.Context, params func(View_reverse_Params) error) (View_reverse_Results_Future, capnp.ReleaseFunc) {
+func (c Host) Meta(ctx context.Context, params func(Host_meta_Params) error) (Host_meta_Results_Future, capnp.ReleaseFunc) {
 
 	s := capnp.Send{
 		Method: capnp.Method{
-			InterfaceID:   0x8a1df0335afc249a,
+			InterfaceID:   0x957cbefc645fd307,
 			MethodID:      2,
-			InterfaceName: "cluster.capnp:View",
-			MethodName:    "reverse",
+			InterfaceName: "cluster.capnp:Host",
+			MethodName:    "meta",
 		},
 	}
 	if params != nil {
 		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(View_reverse_Params(s)) }
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Host_meta_Params(s)) }
 	}
 
 	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return View_reverse_Results_Future{Future: ans.Future()}, release
+	return Host_meta_Results_Future{Future: ans.Future()}, release
 
 }
 
-func (c View) WaitStreaming() error {
+func (c Host) AddPreparer(ctx context.Context, params func(Host_addPreparer_Params) error) (Host_addPreparer_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x957cbefc645fd307,
+			MethodID:      3,
+			InterfaceName: "cluster.capnp:Host",
+			MethodName:    "addPreparer",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Host_addPreparer_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Host_addPreparer_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c Host) WaitStreaming() error {
 	return capnp.Client(c).WaitStreaming()
 }
 
@@ -523,14 +767,14 @@ func (c View) WaitStreaming() error {
 // purposes.  Its format should not be depended on: in particular, it
 // should not be used to compare clients.  Use IsSame to compare clients
 // for equality.
-func (c View) String() string {
-	return "View(" + capnp.Client(c).String() + ")"
+func (c Host) String() string {
+	return "Host(" + capnp.Client(c).String() + ")"
 }
 
 // AddRef creates a new Client that refers to the same capability as c.
 // If c is nil or has resolved to null, then AddRef returns nil.
-func (c View) AddRef() View {
-	return View(capnp.Client(c).AddRef())
+func (c Host) AddRef() Host {
+	return Host(capnp.Client(c).AddRef())
 }
 
 // Release releases a capability reference.  If this is the last
@@ -539,28 +783,28 @@ func (c View) AddRef() View {
 //
 // Release will panic if c has already been released, but not if c is
 // nil or resolved to null.
-func (c View) Release() {
+func (c Host) Release() {
 	capnp.Client(c).Release()
 }
 
 // Resolve blocks until the capability is fully resolved or the Context
 // expires.
-func (c View) Resolve(ctx context.Context) error {
+func (c Host) Resolve(ctx context.Context) error {
 	return capnp.Client(c).Resolve(ctx)
 }
 
-func (c View) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (c Host) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Client(c).EncodeAsPtr(seg)
 }
 
-func (View) DecodeFromPtr(p capnp.Ptr) View {
-	return View(capnp.Client{}.DecodeFromPtr(p))
+func (Host) DecodeFromPtr(p capnp.Ptr) Host {
+	return Host(capnp.Client{}.DecodeFromPtr(p))
 }
 
 // IsValid reports whether c is a valid reference to a capability.
 // A reference is invalid if it is nil, has resolved to null, or has
 // been released.
-func (c View) IsValid() bool {
+func (c Host) IsValid() bool {
 	return capnp.Client(c).IsValid()
 }
 
@@ -568,7 +812,7 @@ func (c View) IsValid() bool {
 // same call to NewClient.  This can return false negatives if c or other
 // are not fully resolved: use Resolve if this is an issue.  If either
 // c or other are released, then IsSame panics.
-func (c View) IsSame(other View) bool {
+func (c Host) IsSame(other Host) bool {
 	return capnp.Client(c).IsSame(capnp.Client(other))
 }
 
@@ -576,167 +820,200 @@ func (c View) IsSame(other View) bool {
 // this client. This affects all future calls, but not calls already
 // waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
 // which is also the default.
-func (c View) SetFlowLimiter(lim fc.FlowLimiter) {
+func (c Host) SetFlowLimiter(lim fc.FlowLimiter) {
 	capnp.Client(c).SetFlowLimiter(lim)
 }
 
 // Get the current flowcontrol.FlowLimiter used to manage flow control
 // for this client.
-func (c View) GetFlowLimiter() fc.FlowLimiter {
+func (c Host) GetFlowLimiter() fc.FlowLimiter {
 	return capnp.Client(c).GetFlowLimiter()
 }
 
-// A View_Server is a View with a local implementation.
-type View_Server interface {
-	Lookup(context.Context, View_lookup) error
+// A Host_Server is a Host with a local implementation.
+type Host_Server interface {
+	SetMeta(context.Context, Host_setMeta) error
 
-	Iter(context.Context, View_iter) error
+	UnsetMeta(context.Context, Host_unsetMeta) error
 
-	Reverse(context.Context, View_reverse) error
+	Meta(context.Context, Host_meta) error
+
+	AddPreparer(context.Context, Host_addPreparer) error
 }
 
-// View_NewServer creates a new Server from an implementation of View_Server.
-func View_NewServer(s View_Server) *server.Server {
+// Host_NewServer creates a new Server from an implementation of Host_Server.
+func Host_NewServer(s Host_Server) *server.Server {
 	c, _ := s.(server.Shutdowner)
-	return server.New(View_Methods(nil, s), s, c)
+	return server.New(Host_Methods(nil, s), s, c)
 }
 
-// View_ServerToClient creates a new Client from an implementation of View_Server.
+// Host_ServerToClient creates a new Client from an implementation of Host_Server.
 // The caller is responsible for calling Release on the returned Client.
-func View_ServerToClient(s View_Server) View {
-	return View(capnp.NewClient(View_NewServer(s)))
+func Host_ServerToClient(s Host_Server) Host {
+	return Host(capnp.NewClient(Host_NewServer(s)))
 }
 
-// View_Methods appends Methods to a slice that invoke the methods on s.
+// Host_Methods appends Methods to a slice that invoke the methods on s.
 // This can be used to create a more complicated Server.
-func View_Methods(methods []server.Method, s View_Server) []server.Method {
+func Host_Methods(methods []server.Method, s Host_Server) []server.Method {
 	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 3)
+		methods = make([]server.Method, 0, 4)
 	}
 
 	methods = append(methods, server.Method{
 		Method: capnp.Method{
-			InterfaceID:   0x8a1df0335afc249a,
+			InterfaceID:   0x957cbefc645fd307,
 			MethodID:      0,
-			InterfaceName: "cluster.capnp:View",
-			MethodName:    "lookup",
+			InterfaceName: "cluster.capnp:Host",
+			MethodName:    "setMeta",
 		},
 		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.Lookup(ctx, View_lookup{call})
+			return s.SetMeta(ctx, Host_setMeta{call})
 		},
 	})
 
 	methods = append(methods, server.Method{
 		Method: capnp.Method{
-			InterfaceID:   0x8a1df0335afc249a,
+			InterfaceID:   0x957cbefc645fd307,
 			MethodID:      1,
-			InterfaceName: "cluster.capnp:View",
-			MethodName:    "iter",
+			InterfaceName: "cluster.capnp:Host",
+			MethodName:    "unsetMeta",
 		},
 		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.Iter(ctx, View_iter{call})
+			return s.UnsetMeta(ctx, Host_unsetMeta{call})
 		},
 	})
 
 	methods = append(methods, server.Method{
 		Method: capnp.Method{
-			InterfaceID:   0x8a1df0335afc249a,
+			InterfaceID:   0x957cbefc645fd307,
 			MethodID:      2,
-			InterfaceName: "cluster.capnp:View",
-			MethodName:    "reverse",
+			InterfaceName: "cluster.capnp:Host",
+			MethodName:    "meta",
 		},
 		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.Reverse(ctx, View_reverse{call})
+			return s.Meta(ctx, Host_meta{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x957cbefc645fd307,
+			MethodID:      3,
+			InterfaceName: "cluster.capnp:Host",
+			MethodName:    "addPreparer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.AddPreparer(ctx, Host_addPreparer{call})
 		},
 	})
 
 	return methods
 }
 
-// View_lookup holds the state for a server call to View.lookup.
+// Host_setMeta holds the state for a server call to Host.setMeta.
 // See server.Call for documentation.
-type View_lookup struct {
+type Host_setMeta struct {
 	*server.Call
 }
 
 // Args returns the call's arguments.
-func (c View_lookup) Args() View_lookup_Params {
-	return View_lookup_Params(c.Call.Args())
+func (c Host_setMeta) Args() Host_setMeta_Params {
+	return Host_setMeta_Params(c.Call.Args())
 }
 
 // AllocResults allocates the results struct.
-func (c View_lookup) AllocResults() (View_lookup_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return View_lookup_Results(r), err
+func (c Host_setMeta) AllocResults() (Host_setMeta_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_setMeta_Results(r), err
 }
 
-// View_iter holds the state for a server call to View.iter.
+// Host_unsetMeta holds the state for a server call to Host.unsetMeta.
 // See server.Call for documentation.
-type View_iter struct {
+type Host_unsetMeta struct {
 	*server.Call
 }
 
 // Args returns the call's arguments.
-func (c View_iter) Args() View_iter_Params {
-	return View_iter_Params(c.Call.Args())
+func (c Host_unsetMeta) Args() Host_unsetMeta_Params {
+	return Host_unsetMeta_Params(c.Call.Args())
 }
 
 // AllocResults allocates the results struct.
-func (c View_iter) AllocResults() (View_iter_Results, error) {
+func (c Host_unsetMeta) AllocResults() (Host_unsetMeta_Results, error) {
 	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return View_iter_Results(r), err
+	return Host_unsetMeta_Results(r), err
 }
 
-// View_reverse holds the state for a server call to View.reverse.
+// Host_meta holds the state for a server call to Host.meta.
 // See server.Call for documentation.
-type View_reverse struct {
+type Host_meta struct {
 	*server.Call
 }
 
 // Args returns the call's arguments.
-func (c View_reverse) Args() View_reverse_Params {
-	return View_reverse_Params(c.Call.Args())
+func (c Host_meta) Args() Host_meta_Params {
+	return Host_meta_Params(c.Call.Args())
 }
 
 // AllocResults allocates the results struct.
-func (c View_reverse) AllocResults() (View_reverse_Results, error) {
+func (c Host_meta) AllocResults() (Host_meta_Results, error) {
 	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return View_reverse_Results(r), err
+	return Host_meta_Results(r), err
 }
 
-// View_List is a list of View.
-type View_List = capnp.CapList[View]
+// Host_addPreparer holds the state for a server call to Host.addPreparer.
+// See server.Call for documentation.
+type Host_addPreparer struct {
+	*server.Call
+}
 
-// NewView creates a new list of View.
-func NewView_List(s *capnp.Segment, sz int32) (View_List, error) {
+// Args returns the call's arguments.
+func (c Host_addPreparer) Args() Host_addPreparer_Params {
+	return Host_addPreparer_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Host_addPreparer) AllocResults() (Host_addPreparer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_addPreparer_Results(r), err
+}
+
+// Host_List is a list of Host.
+type Host_List = capnp.CapList[Host]
+
+// NewHost creates a new list of Host.
+func NewHost_List(s *capnp.Segment, sz int32) (Host_List, error) {
 	l, err := capnp.NewPointerList(s, sz)
-	return capnp.CapList[View](l), err
+	return capnp.CapList[Host](l), err
 }
 
-type View_Handler capnp.Client
+type Host_Preparer capnp.Client
 
-// View_Handler_TypeID is the unique identifier for the type View_Handler.
-const View_Handler_TypeID = 0xee93a663b2a23c03
+// Host_Preparer_TypeID is the unique identifier for the type Host_Preparer.
+const Host_Preparer_TypeID = 0xf415b5b5dc743de4
+
+func (c Host_Preparer) Prepare(ctx context.Context, params func(Host_Preparer_prepare_Params) error) (Host_Preparer_prepare_Results_Future, capnp.ReleaseFunc) {
 
-func (c View_Handler) Recv(ctx context.Context, params func(View_Handler_recv_Params) error) error {
 	s := capnp.Send{
 		Method: capnp.Method{
-			InterfaceID:   0xee93a663b2a23c03,
+			InterfaceID:   0xf415b5b5dc743de4,
 			MethodID:      0,
-			InterfaceName: "cluster.capnp:View.Handler",
-			MethodName:    "recv",
+			InterfaceName: "cluster.capnp:Host.Preparer",
+			MethodName:    "prepare",
 		},
 	}
 	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(View_Handler_recv_Params(s)) }
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Host_Preparer_prepare_Params(s)) }
 	}
 
-	return capnp.Client(c).SendStreamCall(ctx, s)
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Host_Preparer_prepare_Results_Future{Future: ans.Future()}, release
 
 }
 
-func (c View_Handler) WaitStreaming() error {
+func (c Host_Preparer) WaitStreaming() error {
 	return capnp.Client(c).WaitStreaming()
 }
 
@@ -744,14 +1021,14 @@ func (c View_Handler) WaitStreaming() error {
 // purposes.  Its format should not be depended on: in particular, it
 // should not be used to compare clients.  Use IsSame to compare clients
 // for equality.
-func (c View_Handler) String() string {
-	return "View_Handler(" + capnp.Client(c).String() + ")"
+func (c Host_Preparer) String() string {
+	return "Host_Preparer(" + capnp.Client(c).String() + ")"
 }
 
 // AddRef creates a new Client that refers to the same capability as c.
 // If c is nil or has resolved to null, then AddRef returns nil.
-func (c View_Handler) AddRef() View_Handler {
-	return View_Handler(capnp.Client(c).AddRef())
+func (c Host_Preparer) AddRef() Host_Preparer {
+	return Host_Preparer(capnp.Client(c).AddRef())
 }
 
 // Release releases a capability reference.  If this is the last
@@ -760,28 +1037,28 @@ func (c View_Handler) AddRef() View_Handler {
 //
 // Release will panic if c has already been released, but not if c is
 // nil or resolved to null.
-func (c View_Handler) Release() {
+func (c Host_Preparer) Release() {
 	capnp.Client(c).Release()
 }
 
 // Resolve blocks until the capability is fully resolved or the Context
 // expires.
-func (c View_Handler) Resolve(ctx context.Context) error {
+func (c Host_Preparer) Resolve(ctx context.Context) error {
 	return capnp.Client(c).Resolve(ctx)
 }
 
-func (c View_Handler) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (c Host_Preparer) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Client(c).EncodeAsPtr(seg)
 }
 
-func (View_Handler) DecodeFromPtr(p capnp.Ptr) View_Handler {
-	return View_Handler(capnp.Client{}.DecodeFromPtr(p))
+func (Host_Preparer) DecodeFromPtr(p capnp.Ptr) Host_Preparer {
+	return Host_Preparer(capnp.Client{}.DecodeFromPtr(p))
 }
 
 // IsValid reports whether c is a valid reference to a capability.
 // A reference is invalid if it is nil, has resolved to null, or has
 // been released.
-func (c View_Handler) IsValid() bool {
+func (c Host_Preparer) IsValid() bool {
 	return capnp.Client(c).IsValid()
 }
 
@@ -789,7 +1066,7 @@ func (c View_Handler) IsValid() bool {
 // same call to NewClient.  This can return false negatives if c or other
 // are not fully resolved: use Resolve if this is an issue.  If either
 // c or other are released, then IsSame panics.
-func (c View_Handler) IsSame(other View_Handler) bool {
+func (c Host_Preparer) IsSame(other Host_Preparer) bool {
 	return capnp.Client(c).IsSame(capnp.Client(other))
 }
 
@@ -797,481 +1074,2749 @@ func (c View_Handler) IsSame(other View_Handler) bool {
 // this client. This affects all future calls, but not calls already
 // waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
 // which is also the default.
-func (c View_Handler) SetFlowLimiter(lim fc.FlowLimiter) {
+func (c Host_Preparer) SetFlowLimiter(lim fc.FlowLimiter) {
 	capnp.Client(c).SetFlowLimiter(lim)
 }
 
 // Get the current flowcontrol.FlowLimiter used to manage flow control
 // for this client.
-func (c View_Handler) GetFlowLimiter() fc.FlowLimiter {
+func (c Host_Preparer) GetFlowLimiter() fc.FlowLimiter {
 	return capnp.Client(c).GetFlowLimiter()
 }
 
-// A View_Handler_Server is a View_Handler with a local implementation.
-type View_Handler_Server interface {
-	Recv(context.Context, View_Handler_recv) error
+// A Host_Preparer_Server is a Host_Preparer with a local implementation.
+type Host_Preparer_Server interface {
+	Prepare(context.Context, Host_Preparer_prepare) error
 }
 
-// View_Handler_NewServer creates a new Server from an implementation of View_Handler_Server.
-func View_Handler_NewServer(s View_Handler_Server) *server.Server {
+// Host_Preparer_NewServer creates a new Server from an implementation of Host_Preparer_Server.
+func Host_Preparer_NewServer(s Host_Preparer_Server) *server.Server {
 	c, _ := s.(server.Shutdowner)
-	return server.New(View_Handler_Methods(nil, s), s, c)
+	return server.New(Host_Preparer_Methods(nil, s), s, c)
 }
 
-// View_Handler_ServerToClient creates a new Client from an implementation of View_Handler_Server.
+// Host_Preparer_ServerToClient creates a new Client from an implementation of Host_Preparer_Server.
 // The caller is responsible for calling Release on the returned Client.
-func View_Handler_ServerToClient(s View_Handler_Server) View_Handler {
-	return View_Handler(capnp.NewClient(View_Handler_NewServer(s)))
+func Host_Preparer_ServerToClient(s Host_Preparer_Server) Host_Preparer {
+	return Host_Preparer(capnp.NewClient(Host_Preparer_NewServer(s)))
 }
 
-// View_Handler_Methods appends Methods to a slice that invoke the methods on s.
+// Host_Preparer_Methods appends Methods to a slice that invoke the methods on s.
 // This can be used to create a more complicated Server.
-func View_Handler_Methods(methods []server.Method, s View_Handler_Server) []server.Method {
+func Host_Preparer_Methods(methods []server.Method, s Host_Preparer_Server) []server.Method {
 	if cap(methods) == 0 {
 		methods = make([]server.Method, 0, 1)
 	}
 
 	methods = append(methods, server.Method{
 		Method: capnp.Method{
-			InterfaceID:   0xee93a663b2a23c03,
+			InterfaceID:   0xf415b5b5dc743de4,
 			MethodID:      0,
-			InterfaceName: "cluster.capnp:View.Handler",
-			MethodName:    "recv",
+			InterfaceName: "cluster.capnp:Host.Preparer",
+			MethodName:    "prepare",
 		},
 		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.Recv(ctx, View_Handler_recv{call})
+			return s.Prepare(ctx, Host_Preparer_prepare{call})
 		},
 	})
 
 	return methods
 }
 
-// View_Handler_recv holds the state for a server call to View_Handler.recv.
+// Host_Preparer_prepare holds the state for a server call to Host_Preparer.prepare.
 // See server.Call for documentation.
-type View_Handler_recv struct {
+type Host_Preparer_prepare struct {
 	*server.Call
 }
 
 // Args returns the call's arguments.
-func (c View_Handler_recv) Args() View_Handler_recv_Params {
-	return View_Handler_recv_Params(c.Call.Args())
+func (c Host_Preparer_prepare) Args() Host_Preparer_prepare_Params {
+	return Host_Preparer_prepare_Params(c.Call.Args())
 }
 
 // AllocResults allocates the results struct.
-func (c View_Handler_recv) AllocResults() (stream.StreamResult, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return stream.StreamResult(r), err
+func (c Host_Preparer_prepare) AllocResults() (Host_Preparer_prepare_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_Preparer_prepare_Results(r), err
 }
 
-// View_Handler_List is a list of View_Handler.
-type View_Handler_List = capnp.CapList[View_Handler]
+// Host_Preparer_List is a list of Host_Preparer.
+type Host_Preparer_List = capnp.CapList[Host_Preparer]
 
-// NewView_Handler creates a new list of View_Handler.
-func NewView_Handler_List(s *capnp.Segment, sz int32) (View_Handler_List, error) {
+// NewHost_Preparer creates a new list of Host_Preparer.
+func NewHost_Preparer_List(s *capnp.Segment, sz int32) (Host_Preparer_List, error) {
 	l, err := capnp.NewPointerList(s, sz)
-	return capnp.CapList[View_Handler](l), err
+	return capnp.CapList[Host_Preparer](l), err
 }
 
-type View_Handler_recv_Params capnp.Struct
+type Host_Preparer_prepare_Params capnp.Struct
 
-// View_Handler_recv_Params_TypeID is the unique identifier for the type View_Handler_recv_Params.
-const View_Handler_recv_Params_TypeID = 0x8eb96dceb6a99ebd
+// Host_Preparer_prepare_Params_TypeID is the unique identifier for the type Host_Preparer_prepare_Params.
+const Host_Preparer_prepare_Params_TypeID = 0xe2f525cbf9d07c02
 
-func NewView_Handler_recv_Params(s *capnp.Segment) (View_Handler_recv_Params, error) {
+func NewHost_Preparer_prepare_Params(s *capnp.Segment) (Host_Preparer_prepare_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_Preparer_prepare_Params(st), err
+}
+
+func NewRootHost_Preparer_prepare_Params(s *capnp.Segment) (Host_Preparer_prepare_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_Preparer_prepare_Params(st), err
+}
+
+func ReadRootHost_Preparer_prepare_Params(msg *capnp.Message) (Host_Preparer_prepare_Params, error) {
+	root, err := msg.Root()
+	return Host_Preparer_prepare_Params(root.Struct()), err
+}
+
+func (s Host_Preparer_prepare_Params) String() string {
+	str, _ := text.Marshal(0xe2f525cbf9d07c02, capnp.Struct(s))
+	return str
+}
+
+func (s Host_Preparer_prepare_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Host_Preparer_prepare_Params) DecodeFromPtr(p capnp.Ptr) Host_Preparer_prepare_Params {
+	return Host_Preparer_prepare_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Host_Preparer_prepare_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Host_Preparer_prepare_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Host_Preparer_prepare_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Host_Preparer_prepare_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Host_Preparer_prepare_Params_List is a list of Host_Preparer_prepare_Params.
+type Host_Preparer_prepare_Params_List = capnp.StructList[Host_Preparer_prepare_Params]
+
+// NewHost_Preparer_prepare_Params creates a new list of Host_Preparer_prepare_Params.
+func NewHost_Preparer_prepare_Params_List(s *capnp.Segment, sz int32) (Host_Preparer_prepare_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Host_Preparer_prepare_Params](l), err
+}
+
+// Host_Preparer_prepare_Params_Future is a wrapper for a Host_Preparer_prepare_Params promised by a client call.
+type Host_Preparer_prepare_Params_Future struct{ *capnp.Future }
+
+func (f Host_Preparer_prepare_Params_Future) Struct() (Host_Preparer_prepare_Params, error) {
+	p, err := f.Future.Ptr()
+	return Host_Preparer_prepare_Params(p.Struct()), err
+}
+
+type Host_Preparer_prepare_Results capnp.Struct
+
+// Host_Preparer_prepare_Results_TypeID is the unique identifier for the type Host_Preparer_prepare_Results.
+const Host_Preparer_prepare_Results_TypeID = 0xa9e031f65eaff864
+
+func NewHost_Preparer_prepare_Results(s *capnp.Segment) (Host_Preparer_prepare_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return View_Handler_recv_Params(st), err
+	return Host_Preparer_prepare_Results(st), err
 }
 
-func NewRootView_Handler_recv_Params(s *capnp.Segment) (View_Handler_recv_Params, error) {
+func NewRootHost_Preparer_prepare_Results(s *capnp.Segment) (Host_Preparer_prepare_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return View_Handler_recv_Params(st), err
+	return Host_Preparer_prepare_Results(st), err
 }
 
-func ReadRootView_Handler_recv_Params(msg *capnp.Message) (View_Handler_recv_Params, error) {
+func ReadRootHost_Preparer_prepare_Results(msg *capnp.Message) (Host_Preparer_prepare_Results, error) {
 	root, err := msg.Root()
-	return View_Handler_recv_Params(root.Struct()), err
+	return Host_Preparer_prepare_Results(root.Struct()), err
 }
 
-func (s View_Handler_recv_Params) String() string {
-	str, _ := text.Marshal(0x8eb96dceb6a99ebd, capnp.Struct(s))
+func (s Host_Preparer_prepare_Results) String() string {
+	str, _ := text.Marshal(0xa9e031f65eaff864, capnp.Struct(s))
 	return str
 }
 
-func (s View_Handler_recv_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Host_Preparer_prepare_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (View_Handler_recv_Params) DecodeFromPtr(p capnp.Ptr) View_Handler_recv_Params {
-	return View_Handler_recv_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (Host_Preparer_prepare_Results) DecodeFromPtr(p capnp.Ptr) Host_Preparer_prepare_Results {
+	return Host_Preparer_prepare_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s View_Handler_recv_Params) ToPtr() capnp.Ptr {
+func (s Host_Preparer_prepare_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s View_Handler_recv_Params) IsValid() bool {
+func (s Host_Preparer_prepare_Results) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s View_Handler_recv_Params) Message() *capnp.Message {
+func (s Host_Preparer_prepare_Results) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s View_Handler_recv_Params) Segment() *capnp.Segment {
+func (s Host_Preparer_prepare_Results) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s View_Handler_recv_Params) Record() (View_Record, error) {
+func (s Host_Preparer_prepare_Results) Fields() (capnp.TextList, error) {
 	p, err := capnp.Struct(s).Ptr(0)
-	return View_Record(p.Struct()), err
+	return capnp.TextList(p.List()), err
 }
 
-func (s View_Handler_recv_Params) HasRecord() bool {
+func (s Host_Preparer_prepare_Results) HasFields() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s View_Handler_recv_Params) SetRecord(v View_Record) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Host_Preparer_prepare_Results) SetFields(v capnp.TextList) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
 }
 
-// NewRecord sets the record field to a newly
-// allocated View_Record struct, preferring placement in s's segment.
-func (s View_Handler_recv_Params) NewRecord() (View_Record, error) {
-	ss, err := NewView_Record(capnp.Struct(s).Segment())
+// NewFields sets the fields field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Host_Preparer_prepare_Results) NewFields(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
 	if err != nil {
-		return View_Record{}, err
+		return capnp.TextList{}, err
 	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
 }
 
-// View_Handler_recv_Params_List is a list of View_Handler_recv_Params.
-type View_Handler_recv_Params_List = capnp.StructList[View_Handler_recv_Params]
+// Host_Preparer_prepare_Results_List is a list of Host_Preparer_prepare_Results.
+type Host_Preparer_prepare_Results_List = capnp.StructList[Host_Preparer_prepare_Results]
 
-// NewView_Handler_recv_Params creates a new list of View_Handler_recv_Params.
-func NewView_Handler_recv_Params_List(s *capnp.Segment, sz int32) (View_Handler_recv_Params_List, error) {
+// NewHost_Preparer_prepare_Results creates a new list of Host_Preparer_prepare_Results.
+func NewHost_Preparer_prepare_Results_List(s *capnp.Segment, sz int32) (Host_Preparer_prepare_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[View_Handler_recv_Params](l), err
+	return capnp.StructList[Host_Preparer_prepare_Results](l), err
 }
 
-// View_Handler_recv_Params_Future is a wrapper for a View_Handler_recv_Params promised by a client call.
-type View_Handler_recv_Params_Future struct{ *capnp.Future }
+// Host_Preparer_prepare_Results_Future is a wrapper for a Host_Preparer_prepare_Results promised by a client call.
+type Host_Preparer_prepare_Results_Future struct{ *capnp.Future }
 
-func (f View_Handler_recv_Params_Future) Struct() (View_Handler_recv_Params, error) {
+func (f Host_Preparer_prepare_Results_Future) Struct() (Host_Preparer_prepare_Results, error) {
 	p, err := f.Future.Ptr()
-	return View_Handler_recv_Params(p.Struct()), err
-}
-func (p View_Handler_recv_Params_Future) Record() View_Record_Future {
-	return View_Record_Future{Future: p.Future.Field(0, nil)}
+	return Host_Preparer_prepare_Results(p.Struct()), err
 }
 
-type View_Selector capnp.Struct
-type View_Selector_Which uint16
-
-const (
-	View_Selector_Which_all   View_Selector_Which = 0
-	View_Selector_Which_match View_Selector_Which = 1
-	View_Selector_Which_from  View_Selector_Which = 2
-)
-
-func (w View_Selector_Which) String() string {
-	const s = "allmatchfrom"
-	switch w {
-	case View_Selector_Which_all:
-		return s[0:3]
-	case View_Selector_Which_match:
-		return s[3:8]
-	case View_Selector_Which_from:
-		return s[8:12]
-
-	}
-	return "View_Selector_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
-}
+type Host_setMeta_Params capnp.Struct
 
-// View_Selector_TypeID is the unique identifier for the type View_Selector.
-const View_Selector_TypeID = 0xb2029ff7b712d18a
+// Host_setMeta_Params_TypeID is the unique identifier for the type Host_setMeta_Params.
+const Host_setMeta_Params_TypeID = 0xa404c24b5375b9e4
 
-func NewView_Selector(s *capnp.Segment) (View_Selector, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return View_Selector(st), err
+func NewHost_setMeta_Params(s *capnp.Segment) (Host_setMeta_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_setMeta_Params(st), err
 }
 
-func NewRootView_Selector(s *capnp.Segment) (View_Selector, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return View_Selector(st), err
+func NewRootHost_setMeta_Params(s *capnp.Segment) (Host_setMeta_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_setMeta_Params(st), err
 }
 
-func ReadRootView_Selector(msg *capnp.Message) (View_Selector, error) {
+func ReadRootHost_setMeta_Params(msg *capnp.Message) (Host_setMeta_Params, error) {
 	root, err := msg.Root()
-	return View_Selector(root.Struct()), err
+	return Host_setMeta_Params(root.Struct()), err
 }
 
-func (s View_Selector) String() string {
-	str, _ := text.Marshal(0xb2029ff7b712d18a, capnp.Struct(s))
+func (s Host_setMeta_Params) String() string {
+	str, _ := text.Marshal(0xa404c24b5375b9e4, capnp.Struct(s))
 	return str
 }
 
-func (s View_Selector) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Host_setMeta_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (View_Selector) DecodeFromPtr(p capnp.Ptr) View_Selector {
-	return View_Selector(capnp.Struct{}.DecodeFromPtr(p))
+func (Host_setMeta_Params) DecodeFromPtr(p capnp.Ptr) Host_setMeta_Params {
+	return Host_setMeta_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s View_Selector) ToPtr() capnp.Ptr {
+func (s Host_setMeta_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-
-func (s View_Selector) Which() View_Selector_Which {
-	return View_Selector_Which(capnp.Struct(s).Uint16(0))
-}
-func (s View_Selector) IsValid() bool {
+func (s Host_setMeta_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s View_Selector) Message() *capnp.Message {
+func (s Host_setMeta_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s View_Selector) Segment() *capnp.Segment {
+func (s Host_setMeta_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s View_Selector) SetAll() {
-	capnp.Struct(s).SetUint16(0, 0)
-
-}
-
-func (s View_Selector) Match() (View_Index, error) {
-	if capnp.Struct(s).Uint16(0) != 1 {
-		panic("Which() != match")
-	}
+func (s Host_setMeta_Params) Fields() (capnp.TextList, error) {
 	p, err := capnp.Struct(s).Ptr(0)
-	return View_Index(p.Struct()), err
+	return capnp.TextList(p.List()), err
 }
 
-func (s View_Selector) HasMatch() bool {
-	if capnp.Struct(s).Uint16(0) != 1 {
-		return false
-	}
+func (s Host_setMeta_Params) HasFields() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s View_Selector) SetMatch(v View_Index) error {
-	capnp.Struct(s).SetUint16(0, 1)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Host_setMeta_Params) SetFields(v capnp.TextList) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
 }
 
-// NewMatch sets the match field to a newly
-// allocated View_Index struct, preferring placement in s's segment.
-func (s View_Selector) NewMatch() (View_Index, error) {
-	capnp.Struct(s).SetUint16(0, 1)
-	ss, err := NewView_Index(capnp.Struct(s).Segment())
+// NewFields sets the fields field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Host_setMeta_Params) NewFields(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
 	if err != nil {
-		return View_Index{}, err
+		return capnp.TextList{}, err
 	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
 }
 
-func (s View_Selector) From() (View_Index, error) {
-	if capnp.Struct(s).Uint16(0) != 2 {
-		panic("Which() != from")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return View_Index(p.Struct()), err
+// Host_setMeta_Params_List is a list of Host_setMeta_Params.
+type Host_setMeta_Params_List = capnp.StructList[Host_setMeta_Params]
+
+// NewHost_setMeta_Params creates a new list of Host_setMeta_Params.
+func NewHost_setMeta_Params_List(s *capnp.Segment, sz int32) (Host_setMeta_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Host_setMeta_Params](l), err
 }
 
-func (s View_Selector) HasFrom() bool {
-	if capnp.Struct(s).Uint16(0) != 2 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// Host_setMeta_Params_Future is a wrapper for a Host_setMeta_Params promised by a client call.
+type Host_setMeta_Params_Future struct{ *capnp.Future }
+
+func (f Host_setMeta_Params_Future) Struct() (Host_setMeta_Params, error) {
+	p, err := f.Future.Ptr()
+	return Host_setMeta_Params(p.Struct()), err
 }
 
-func (s View_Selector) SetFrom(v View_Index) error {
-	capnp.Struct(s).SetUint16(0, 2)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+type Host_setMeta_Results capnp.Struct
+
+// Host_setMeta_Results_TypeID is the unique identifier for the type Host_setMeta_Results.
+const Host_setMeta_Results_TypeID = 0x8f58928e854cd4f5
+
+func NewHost_setMeta_Results(s *capnp.Segment) (Host_setMeta_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_setMeta_Results(st), err
 }
 
-// NewFrom sets the from field to a newly
-// allocated View_Index struct, preferring placement in s's segment.
-func (s View_Selector) NewFrom() (View_Index, error) {
-	capnp.Struct(s).SetUint16(0, 2)
-	ss, err := NewView_Index(capnp.Struct(s).Segment())
-	if err != nil {
-		return View_Index{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func NewRootHost_setMeta_Results(s *capnp.Segment) (Host_setMeta_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_setMeta_Results(st), err
 }
 
-// View_Selector_List is a list of View_Selector.
-type View_Selector_List = capnp.StructList[View_Selector]
+func ReadRootHost_setMeta_Results(msg *capnp.Message) (Host_setMeta_Results, error) {
+	root, err := msg.Root()
+	return Host_setMeta_Results(root.Struct()), err
+}
 
-// NewView_Selector creates a new list of View_Selector.
-func NewView_Selector_List(s *capnp.Segment, sz int32) (View_Selector_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[View_Selector](l), err
+func (s Host_setMeta_Results) String() string {
+	str, _ := text.Marshal(0x8f58928e854cd4f5, capnp.Struct(s))
+	return str
 }
 
-// View_Selector_Future is a wrapper for a View_Selector promised by a client call.
-type View_Selector_Future struct{ *capnp.Future }
+func (s Host_setMeta_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
 
-func (f View_Selector_Future) Struct() (View_Selector, error) {
-	p, err := f.Future.Ptr()
-	return View_Selector(p.Struct()), err
+func (Host_setMeta_Results) DecodeFromPtr(p capnp.Ptr) Host_setMeta_Results {
+	return Host_setMeta_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
-func (p View_Selector_Future) Match() View_Index_Future {
-	return View_Index_Future{Future: p.Future.Field(0, nil)}
+
+func (s Host_setMeta_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
 }
-func (p View_Selector_Future) From() View_Index_Future {
-	return View_Index_Future{Future: p.Future.Field(0, nil)}
+func (s Host_setMeta_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-type View_Constraint capnp.Struct
-type View_Constraint_Which uint16
+func (s Host_setMeta_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
 
-const (
-	View_Constraint_Which_limit View_Constraint_Which = 0
-	View_Constraint_Which_to    View_Constraint_Which = 1
-)
+func (s Host_setMeta_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
 
-func (w View_Constraint_Which) String() string {
-	const s = "limitto"
-	switch w {
-	case View_Constraint_Which_limit:
-		return s[0:5]
-	case View_Constraint_Which_to:
-		return s[5:7]
+// Host_setMeta_Results_List is a list of Host_setMeta_Results.
+type Host_setMeta_Results_List = capnp.StructList[Host_setMeta_Results]
 
-	}
-	return "View_Constraint_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+// NewHost_setMeta_Results creates a new list of Host_setMeta_Results.
+func NewHost_setMeta_Results_List(s *capnp.Segment, sz int32) (Host_setMeta_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Host_setMeta_Results](l), err
+}
+
+// Host_setMeta_Results_Future is a wrapper for a Host_setMeta_Results promised by a client call.
+type Host_setMeta_Results_Future struct{ *capnp.Future }
+
+func (f Host_setMeta_Results_Future) Struct() (Host_setMeta_Results, error) {
+	p, err := f.Future.Ptr()
+	return Host_setMeta_Results(p.Struct()), err
+}
+
+type Host_unsetMeta_Params capnp.Struct
+
+// Host_unsetMeta_Params_TypeID is the unique identifier for the type Host_unsetMeta_Params.
+const Host_unsetMeta_Params_TypeID = 0xe5b5227505fcaa99
+
+func NewHost_unsetMeta_Params(s *capnp.Segment) (Host_unsetMeta_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_unsetMeta_Params(st), err
+}
+
+func NewRootHost_unsetMeta_Params(s *capnp.Segment) (Host_unsetMeta_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_unsetMeta_Params(st), err
+}
+
+func ReadRootHost_unsetMeta_Params(msg *capnp.Message) (Host_unsetMeta_Params, error) {
+	root, err := msg.Root()
+	return Host_unsetMeta_Params(root.Struct()), err
+}
+
+func (s Host_unsetMeta_Params) String() string {
+	str, _ := text.Marshal(0xe5b5227505fcaa99, capnp.Struct(s))
+	return str
+}
+
+func (s Host_unsetMeta_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Host_unsetMeta_Params) DecodeFromPtr(p capnp.Ptr) Host_unsetMeta_Params {
+	return Host_unsetMeta_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Host_unsetMeta_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Host_unsetMeta_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Host_unsetMeta_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Host_unsetMeta_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Host_unsetMeta_Params) Keys() (capnp.TextList, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return capnp.TextList(p.List()), err
+}
+
+func (s Host_unsetMeta_Params) HasKeys() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Host_unsetMeta_Params) SetKeys(v capnp.TextList) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewKeys sets the keys field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Host_unsetMeta_Params) NewKeys(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+
+// Host_unsetMeta_Params_List is a list of Host_unsetMeta_Params.
+type Host_unsetMeta_Params_List = capnp.StructList[Host_unsetMeta_Params]
+
+// NewHost_unsetMeta_Params creates a new list of Host_unsetMeta_Params.
+func NewHost_unsetMeta_Params_List(s *capnp.Segment, sz int32) (Host_unsetMeta_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Host_unsetMeta_Params](l), err
+}
+
+// Host_unsetMeta_Params_Future is a wrapper for a Host_unsetMeta_Params promised by a client call.
+type Host_unsetMeta_Params_Future struct{ *capnp.Future }
+
+func (f Host_unsetMeta_Params_Future) Struct() (Host_unsetMeta_Params, error) {
+	p, err := f.Future.Ptr()
+	return Host_unsetMeta_Params(p.Struct()), err
+}
+
+type Host_unsetMeta_Results capnp.Struct
+
+// Host_unsetMeta_Results_TypeID is the unique identifier for the type Host_unsetMeta_Results.
+const Host_unsetMeta_Results_TypeID = 0xdc88f975f5090eee
+
+func NewHost_unsetMeta_Results(s *capnp.Segment) (Host_unsetMeta_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_unsetMeta_Results(st), err
+}
+
+func NewRootHost_unsetMeta_Results(s *capnp.Segment) (Host_unsetMeta_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_unsetMeta_Results(st), err
+}
+
+func ReadRootHost_unsetMeta_Results(msg *capnp.Message) (Host_unsetMeta_Results, error) {
+	root, err := msg.Root()
+	return Host_unsetMeta_Results(root.Struct()), err
+}
+
+func (s Host_unsetMeta_Results) String() string {
+	str, _ := text.Marshal(0xdc88f975f5090eee, capnp.Struct(s))
+	return str
+}
+
+func (s Host_unsetMeta_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Host_unsetMeta_Results) DecodeFromPtr(p capnp.Ptr) Host_unsetMeta_Results {
+	return Host_unsetMeta_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Host_unsetMeta_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Host_unsetMeta_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Host_unsetMeta_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Host_unsetMeta_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Host_unsetMeta_Results_List is a list of Host_unsetMeta_Results.
+type Host_unsetMeta_Results_List = capnp.StructList[Host_unsetMeta_Results]
+
+// NewHost_unsetMeta_Results creates a new list of Host_unsetMeta_Results.
+func NewHost_unsetMeta_Results_List(s *capnp.Segment, sz int32) (Host_unsetMeta_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Host_unsetMeta_Results](l), err
+}
+
+// Host_unsetMeta_Results_Future is a wrapper for a Host_unsetMeta_Results promised by a client call.
+type Host_unsetMeta_Results_Future struct{ *capnp.Future }
+
+func (f Host_unsetMeta_Results_Future) Struct() (Host_unsetMeta_Results, error) {
+	p, err := f.Future.Ptr()
+	return Host_unsetMeta_Results(p.Struct()), err
+}
+
+type Host_meta_Params capnp.Struct
+
+// Host_meta_Params_TypeID is the unique identifier for the type Host_meta_Params.
+const Host_meta_Params_TypeID = 0x828b2823e5eeb7be
+
+func NewHost_meta_Params(s *capnp.Segment) (Host_meta_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_meta_Params(st), err
+}
+
+func NewRootHost_meta_Params(s *capnp.Segment) (Host_meta_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_meta_Params(st), err
+}
+
+func ReadRootHost_meta_Params(msg *capnp.Message) (Host_meta_Params, error) {
+	root, err := msg.Root()
+	return Host_meta_Params(root.Struct()), err
+}
+
+func (s Host_meta_Params) String() string {
+	str, _ := text.Marshal(0x828b2823e5eeb7be, capnp.Struct(s))
+	return str
+}
+
+func (s Host_meta_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Host_meta_Params) DecodeFromPtr(p capnp.Ptr) Host_meta_Params {
+	return Host_meta_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Host_meta_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Host_meta_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Host_meta_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Host_meta_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Host_meta_Params_List is a list of Host_meta_Params.
+type Host_meta_Params_List = capnp.StructList[Host_meta_Params]
+
+// NewHost_meta_Params creates a new list of Host_meta_Params.
+func NewHost_meta_Params_List(s *capnp.Segment, sz int32) (Host_meta_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Host_meta_Params](l), err
+}
+
+// Host_meta_Params_Future is a wrapper for a Host_meta_Params promised by a client call.
+type Host_meta_Params_Future struct{ *capnp.Future }
+
+func (f Host_meta_Params_Future) Struct() (Host_meta_Params, error) {
+	p, err := f.Future.Ptr()
+	return Host_meta_Params(p.Struct()), err
+}
+
+type Host_meta_Results capnp.Struct
+
+// Host_meta_Results_TypeID is the unique identifier for the type Host_meta_Results.
+const Host_meta_Results_TypeID = 0xcabb5c85a457450b
+
+func NewHost_meta_Results(s *capnp.Segment) (Host_meta_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_meta_Results(st), err
+}
+
+func NewRootHost_meta_Results(s *capnp.Segment) (Host_meta_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_meta_Results(st), err
+}
+
+func ReadRootHost_meta_Results(msg *capnp.Message) (Host_meta_Results, error) {
+	root, err := msg.Root()
+	return Host_meta_Results(root.Struct()), err
+}
+
+func (s Host_meta_Results) String() string {
+	str, _ := text.Marshal(0xcabb5c85a457450b, capnp.Struct(s))
+	return str
+}
+
+func (s Host_meta_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Host_meta_Results) DecodeFromPtr(p capnp.Ptr) Host_meta_Results {
+	return Host_meta_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Host_meta_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Host_meta_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Host_meta_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Host_meta_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Host_meta_Results) Fields() (capnp.TextList, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return capnp.TextList(p.List()), err
+}
+
+func (s Host_meta_Results) HasFields() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Host_meta_Results) SetFields(v capnp.TextList) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewFields sets the fields field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Host_meta_Results) NewFields(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+
+// Host_meta_Results_List is a list of Host_meta_Results.
+type Host_meta_Results_List = capnp.StructList[Host_meta_Results]
+
+// NewHost_meta_Results creates a new list of Host_meta_Results.
+func NewHost_meta_Results_List(s *capnp.Segment, sz int32) (Host_meta_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Host_meta_Results](l), err
+}
+
+// Host_meta_Results_Future is a wrapper for a Host_meta_Results promised by a client call.
+type Host_meta_Results_Future struct{ *capnp.Future }
+
+func (f Host_meta_Results_Future) Struct() (Host_meta_Results, error) {
+	p, err := f.Future.Ptr()
+	return Host_meta_Results(p.Struct()), err
+}
+
+type Host_addPreparer_Params capnp.Struct
+
+// Host_addPreparer_Params_TypeID is the unique identifier for the type Host_addPreparer_Params.
+const Host_addPreparer_Params_TypeID = 0x89ec8e1ef0f263f3
+
+func NewHost_addPreparer_Params(s *capnp.Segment) (Host_addPreparer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_addPreparer_Params(st), err
+}
+
+func NewRootHost_addPreparer_Params(s *capnp.Segment) (Host_addPreparer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_addPreparer_Params(st), err
+}
+
+func ReadRootHost_addPreparer_Params(msg *capnp.Message) (Host_addPreparer_Params, error) {
+	root, err := msg.Root()
+	return Host_addPreparer_Params(root.Struct()), err
+}
+
+func (s Host_addPreparer_Params) String() string {
+	str, _ := text.Marshal(0x89ec8e1ef0f263f3, capnp.Struct(s))
+	return str
+}
+
+func (s Host_addPreparer_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Host_addPreparer_Params) DecodeFromPtr(p capnp.Ptr) Host_addPreparer_Params {
+	return Host_addPreparer_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Host_addPreparer_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Host_addPreparer_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Host_addPreparer_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Host_addPreparer_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Host_addPreparer_Params) Preparer() Host_Preparer {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return Host_Preparer(p.Interface().Client())
+}
+
+func (s Host_addPreparer_Params) HasPreparer() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Host_addPreparer_Params) SetPreparer(v Host_Preparer) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// Host_addPreparer_Params_List is a list of Host_addPreparer_Params.
+type Host_addPreparer_Params_List = capnp.StructList[Host_addPreparer_Params]
+
+// NewHost_addPreparer_Params creates a new list of Host_addPreparer_Params.
+func NewHost_addPreparer_Params_List(s *capnp.Segment, sz int32) (Host_addPreparer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Host_addPreparer_Params](l), err
+}
+
+// Host_addPreparer_Params_Future is a wrapper for a Host_addPreparer_Params promised by a client call.
+type Host_addPreparer_Params_Future struct{ *capnp.Future }
+
+func (f Host_addPreparer_Params_Future) Struct() (Host_addPreparer_Params, error) {
+	p, err := f.Future.Ptr()
+	return Host_addPreparer_Params(p.Struct()), err
+}
+func (p Host_addPreparer_Params_Future) Preparer() Host_Preparer {
+	return Host_Preparer(p.Future.Field(0, nil).Client())
+}
+
+type Host_addPreparer_Results capnp.Struct
+
+// Host_addPreparer_Results_TypeID is the unique identifier for the type Host_addPreparer_Results.
+const Host_addPreparer_Results_TypeID = 0xbe186003ae0f0429
+
+func NewHost_addPreparer_Results(s *capnp.Segment) (Host_addPreparer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_addPreparer_Results(st), err
+}
+
+func NewRootHost_addPreparer_Results(s *capnp.Segment) (Host_addPreparer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_addPreparer_Results(st), err
+}
+
+func ReadRootHost_addPreparer_Results(msg *capnp.Message) (Host_addPreparer_Results, error) {
+	root, err := msg.Root()
+	return Host_addPreparer_Results(root.Struct()), err
+}
+
+func (s Host_addPreparer_Results) String() string {
+	str, _ := text.Marshal(0xbe186003ae0f0429, capnp.Struct(s))
+	return str
+}
+
+func (s Host_addPreparer_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Host_addPreparer_Results) DecodeFromPtr(p capnp.Ptr) Host_addPreparer_Results {
+	return Host_addPreparer_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Host_addPreparer_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Host_addPreparer_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Host_addPreparer_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Host_addPreparer_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Host_addPreparer_Results_List is a list of Host_addPreparer_Results.
+type Host_addPreparer_Results_List = capnp.StructList[Host_addPreparer_Results]
+
+// NewHost_addPreparer_Results creates a new list of Host_addPreparer_Results.
+func NewHost_addPreparer_Results_List(s *capnp.Segment, sz int32) (Host_addPreparer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Host_addPreparer_Results](l), err
+}
+
+// Host_addPreparer_Results_Future is a wrapper for a Host_addPreparer_Results promised by a client call.
+type Host_addPreparer_Results_Future struct{ *capnp.Future }
+
+func (f Host_addPreparer_Results_Future) Struct() (Host_addPreparer_Results, error) {
+	p, err := f.Future.Ptr()
+	return Host_addPreparer_Results(p.Struct()), err
+}
+
+type View capnp.Client
+
+// View_TypeID is the unique identifier for the type View.
+const View_TypeID = 0x8a1df0335afc249a
+
+func (c View) Lookup(ctx context.Context, params func(View_lookup_Params) error) (View_lookup_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x8a1df0335afc249a,
+			MethodID:      0,
+			InterfaceName: "cluster.capnp:View",
+			MethodName:    "lookup",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 2}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(View_lookup_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return View_lookup_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c View) Iter(ctx context.Context, params func(View_iter_Params) error) (View_iter_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x8a1df0335afc249a,
+			MethodID:      1,
+			InterfaceName: "cluster.capnp:View",
+			MethodName:    "iter",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 3}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(View_iter_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return View_iter_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c View) Reverse(ctx context.Context, params func(View_reverse_Params) error) (View_reverse_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x8a1df0335afc249a,
+			MethodID:      2,
+			InterfaceName: "cluster.capnp:View",
+			MethodName:    "reverse",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(View_reverse_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return View_reverse_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c View) Watch(ctx context.Context, params func(View_watch_Params) error) (View_watch_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x8a1df0335afc249a,
+			MethodID:      3,
+			InterfaceName: "cluster.capnp:View",
+			MethodName:    "watch",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 3}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(View_watch_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return View_watch_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c View) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c View) String() string {
+	return "View(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c View) AddRef() View {
+	return View(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c View) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c View) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c View) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (View) DecodeFromPtr(p capnp.Ptr) View {
+	return View(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c View) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c View) IsSame(other View) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c View) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c View) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A View_Server is a View with a local implementation.
+type View_Server interface {
+	Lookup(context.Context, View_lookup) error
+
+	Iter(context.Context, View_iter) error
+
+	Reverse(context.Context, View_reverse) error
+
+	Watch(context.Context, View_watch) error
+}
+
+// View_NewServer creates a new Server from an implementation of View_Server.
+func View_NewServer(s View_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(View_Methods(nil, s), s, c)
+}
+
+// View_ServerToClient creates a new Client from an implementation of View_Server.
+// The caller is responsible for calling Release on the returned Client.
+func View_ServerToClient(s View_Server) View {
+	return View(capnp.NewClient(View_NewServer(s)))
+}
+
+// View_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func View_Methods(methods []server.Method, s View_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 4)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x8a1df0335afc249a,
+			MethodID:      0,
+			InterfaceName: "cluster.capnp:View",
+			MethodName:    "lookup",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Lookup(ctx, View_lookup{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x8a1df0335afc249a,
+			MethodID:      1,
+			InterfaceName: "cluster.capnp:View",
+			MethodName:    "iter",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Iter(ctx, View_iter{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x8a1df0335afc249a,
+			MethodID:      2,
+			InterfaceName: "cluster.capnp:View",
+			MethodName:    "reverse",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Reverse(ctx, View_reverse{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x8a1df0335afc249a,
+			MethodID:      3,
+			InterfaceName: "cluster.capnp:View",
+			MethodName:    "watch",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Watch(ctx, View_watch{call})
+		},
+	})
+
+	return methods
+}
+
+// View_lookup holds the state for a server call to View.lookup.
+// See server.Call for documentation.
+type View_lookup struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c View_lookup) Args() View_lookup_Params {
+	return View_lookup_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c View_lookup) AllocResults() (View_lookup_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return View_lookup_Results(r), err
+}
+
+// View_iter holds the state for a server call to View.iter.
+// See server.Call for documentation.
+type View_iter struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c View_iter) Args() View_iter_Params {
+	return View_iter_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c View_iter) AllocResults() (View_iter_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return View_iter_Results(r), err
+}
+
+// View_reverse holds the state for a server call to View.reverse.
+// See server.Call for documentation.
+type View_reverse struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c View_reverse) Args() View_reverse_Params {
+	return View_reverse_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c View_reverse) AllocResults() (View_reverse_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return View_reverse_Results(r), err
+}
+
+// View_watch holds the state for a server call to View.watch.
+// See server.Call for documentation.
+type View_watch struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c View_watch) Args() View_watch_Params {
+	return View_watch_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c View_watch) AllocResults() (View_watch_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return View_watch_Results(r), err
+}
+
+// View_List is a list of View.
+type View_List = capnp.CapList[View]
+
+// NewView creates a new list of View.
+func NewView_List(s *capnp.Segment, sz int32) (View_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[View](l), err
+}
+
+type View_Handler capnp.Client
+
+// View_Handler_TypeID is the unique identifier for the type View_Handler.
+const View_Handler_TypeID = 0xee93a663b2a23c03
+
+func (c View_Handler) Recv(ctx context.Context, params func(View_Handler_recv_Params) error) error {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xee93a663b2a23c03,
+			MethodID:      0,
+			InterfaceName: "cluster.capnp:View.Handler",
+			MethodName:    "recv",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(View_Handler_recv_Params(s)) }
+	}
+
+	return capnp.Client(c).SendStreamCall(ctx, s)
+
+}
+
+func (c View_Handler) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c View_Handler) String() string {
+	return "View_Handler(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c View_Handler) AddRef() View_Handler {
+	return View_Handler(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c View_Handler) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c View_Handler) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c View_Handler) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (View_Handler) DecodeFromPtr(p capnp.Ptr) View_Handler {
+	return View_Handler(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c View_Handler) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c View_Handler) IsSame(other View_Handler) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c View_Handler) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c View_Handler) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A View_Handler_Server is a View_Handler with a local implementation.
+type View_Handler_Server interface {
+	Recv(context.Context, View_Handler_recv) error
+}
+
+// View_Handler_NewServer creates a new Server from an implementation of View_Handler_Server.
+func View_Handler_NewServer(s View_Handler_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(View_Handler_Methods(nil, s), s, c)
+}
+
+// View_Handler_ServerToClient creates a new Client from an implementation of View_Handler_Server.
+// The caller is responsible for calling Release on the returned Client.
+func View_Handler_ServerToClient(s View_Handler_Server) View_Handler {
+	return View_Handler(capnp.NewClient(View_Handler_NewServer(s)))
+}
+
+// View_Handler_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func View_Handler_Methods(methods []server.Method, s View_Handler_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 1)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xee93a663b2a23c03,
+			MethodID:      0,
+			InterfaceName: "cluster.capnp:View.Handler",
+			MethodName:    "recv",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Recv(ctx, View_Handler_recv{call})
+		},
+	})
+
+	return methods
+}
+
+// View_Handler_recv holds the state for a server call to View_Handler.recv.
+// See server.Call for documentation.
+type View_Handler_recv struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c View_Handler_recv) Args() View_Handler_recv_Params {
+	return View_Handler_recv_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c View_Handler_recv) AllocResults() (stream.StreamResult, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return stream.StreamResult(r), err
+}
+
+// View_Handler_List is a list of View_Handler.
+type View_Handler_List = capnp.CapList[View_Handler]
+
+// NewView_Handler creates a new list of View_Handler.
+func NewView_Handler_List(s *capnp.Segment, sz int32) (View_Handler_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[View_Handler](l), err
+}
+
+type View_Handler_recv_Params capnp.Struct
+
+// View_Handler_recv_Params_TypeID is the unique identifier for the type View_Handler_recv_Params.
+const View_Handler_recv_Params_TypeID = 0x8eb96dceb6a99ebd
+
+func NewView_Handler_recv_Params(s *capnp.Segment) (View_Handler_recv_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return View_Handler_recv_Params(st), err
+}
+
+func NewRootView_Handler_recv_Params(s *capnp.Segment) (View_Handler_recv_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return View_Handler_recv_Params(st), err
+}
+
+func ReadRootView_Handler_recv_Params(msg *capnp.Message) (View_Handler_recv_Params, error) {
+	root, err := msg.Root()
+	return View_Handler_recv_Params(root.Struct()), err
+}
+
+func (s View_Handler_recv_Params) String() string {
+	str, _ := text.Marshal(0x8eb96dceb6a99ebd, capnp.Struct(s))
+	return str
+}
+
+func (s View_Handler_recv_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_Handler_recv_Params) DecodeFromPtr(p capnp.Ptr) View_Handler_recv_Params {
+	return View_Handler_recv_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s View_Handler_recv_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s View_Handler_recv_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s View_Handler_recv_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s View_Handler_recv_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s View_Handler_recv_Params) Record() (View_Record, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return View_Record(p.Struct()), err
+}
+
+func (s View_Handler_recv_Params) HasRecord() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_Handler_recv_Params) SetRecord(v View_Record) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewRecord sets the record field to a newly
+// allocated View_Record struct, preferring placement in s's segment.
+func (s View_Handler_recv_Params) NewRecord() (View_Record, error) {
+	ss, err := NewView_Record(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_Record{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// View_Handler_recv_Params_List is a list of View_Handler_recv_Params.
+type View_Handler_recv_Params_List = capnp.StructList[View_Handler_recv_Params]
+
+// NewView_Handler_recv_Params creates a new list of View_Handler_recv_Params.
+func NewView_Handler_recv_Params_List(s *capnp.Segment, sz int32) (View_Handler_recv_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[View_Handler_recv_Params](l), err
+}
+
+// View_Handler_recv_Params_Future is a wrapper for a View_Handler_recv_Params promised by a client call.
+type View_Handler_recv_Params_Future struct{ *capnp.Future }
+
+func (f View_Handler_recv_Params_Future) Struct() (View_Handler_recv_Params, error) {
+	p, err := f.Future.Ptr()
+	return View_Handler_recv_Params(p.Struct()), err
+}
+func (p View_Handler_recv_Params_Future) Record() View_Record_Future {
+	return View_Record_Future{Future: p.Future.Field(0, nil)}
+}
+
+type View_Watcher capnp.Client
+
+// View_Watcher_TypeID is the unique identifier for the type View_Watcher.
+const View_Watcher_TypeID = 0x9a41501dc1aea893
+
+func (c View_Watcher) Recv(ctx context.Context, params func(View_Watcher_recv_Params) error) error {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x9a41501dc1aea893,
+			MethodID:      0,
+			InterfaceName: "cluster.capnp:View.Watcher",
+			MethodName:    "recv",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(View_Watcher_recv_Params(s)) }
+	}
+
+	return capnp.Client(c).SendStreamCall(ctx, s)
+
+}
+
+func (c View_Watcher) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c View_Watcher) String() string {
+	return "View_Watcher(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c View_Watcher) AddRef() View_Watcher {
+	return View_Watcher(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c View_Watcher) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c View_Watcher) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c View_Watcher) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (View_Watcher) DecodeFromPtr(p capnp.Ptr) View_Watcher {
+	return View_Watcher(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c View_Watcher) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c View_Watcher) IsSame(other View_Watcher) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c View_Watcher) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c View_Watcher) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A View_Watcher_Server is a View_Watcher with a local implementation.
+type View_Watcher_Server interface {
+	Recv(context.Context, View_Watcher_recv) error
+}
+
+// View_Watcher_NewServer creates a new Server from an implementation of View_Watcher_Server.
+func View_Watcher_NewServer(s View_Watcher_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(View_Watcher_Methods(nil, s), s, c)
+}
+
+// View_Watcher_ServerToClient creates a new Client from an implementation of View_Watcher_Server.
+// The caller is responsible for calling Release on the returned Client.
+func View_Watcher_ServerToClient(s View_Watcher_Server) View_Watcher {
+	return View_Watcher(capnp.NewClient(View_Watcher_NewServer(s)))
+}
+
+// View_Watcher_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func View_Watcher_Methods(methods []server.Method, s View_Watcher_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 1)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x9a41501dc1aea893,
+			MethodID:      0,
+			InterfaceName: "cluster.capnp:View.Watcher",
+			MethodName:    "recv",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Recv(ctx, View_Watcher_recv{call})
+		},
+	})
+
+	return methods
+}
+
+// View_Watcher_recv holds the state for a server call to View_Watcher.recv.
+// See server.Call for documentation.
+type View_Watcher_recv struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c View_Watcher_recv) Args() View_Watcher_recv_Params {
+	return View_Watcher_recv_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c View_Watcher_recv) AllocResults() (stream.StreamResult, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return stream.StreamResult(r), err
+}
+
+// View_Watcher_List is a list of View_Watcher.
+type View_Watcher_List = capnp.CapList[View_Watcher]
+
+// NewView_Watcher creates a new list of View_Watcher.
+func NewView_Watcher_List(s *capnp.Segment, sz int32) (View_Watcher_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[View_Watcher](l), err
+}
+
+type View_Watcher_recv_Params capnp.Struct
+
+// View_Watcher_recv_Params_TypeID is the unique identifier for the type View_Watcher_recv_Params.
+const View_Watcher_recv_Params_TypeID = 0xa6fbaf9b531cb0f5
+
+func NewView_Watcher_recv_Params(s *capnp.Segment) (View_Watcher_recv_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return View_Watcher_recv_Params(st), err
+}
+
+func NewRootView_Watcher_recv_Params(s *capnp.Segment) (View_Watcher_recv_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return View_Watcher_recv_Params(st), err
+}
+
+func ReadRootView_Watcher_recv_Params(msg *capnp.Message) (View_Watcher_recv_Params, error) {
+	root, err := msg.Root()
+	return View_Watcher_recv_Params(root.Struct()), err
+}
+
+func (s View_Watcher_recv_Params) String() string {
+	str, _ := text.Marshal(0xa6fbaf9b531cb0f5, capnp.Struct(s))
+	return str
+}
+
+func (s View_Watcher_recv_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_Watcher_recv_Params) DecodeFromPtr(p capnp.Ptr) View_Watcher_recv_Params {
+	return View_Watcher_recv_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s View_Watcher_recv_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s View_Watcher_recv_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s View_Watcher_recv_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s View_Watcher_recv_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s View_Watcher_recv_Params) Event() (View_Event, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return View_Event(p.Struct()), err
+}
+
+func (s View_Watcher_recv_Params) HasEvent() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_Watcher_recv_Params) SetEvent(v View_Event) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewEvent sets the event field to a newly
+// allocated View_Event struct, preferring placement in s's segment.
+func (s View_Watcher_recv_Params) NewEvent() (View_Event, error) {
+	ss, err := NewView_Event(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_Event{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// View_Watcher_recv_Params_List is a list of View_Watcher_recv_Params.
+type View_Watcher_recv_Params_List = capnp.StructList[View_Watcher_recv_Params]
+
+// NewView_Watcher_recv_Params creates a new list of View_Watcher_recv_Params.
+func NewView_Watcher_recv_Params_List(s *capnp.Segment, sz int32) (View_Watcher_recv_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[View_Watcher_recv_Params](l), err
+}
+
+// View_Watcher_recv_Params_Future is a wrapper for a View_Watcher_recv_Params promised by a client call.
+type View_Watcher_recv_Params_Future struct{ *capnp.Future }
+
+func (f View_Watcher_recv_Params_Future) Struct() (View_Watcher_recv_Params, error) {
+	p, err := f.Future.Ptr()
+	return View_Watcher_recv_Params(p.Struct()), err
+}
+func (p View_Watcher_recv_Params_Future) Event() View_Event_Future {
+	return View_Event_Future{Future: p.Future.Field(0, nil)}
+}
+
+type View_Event capnp.Struct
+
+// View_Event_TypeID is the unique identifier for the type View_Event.
+const View_Event_TypeID = 0xa94e26d7a3b4d37d
+
+func NewView_Event(s *capnp.Segment) (View_Event, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return View_Event(st), err
+}
+
+func NewRootView_Event(s *capnp.Segment) (View_Event, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return View_Event(st), err
+}
+
+func ReadRootView_Event(msg *capnp.Message) (View_Event, error) {
+	root, err := msg.Root()
+	return View_Event(root.Struct()), err
+}
+
+func (s View_Event) String() string {
+	str, _ := text.Marshal(0xa94e26d7a3b4d37d, capnp.Struct(s))
+	return str
+}
+
+func (s View_Event) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_Event) DecodeFromPtr(p capnp.Ptr) View_Event {
+	return View_Event(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s View_Event) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s View_Event) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s View_Event) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s View_Event) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s View_Event) Type() View_Event_Type {
+	return View_Event_Type(capnp.Struct(s).Uint16(0))
+}
+
+func (s View_Event) SetType(v View_Event_Type) {
+	capnp.Struct(s).SetUint16(0, uint16(v))
+}
+
+func (s View_Event) Record() (View_Record, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return View_Record(p.Struct()), err
+}
+
+func (s View_Event) HasRecord() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_Event) SetRecord(v View_Record) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewRecord sets the record field to a newly
+// allocated View_Record struct, preferring placement in s's segment.
+func (s View_Event) NewRecord() (View_Record, error) {
+	ss, err := NewView_Record(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_Record{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// View_Event_List is a list of View_Event.
+type View_Event_List = capnp.StructList[View_Event]
+
+// NewView_Event creates a new list of View_Event.
+func NewView_Event_List(s *capnp.Segment, sz int32) (View_Event_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[View_Event](l), err
+}
+
+// View_Event_Future is a wrapper for a View_Event promised by a client call.
+type View_Event_Future struct{ *capnp.Future }
+
+func (f View_Event_Future) Struct() (View_Event, error) {
+	p, err := f.Future.Ptr()
+	return View_Event(p.Struct()), err
+}
+func (p View_Event_Future) Record() View_Record_Future {
+	return View_Record_Future{Future: p.Future.Field(0, nil)}
+}
+
+type View_Event_Type uint16
+
+// View_Event_Type_TypeID is the unique identifier for the type View_Event_Type.
+const View_Event_Type_TypeID = 0x9ea0d57316239ccb
+
+// Values of View_Event_Type.
+const (
+	View_Event_Type_join   View_Event_Type = 0
+	View_Event_Type_update View_Event_Type = 1
+	View_Event_Type_leave  View_Event_Type = 2
+)
+
+// String returns the enum's constant name.
+func (c View_Event_Type) String() string {
+	switch c {
+	case View_Event_Type_join:
+		return "join"
+	case View_Event_Type_update:
+		return "update"
+	case View_Event_Type_leave:
+		return "leave"
+
+	default:
+		return ""
+	}
+}
+
+// View_Event_TypeFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func View_Event_TypeFromString(c string) View_Event_Type {
+	switch c {
+	case "join":
+		return View_Event_Type_join
+	case "update":
+		return View_Event_Type_update
+	case "leave":
+		return View_Event_Type_leave
+
+	default:
+		return 0
+	}
+}
+
+type View_Event_Type_List = capnp.EnumList[View_Event_Type]
+
+func NewView_Event_Type_List(s *capnp.Segment, sz int32) (View_Event_Type_List, error) {
+	return capnp.NewEnumList[View_Event_Type](s, sz)
+}
+
+type View_Selector capnp.Struct
+type View_Selector_Which uint16
+
+const (
+	View_Selector_Which_all   View_Selector_Which = 0
+	View_Selector_Which_match View_Selector_Which = 1
+	View_Selector_Which_from  View_Selector_Which = 2
+)
+
+func (w View_Selector_Which) String() string {
+	const s = "allmatchfrom"
+	switch w {
+	case View_Selector_Which_all:
+		return s[0:3]
+	case View_Selector_Which_match:
+		return s[3:8]
+	case View_Selector_Which_from:
+		return s[8:12]
+
+	}
+	return "View_Selector_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+}
+
+// View_Selector_TypeID is the unique identifier for the type View_Selector.
+const View_Selector_TypeID = 0xb2029ff7b712d18a
+
+func NewView_Selector(s *capnp.Segment) (View_Selector, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return View_Selector(st), err
+}
+
+func NewRootView_Selector(s *capnp.Segment) (View_Selector, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return View_Selector(st), err
+}
+
+func ReadRootView_Selector(msg *capnp.Message) (View_Selector, error) {
+	root, err := msg.Root()
+	return View_Selector(root.Struct()), err
+}
+
+func (s View_Selector) String() string {
+	str, _ := text.Marshal(0xb2029ff7b712d18a, capnp.Struct(s))
+	return str
+}
+
+func (s View_Selector) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_Selector) DecodeFromPtr(p capnp.Ptr) View_Selector {
+	return View_Selector(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s View_Selector) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+
+func (s View_Selector) Which() View_Selector_Which {
+	return View_Selector_Which(capnp.Struct(s).Uint16(0))
+}
+func (s View_Selector) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s View_Selector) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s View_Selector) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s View_Selector) SetAll() {
+	capnp.Struct(s).SetUint16(0, 0)
+
+}
+
+func (s View_Selector) Match() (View_Index, error) {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		panic("Which() != match")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return View_Index(p.Struct()), err
+}
+
+func (s View_Selector) HasMatch() bool {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_Selector) SetMatch(v View_Index) error {
+	capnp.Struct(s).SetUint16(0, 1)
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewMatch sets the match field to a newly
+// allocated View_Index struct, preferring placement in s's segment.
+func (s View_Selector) NewMatch() (View_Index, error) {
+	capnp.Struct(s).SetUint16(0, 1)
+	ss, err := NewView_Index(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_Index{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s View_Selector) From() (View_Index, error) {
+	if capnp.Struct(s).Uint16(0) != 2 {
+		panic("Which() != from")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return View_Index(p.Struct()), err
+}
+
+func (s View_Selector) HasFrom() bool {
+	if capnp.Struct(s).Uint16(0) != 2 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_Selector) SetFrom(v View_Index) error {
+	capnp.Struct(s).SetUint16(0, 2)
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewFrom sets the from field to a newly
+// allocated View_Index struct, preferring placement in s's segment.
+func (s View_Selector) NewFrom() (View_Index, error) {
+	capnp.Struct(s).SetUint16(0, 2)
+	ss, err := NewView_Index(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_Index{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// View_Selector_List is a list of View_Selector.
+type View_Selector_List = capnp.StructList[View_Selector]
+
+// NewView_Selector creates a new list of View_Selector.
+func NewView_Selector_List(s *capnp.Segment, sz int32) (View_Selector_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[View_Selector](l), err
+}
+
+// View_Selector_Future is a wrapper for a View_Selector promised by a client call.
+type View_Selector_Future struct{ *capnp.Future }
+
+func (f View_Selector_Future) Struct() (View_Selector, error) {
+	p, err := f.Future.Ptr()
+	return View_Selector(p.Struct()), err
+}
+func (p View_Selector_Future) Match() View_Index_Future {
+	return View_Index_Future{Future: p.Future.Field(0, nil)}
+}
+func (p View_Selector_Future) From() View_Index_Future {
+	return View_Index_Future{Future: p.Future.Field(0, nil)}
+}
+
+type View_Constraint capnp.Struct
+type View_Constraint_Which uint16
+
+const (
+	View_Constraint_Which_limit View_Constraint_Which = 0
+	View_Constraint_Which_to    View_Constraint_Which = 1
+	View_Constraint_Which_where View_Constraint_Which = 2
+)
+
+func (w View_Constraint_Which) String() string {
+	const s = "limittowhere"
+	switch w {
+	case View_Constraint_Which_limit:
+		return s[0:5]
+	case View_Constraint_Which_to:
+		return s[5:7]
+	case View_Constraint_Which_where:
+		return s[7:12]
+
+	}
+	return "View_Constraint_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+}
+
+// View_Constraint_TypeID is the unique identifier for the type View_Constraint.
+const View_Constraint_TypeID = 0xab133d2062f6cc53
+
+func NewView_Constraint(s *capnp.Segment) (View_Constraint, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return View_Constraint(st), err
+}
+
+func NewRootView_Constraint(s *capnp.Segment) (View_Constraint, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return View_Constraint(st), err
+}
+
+func ReadRootView_Constraint(msg *capnp.Message) (View_Constraint, error) {
+	root, err := msg.Root()
+	return View_Constraint(root.Struct()), err
+}
+
+func (s View_Constraint) String() string {
+	str, _ := text.Marshal(0xab133d2062f6cc53, capnp.Struct(s))
+	return str
+}
+
+func (s View_Constraint) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_Constraint) DecodeFromPtr(p capnp.Ptr) View_Constraint {
+	return View_Constraint(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s View_Constraint) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+
+func (s View_Constraint) Which() View_Constraint_Which {
+	return View_Constraint_Which(capnp.Struct(s).Uint16(8))
+}
+func (s View_Constraint) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s View_Constraint) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s View_Constraint) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s View_Constraint) Limit() uint64 {
+	if capnp.Struct(s).Uint16(8) != 0 {
+		panic("Which() != limit")
+	}
+	return capnp.Struct(s).Uint64(0)
+}
+
+func (s View_Constraint) SetLimit(v uint64) {
+	capnp.Struct(s).SetUint16(8, 0)
+	capnp.Struct(s).SetUint64(0, v)
+}
+
+func (s View_Constraint) To() (View_Index, error) {
+	if capnp.Struct(s).Uint16(8) != 1 {
+		panic("Which() != to")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return View_Index(p.Struct()), err
+}
+
+func (s View_Constraint) HasTo() bool {
+	if capnp.Struct(s).Uint16(8) != 1 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_Constraint) SetTo(v View_Index) error {
+	capnp.Struct(s).SetUint16(8, 1)
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewTo sets the to field to a newly
+// allocated View_Index struct, preferring placement in s's segment.
+func (s View_Constraint) NewTo() (View_Index, error) {
+	capnp.Struct(s).SetUint16(8, 1)
+	ss, err := NewView_Index(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_Index{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s View_Constraint) Where() (View_Predicate, error) {
+	if capnp.Struct(s).Uint16(8) != 2 {
+		panic("Which() != where")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return View_Predicate(p.Struct()), err
+}
+
+func (s View_Constraint) HasWhere() bool {
+	if capnp.Struct(s).Uint16(8) != 2 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_Constraint) SetWhere(v View_Predicate) error {
+	capnp.Struct(s).SetUint16(8, 2)
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewWhere sets the where field to a newly
+// allocated View_Predicate struct, preferring placement in s's segment.
+func (s View_Constraint) NewWhere() (View_Predicate, error) {
+	capnp.Struct(s).SetUint16(8, 2)
+	ss, err := NewView_Predicate(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_Predicate{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// View_Constraint_List is a list of View_Constraint.
+type View_Constraint_List = capnp.StructList[View_Constraint]
+
+// NewView_Constraint creates a new list of View_Constraint.
+func NewView_Constraint_List(s *capnp.Segment, sz int32) (View_Constraint_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	return capnp.StructList[View_Constraint](l), err
+}
+
+// View_Constraint_Future is a wrapper for a View_Constraint promised by a client call.
+type View_Constraint_Future struct{ *capnp.Future }
+
+func (f View_Constraint_Future) Struct() (View_Constraint, error) {
+	p, err := f.Future.Ptr()
+	return View_Constraint(p.Struct()), err
+}
+func (p View_Constraint_Future) To() View_Index_Future {
+	return View_Index_Future{Future: p.Future.Field(0, nil)}
+}
+func (p View_Constraint_Future) Where() View_Predicate_Future {
+	return View_Predicate_Future{Future: p.Future.Field(0, nil)}
+}
+
+type View_Predicate capnp.Struct
+type View_Predicate_Which uint16
+
+const (
+	View_Predicate_Which_and      View_Predicate_Which = 0
+	View_Predicate_Which_or       View_Predicate_Which = 1
+	View_Predicate_Which_not      View_Predicate_Which = 2
+	View_Predicate_Which_has      View_Predicate_Which = 3
+	View_Predicate_Which_meta     View_Predicate_Which = 4
+	View_Predicate_Which_compare  View_Predicate_Which = 5
+	View_Predicate_Which_host     View_Predicate_Which = 6
+	View_Predicate_Which_resource View_Predicate_Which = 7
+)
+
+func (w View_Predicate_Which) String() string {
+	const s = "andornothasmetacomparehostresource"
+	switch w {
+	case View_Predicate_Which_and:
+		return s[0:3]
+	case View_Predicate_Which_or:
+		return s[3:5]
+	case View_Predicate_Which_not:
+		return s[5:8]
+	case View_Predicate_Which_has:
+		return s[8:11]
+	case View_Predicate_Which_meta:
+		return s[11:15]
+	case View_Predicate_Which_compare:
+		return s[15:22]
+	case View_Predicate_Which_host:
+		return s[22:26]
+	case View_Predicate_Which_resource:
+		return s[26:34]
+
+	}
+	return "View_Predicate_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-// View_Constraint_TypeID is the unique identifier for the type View_Constraint.
-const View_Constraint_TypeID = 0xab133d2062f6cc53
+// View_Predicate_TypeID is the unique identifier for the type View_Predicate.
+const View_Predicate_TypeID = 0x926dd174ab4af74c
 
-func NewView_Constraint(s *capnp.Segment) (View_Constraint, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
-	return View_Constraint(st), err
+func NewView_Predicate(s *capnp.Segment) (View_Predicate, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return View_Predicate(st), err
 }
 
-func NewRootView_Constraint(s *capnp.Segment) (View_Constraint, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
-	return View_Constraint(st), err
+func NewRootView_Predicate(s *capnp.Segment) (View_Predicate, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return View_Predicate(st), err
 }
 
-func ReadRootView_Constraint(msg *capnp.Message) (View_Constraint, error) {
+func ReadRootView_Predicate(msg *capnp.Message) (View_Predicate, error) {
 	root, err := msg.Root()
-	return View_Constraint(root.Struct()), err
+	return View_Predicate(root.Struct()), err
 }
 
-func (s View_Constraint) String() string {
-	str, _ := text.Marshal(0xab133d2062f6cc53, capnp.Struct(s))
+func (s View_Predicate) String() string {
+	str, _ := text.Marshal(0x926dd174ab4af74c, capnp.Struct(s))
 	return str
 }
 
-func (s View_Constraint) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s View_Predicate) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (View_Constraint) DecodeFromPtr(p capnp.Ptr) View_Constraint {
-	return View_Constraint(capnp.Struct{}.DecodeFromPtr(p))
+func (View_Predicate) DecodeFromPtr(p capnp.Ptr) View_Predicate {
+	return View_Predicate(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s View_Constraint) ToPtr() capnp.Ptr {
+func (s View_Predicate) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
 
-func (s View_Constraint) Which() View_Constraint_Which {
-	return View_Constraint_Which(capnp.Struct(s).Uint16(8))
+func (s View_Predicate) Which() View_Predicate_Which {
+	return View_Predicate_Which(capnp.Struct(s).Uint16(0))
 }
-func (s View_Constraint) IsValid() bool {
+func (s View_Predicate) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s View_Constraint) Message() *capnp.Message {
+func (s View_Predicate) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s View_Constraint) Segment() *capnp.Segment {
+func (s View_Predicate) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s View_Predicate) And() (View_Predicate_List, error) {
+	if capnp.Struct(s).Uint16(0) != 0 {
+		panic("Which() != and")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return View_Predicate_List(p.List()), err
+}
+
+func (s View_Predicate) HasAnd() bool {
+	if capnp.Struct(s).Uint16(0) != 0 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_Predicate) SetAnd(v View_Predicate_List) error {
+	capnp.Struct(s).SetUint16(0, 0)
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewAnd sets the and field to a newly
+// allocated View_Predicate_List, preferring placement in s's segment.
+func (s View_Predicate) NewAnd(n int32) (View_Predicate_List, error) {
+	capnp.Struct(s).SetUint16(0, 0)
+	l, err := NewView_Predicate_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return View_Predicate_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+func (s View_Predicate) Or() (View_Predicate_List, error) {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		panic("Which() != or")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return View_Predicate_List(p.List()), err
+}
+
+func (s View_Predicate) HasOr() bool {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_Predicate) SetOr(v View_Predicate_List) error {
+	capnp.Struct(s).SetUint16(0, 1)
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewOr sets the or field to a newly
+// allocated View_Predicate_List, preferring placement in s's segment.
+func (s View_Predicate) NewOr(n int32) (View_Predicate_List, error) {
+	capnp.Struct(s).SetUint16(0, 1)
+	l, err := NewView_Predicate_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return View_Predicate_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+func (s View_Predicate) Not() (View_Predicate, error) {
+	if capnp.Struct(s).Uint16(0) != 2 {
+		panic("Which() != not")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return View_Predicate(p.Struct()), err
+}
+
+func (s View_Predicate) HasNot() bool {
+	if capnp.Struct(s).Uint16(0) != 2 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_Predicate) SetNot(v View_Predicate) error {
+	capnp.Struct(s).SetUint16(0, 2)
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewNot sets the not field to a newly
+// allocated View_Predicate struct, preferring placement in s's segment.
+func (s View_Predicate) NewNot() (View_Predicate, error) {
+	capnp.Struct(s).SetUint16(0, 2)
+	ss, err := NewView_Predicate(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_Predicate{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s View_Predicate) Has() (string, error) {
+	if capnp.Struct(s).Uint16(0) != 3 {
+		panic("Which() != has")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s View_Predicate) HasHas() bool {
+	if capnp.Struct(s).Uint16(0) != 3 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_Predicate) HasBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s View_Predicate) SetHas(v string) error {
+	capnp.Struct(s).SetUint16(0, 3)
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s View_Predicate) Meta() (string, error) {
+	if capnp.Struct(s).Uint16(0) != 4 {
+		panic("Which() != meta")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s View_Predicate) HasMeta() bool {
+	if capnp.Struct(s).Uint16(0) != 4 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_Predicate) MetaBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s View_Predicate) SetMeta(v string) error {
+	capnp.Struct(s).SetUint16(0, 4)
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s View_Predicate) Compare() (View_Comparison, error) {
+	if capnp.Struct(s).Uint16(0) != 5 {
+		panic("Which() != compare")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return View_Comparison(p.Struct()), err
+}
+
+func (s View_Predicate) HasCompare() bool {
+	if capnp.Struct(s).Uint16(0) != 5 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_Predicate) SetCompare(v View_Comparison) error {
+	capnp.Struct(s).SetUint16(0, 5)
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewCompare sets the compare field to a newly
+// allocated View_Comparison struct, preferring placement in s's segment.
+func (s View_Predicate) NewCompare() (View_Comparison, error) {
+	capnp.Struct(s).SetUint16(0, 5)
+	ss, err := NewView_Comparison(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_Comparison{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s View_Predicate) Host() (string, error) {
+	if capnp.Struct(s).Uint16(0) != 6 {
+		panic("Which() != host")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s View_Predicate) HasHost() bool {
+	if capnp.Struct(s).Uint16(0) != 6 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_Predicate) HostBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s View_Predicate) SetHost(v string) error {
+	capnp.Struct(s).SetUint16(0, 6)
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s View_Predicate) Resource() (View_ResourceComparison, error) {
+	if capnp.Struct(s).Uint16(0) != 7 {
+		panic("Which() != resource")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return View_ResourceComparison(p.Struct()), err
+}
+
+func (s View_Predicate) HasResource() bool {
+	if capnp.Struct(s).Uint16(0) != 7 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_Predicate) SetResource(v View_ResourceComparison) error {
+	capnp.Struct(s).SetUint16(0, 7)
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewResource sets the resource field to a newly
+// allocated View_ResourceComparison struct, preferring placement in s's segment.
+func (s View_Predicate) NewResource() (View_ResourceComparison, error) {
+	capnp.Struct(s).SetUint16(0, 7)
+	ss, err := NewView_ResourceComparison(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_ResourceComparison{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// View_Predicate_List is a list of View_Predicate.
+type View_Predicate_List = capnp.StructList[View_Predicate]
+
+// NewView_Predicate creates a new list of View_Predicate.
+func NewView_Predicate_List(s *capnp.Segment, sz int32) (View_Predicate_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[View_Predicate](l), err
+}
+
+// View_Predicate_Future is a wrapper for a View_Predicate promised by a client call.
+type View_Predicate_Future struct{ *capnp.Future }
+
+func (f View_Predicate_Future) Struct() (View_Predicate, error) {
+	p, err := f.Future.Ptr()
+	return View_Predicate(p.Struct()), err
+}
+func (p View_Predicate_Future) Not() View_Predicate_Future {
+	return View_Predicate_Future{Future: p.Future.Field(0, nil)}
+}
+func (p View_Predicate_Future) Compare() View_Comparison_Future {
+	return View_Comparison_Future{Future: p.Future.Field(0, nil)}
+}
+func (p View_Predicate_Future) Resource() View_ResourceComparison_Future {
+	return View_ResourceComparison_Future{Future: p.Future.Field(0, nil)}
+}
+
+type View_ResourceComparison capnp.Struct
+
+// View_ResourceComparison_TypeID is the unique identifier for the type View_ResourceComparison.
+const View_ResourceComparison_TypeID = 0x99522456de240c14
+
+func NewView_ResourceComparison(s *capnp.Segment) (View_ResourceComparison, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return View_ResourceComparison(st), err
+}
+
+func NewRootView_ResourceComparison(s *capnp.Segment) (View_ResourceComparison, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return View_ResourceComparison(st), err
+}
+
+func ReadRootView_ResourceComparison(msg *capnp.Message) (View_ResourceComparison, error) {
+	root, err := msg.Root()
+	return View_ResourceComparison(root.Struct()), err
+}
+
+func (s View_ResourceComparison) String() string {
+	str, _ := text.Marshal(0x99522456de240c14, capnp.Struct(s))
+	return str
+}
+
+func (s View_ResourceComparison) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_ResourceComparison) DecodeFromPtr(p capnp.Ptr) View_ResourceComparison {
+	return View_ResourceComparison(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s View_ResourceComparison) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s View_ResourceComparison) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s View_ResourceComparison) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s View_ResourceComparison) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s View_ResourceComparison) Resource() Resource {
+	return Resource(capnp.Struct(s).Uint16(0))
+}
+
+func (s View_ResourceComparison) SetResource(v Resource) {
+	capnp.Struct(s).SetUint16(0, uint16(v))
+}
+
+func (s View_ResourceComparison) Op() View_Comparison_Op {
+	return View_Comparison_Op(capnp.Struct(s).Uint16(2))
+}
+
+func (s View_ResourceComparison) SetOp(v View_Comparison_Op) {
+	capnp.Struct(s).SetUint16(2, uint16(v))
+}
+
+func (s View_ResourceComparison) Value() float64 {
+	return math.Float64frombits(capnp.Struct(s).Uint64(8))
+}
+
+func (s View_ResourceComparison) SetValue(v float64) {
+	capnp.Struct(s).SetUint64(8, math.Float64bits(v))
+}
+
+// View_ResourceComparison_List is a list of View_ResourceComparison.
+type View_ResourceComparison_List = capnp.StructList[View_ResourceComparison]
+
+// NewView_ResourceComparison creates a new list of View_ResourceComparison.
+func NewView_ResourceComparison_List(s *capnp.Segment, sz int32) (View_ResourceComparison_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return capnp.StructList[View_ResourceComparison](l), err
+}
+
+// View_ResourceComparison_Future is a wrapper for a View_ResourceComparison promised by a client call.
+type View_ResourceComparison_Future struct{ *capnp.Future }
+
+func (f View_ResourceComparison_Future) Struct() (View_ResourceComparison, error) {
+	p, err := f.Future.Ptr()
+	return View_ResourceComparison(p.Struct()), err
+}
+
+type View_Comparison capnp.Struct
+
+// View_Comparison_TypeID is the unique identifier for the type View_Comparison.
+const View_Comparison_TypeID = 0x8f4c3dbda216e68e
+
+func NewView_Comparison(s *capnp.Segment) (View_Comparison, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return View_Comparison(st), err
+}
+
+func NewRootView_Comparison(s *capnp.Segment) (View_Comparison, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return View_Comparison(st), err
+}
+
+func ReadRootView_Comparison(msg *capnp.Message) (View_Comparison, error) {
+	root, err := msg.Root()
+	return View_Comparison(root.Struct()), err
+}
+
+func (s View_Comparison) String() string {
+	str, _ := text.Marshal(0x8f4c3dbda216e68e, capnp.Struct(s))
+	return str
+}
+
+func (s View_Comparison) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_Comparison) DecodeFromPtr(p capnp.Ptr) View_Comparison {
+	return View_Comparison(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s View_Comparison) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s View_Comparison) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s View_Comparison) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s View_Comparison) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s View_Constraint) Limit() uint64 {
-	if capnp.Struct(s).Uint16(8) != 0 {
-		panic("Which() != limit")
-	}
-	return capnp.Struct(s).Uint64(0)
+func (s View_Comparison) Key() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
 }
 
-func (s View_Constraint) SetLimit(v uint64) {
-	capnp.Struct(s).SetUint16(8, 0)
-	capnp.Struct(s).SetUint64(0, v)
+func (s View_Comparison) HasKey() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s View_Constraint) To() (View_Index, error) {
-	if capnp.Struct(s).Uint16(8) != 1 {
-		panic("Which() != to")
-	}
+func (s View_Comparison) KeyBytes() ([]byte, error) {
 	p, err := capnp.Struct(s).Ptr(0)
-	return View_Index(p.Struct()), err
+	return p.TextBytes(), err
 }
 
-func (s View_Constraint) HasTo() bool {
-	if capnp.Struct(s).Uint16(8) != 1 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (s View_Comparison) SetKey(v string) error {
+	return capnp.Struct(s).SetText(0, v)
 }
 
-func (s View_Constraint) SetTo(v View_Index) error {
-	capnp.Struct(s).SetUint16(8, 1)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s View_Comparison) Op() View_Comparison_Op {
+	return View_Comparison_Op(capnp.Struct(s).Uint16(0))
 }
 
-// NewTo sets the to field to a newly
-// allocated View_Index struct, preferring placement in s's segment.
-func (s View_Constraint) NewTo() (View_Index, error) {
-	capnp.Struct(s).SetUint16(8, 1)
-	ss, err := NewView_Index(capnp.Struct(s).Segment())
-	if err != nil {
-		return View_Index{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s View_Comparison) SetOp(v View_Comparison_Op) {
+	capnp.Struct(s).SetUint16(0, uint16(v))
 }
 
-// View_Constraint_List is a list of View_Constraint.
-type View_Constraint_List = capnp.StructList[View_Constraint]
+func (s View_Comparison) Value() float64 {
+	return math.Float64frombits(capnp.Struct(s).Uint64(8))
+}
 
-// NewView_Constraint creates a new list of View_Constraint.
-func NewView_Constraint_List(s *capnp.Segment, sz int32) (View_Constraint_List, error) {
+func (s View_Comparison) SetValue(v float64) {
+	capnp.Struct(s).SetUint64(8, math.Float64bits(v))
+}
+
+// View_Comparison_List is a list of View_Comparison.
+type View_Comparison_List = capnp.StructList[View_Comparison]
+
+// NewView_Comparison creates a new list of View_Comparison.
+func NewView_Comparison_List(s *capnp.Segment, sz int32) (View_Comparison_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
-	return capnp.StructList[View_Constraint](l), err
+	return capnp.StructList[View_Comparison](l), err
 }
 
-// View_Constraint_Future is a wrapper for a View_Constraint promised by a client call.
-type View_Constraint_Future struct{ *capnp.Future }
+// View_Comparison_Future is a wrapper for a View_Comparison promised by a client call.
+type View_Comparison_Future struct{ *capnp.Future }
 
-func (f View_Constraint_Future) Struct() (View_Constraint, error) {
+func (f View_Comparison_Future) Struct() (View_Comparison, error) {
 	p, err := f.Future.Ptr()
-	return View_Constraint(p.Struct()), err
+	return View_Comparison(p.Struct()), err
 }
-func (p View_Constraint_Future) To() View_Index_Future {
-	return View_Index_Future{Future: p.Future.Field(0, nil)}
+
+type View_Comparison_Op uint16
+
+// View_Comparison_Op_TypeID is the unique identifier for the type View_Comparison_Op.
+const View_Comparison_Op_TypeID = 0xbc9b043aba60cc46
+
+// Values of View_Comparison_Op.
+const (
+	View_Comparison_Op_eq View_Comparison_Op = 0
+	View_Comparison_Op_ne View_Comparison_Op = 1
+	View_Comparison_Op_lt View_Comparison_Op = 2
+	View_Comparison_Op_le View_Comparison_Op = 3
+	View_Comparison_Op_gt View_Comparison_Op = 4
+	View_Comparison_Op_ge View_Comparison_Op = 5
+)
+
+// String returns the enum's constant name.
+func (c View_Comparison_Op) String() string {
+	switch c {
+	case View_Comparison_Op_eq:
+		return "eq"
+	case View_Comparison_Op_ne:
+		return "ne"
+	case View_Comparison_Op_lt:
+		return "lt"
+	case View_Comparison_Op_le:
+		return "le"
+	case View_Comparison_Op_gt:
+		return "gt"
+	case View_Comparison_Op_ge:
+		return "ge"
+
+	default:
+		return ""
+	}
+}
+
+// View_Comparison_OpFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func View_Comparison_OpFromString(c string) View_Comparison_Op {
+	switch c {
+	case "eq":
+		return View_Comparison_Op_eq
+	case "ne":
+		return View_Comparison_Op_ne
+	case "lt":
+		return View_Comparison_Op_lt
+	case "le":
+		return View_Comparison_Op_le
+	case "gt":
+		return View_Comparison_Op_gt
+	case "ge":
+		return View_Comparison_Op_ge
+
+	default:
+		return 0
+	}
+}
+
+type View_Comparison_Op_List = capnp.EnumList[View_Comparison_Op]
+
+func NewView_Comparison_Op_List(s *capnp.Segment, sz int32) (View_Comparison_Op_List, error) {
+	return capnp.NewEnumList[View_Comparison_Op](s, sz)
 }
 
 type View_Index capnp.Struct
 type View_Index_Which uint16
 
 const (
-	View_Index_Which_peer   View_Index_Which = 0
-	View_Index_Which_server View_Index_Which = 1
-	View_Index_Which_host   View_Index_Which = 2
-	View_Index_Which_meta   View_Index_Which = 3
+	View_Index_Which_peer     View_Index_Which = 0
+	View_Index_Which_server   View_Index_Which = 1
+	View_Index_Which_host     View_Index_Which = 2
+	View_Index_Which_meta     View_Index_Which = 3
+	View_Index_Which_resource View_Index_Which = 4
 )
 
 func (w View_Index_Which) String() string {
-	const s = "peerserverhostmeta"
+	const s = "peerserverhostmetaresource"
 	switch w {
 	case View_Index_Which_peer:
 		return s[0:4]
@@ -1281,6 +3826,8 @@ func (w View_Index_Which) String() string {
 		return s[10:14]
 	case View_Index_Which_meta:
 		return s[14:18]
+	case View_Index_Which_resource:
+		return s[18:26]
 
 	}
 	return "View_Index_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
@@ -1438,6 +3985,38 @@ func (s View_Index) SetMeta(v string) error {
 	return capnp.Struct(s).SetText(0, v)
 }
 
+func (s View_Index) Resource() (View_ResourceValue, error) {
+	if capnp.Struct(s).Uint16(2) != 4 {
+		panic("Which() != resource")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return View_ResourceValue(p.Struct()), err
+}
+
+func (s View_Index) HasResource() bool {
+	if capnp.Struct(s).Uint16(2) != 4 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_Index) SetResource(v View_ResourceValue) error {
+	capnp.Struct(s).SetUint16(2, 4)
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewResource sets the resource field to a newly
+// allocated View_ResourceValue struct, preferring placement in s's segment.
+func (s View_Index) NewResource() (View_ResourceValue, error) {
+	capnp.Struct(s).SetUint16(2, 4)
+	ss, err := NewView_ResourceValue(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_ResourceValue{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
 // View_Index_List is a list of View_Index.
 type View_Index_List = capnp.StructList[View_Index]
 
@@ -1454,6 +4033,89 @@ func (f View_Index_Future) Struct() (View_Index, error) {
 	p, err := f.Future.Ptr()
 	return View_Index(p.Struct()), err
 }
+func (p View_Index_Future) Resource() View_ResourceValue_Future {
+	return View_ResourceValue_Future{Future: p.Future.Field(0, nil)}
+}
+
+type View_ResourceValue capnp.Struct
+
+// View_ResourceValue_TypeID is the unique identifier for the type View_ResourceValue.
+const View_ResourceValue_TypeID = 0xf4b0fba811d97f18
+
+func NewView_ResourceValue(s *capnp.Segment) (View_ResourceValue, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return View_ResourceValue(st), err
+}
+
+func NewRootView_ResourceValue(s *capnp.Segment) (View_ResourceValue, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return View_ResourceValue(st), err
+}
+
+func ReadRootView_ResourceValue(msg *capnp.Message) (View_ResourceValue, error) {
+	root, err := msg.Root()
+	return View_ResourceValue(root.Struct()), err
+}
+
+func (s View_ResourceValue) String() string {
+	str, _ := text.Marshal(0xf4b0fba811d97f18, capnp.Struct(s))
+	return str
+}
+
+func (s View_ResourceValue) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_ResourceValue) DecodeFromPtr(p capnp.Ptr) View_ResourceValue {
+	return View_ResourceValue(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s View_ResourceValue) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s View_ResourceValue) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s View_ResourceValue) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s View_ResourceValue) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s View_ResourceValue) Resource() Resource {
+	return Resource(capnp.Struct(s).Uint16(0))
+}
+
+func (s View_ResourceValue) SetResource(v Resource) {
+	capnp.Struct(s).SetUint16(0, uint16(v))
+}
+
+func (s View_ResourceValue) Value() float64 {
+	return math.Float64frombits(capnp.Struct(s).Uint64(8))
+}
+
+func (s View_ResourceValue) SetValue(v float64) {
+	capnp.Struct(s).SetUint64(8, math.Float64bits(v))
+}
+
+// View_ResourceValue_List is a list of View_ResourceValue.
+type View_ResourceValue_List = capnp.StructList[View_ResourceValue]
+
+// NewView_ResourceValue creates a new list of View_ResourceValue.
+func NewView_ResourceValue_List(s *capnp.Segment, sz int32) (View_ResourceValue_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return capnp.StructList[View_ResourceValue](l), err
+}
+
+// View_ResourceValue_Future is a wrapper for a View_ResourceValue promised by a client call.
+type View_ResourceValue_Future struct{ *capnp.Future }
+
+func (f View_ResourceValue_Future) Struct() (View_ResourceValue, error) {
+	p, err := f.Future.Ptr()
+	return View_ResourceValue(p.Struct()), err
+}
 
 type View_Record capnp.Struct
 
@@ -2264,118 +4926,452 @@ func (p View_reverse_Results_Future) View() View {
 	return View(p.Future.Field(0, nil).Client())
 }
 
-const schema_fcf6ac08e448a6ac = "x\xda\xacV]h\x1cU\x14>\xe7\xde\xd9\x9d\xcdv" +
-	"7\x9b\x9bI\xa5\xad\x84\xb45\xd1&\x92\xd06E0" +
-	"(IcC~0\x90\x9b\xb4B\x8b\xa0\x93\xcdmv" +
-	"uv7\x9d\x99\xfc\x08\xc6X\xac\xd0V+\x8a\x16j" +
-	"\x1f\x14\xc5V\xab\x85b\xc0J\xa1\x8a\x0a*JZ\xf1" +
-	"\x07\xa5\"TE\xed\x9b\xb6AZ\xa91#wfg" +
-	"g\x93l\xe3\x8bo\x93\xd9\x93s\xbes\xce\xf7}g" +
-	"6\xbeN\xdb\x94M\xf1k\x11 <\x15\x0a;Gk" +
-	"\xe7v5_\xae>\x08\xac\x9c:'\x8fw\xfd\x129" +
-	"yu\x0e\x005]9\xaa\xa5\x95a\x00\xed\x8c\xf2\xa9" +
-	"\xb6)\xa4\x028\x8d]\x17\xaf<q\xa1\xe6)`\x1a" +
-	"\x02(*@\xf3\xeaP%\x82\xe2\xbc\xf7\xd2\x89\xd3\xe7" +
-	"3g\x9e\x01v3\x02\x84P\xfe\x84\xa1\x16\x04\xd4\xca" +
-	"B\xad\x80\xce\xdakw\x1cQ\xf7\xd8'\x80\x97#\x09" +
-	"\x0a\x85\x88\x0a\xa0\xd5\x87>\xf2*h\x8d\xa1K\x80\xce" +
-	"\xc0\xcc\xd5\xc1\xb5wko\x01\xd7\x90\x04\x10;P%" +
-	"\xa8h,<\xabU\x87e\xf4\xea\xf08\xa0s\xf0\xcb" +
-	"\xcaw\xaf\xbdL\xa6e4.\x88\xa6\x00\xdah\xf8g" +
-	"m\xaf\x1b=\x19>\x05\xe8\xdc\xd6\x19\xfe\xfa\xa6X\xdd" +
-	"4\xb0\x95\x05\xa4+\xd5\xa8DZ\xadJ\xa4\xc7\xae~" +
-	"s\xeb\x8c\xd28\xb34\x9d\x82\xa8mU?\xd7zU" +
-	"\xf9O\xddj\x0d\x02:\xc6\xb9\x0d\xef\xfc=\xff\xd8\x8c" +
-	"7\x13/\xdf\xce\xc8\x1a\x99O\x8f\xb8\x9dO\x0eL\xbf" +
-	"\xdf\xfe\xc5\xb9E\xcdx\x9d\xef\x8d|\xa5\x1d\x8a\xc8\xa7" +
-	"\x03\x11\xd9\xb9rK\xf9\xd9#\xb3\xaf}\xbb\xb48\x01" +
-	"\xd0\xf4\xb2\xebZ\xa6LF\xa7\xcbd\xe7\xc6\x86\xdb\xff" +
-	"\xda\xfec\xfd\x85|m\x99\xb1\xf9\xe32\xb7\x97sn" +
-	"\xc0\x1f\xdf\xd5\x9c\xde6\xd3\xf3k\xd1\xc2\xb6F\x89\\" +
-	"\x986\xf7Fg\xa5~\xf1\xb7b\xd8u\xd1J\xf9\xaf" +
-	"\x8dQ\x09\x9b\xde\xf5\xeat\xf2\xf8\xf3\xbf\x03\xd3h\x00" +
-	"\x04P\xeb\x8d~\xaf\xed\x8cJ\x10;\xa2\x9d\xda\xa4|" +
-	"\xbax\xe9\xfa\x0f\x9f\x98+.\x17\x8dTx\xb92n" +
-	"\xae\xb3\xb3\x1f\x9e\xdf\xf6\xd3\xec\x95%$;\x14}S" +
-	";\xec\xe6z.\xda\xa9\x9d\x91O\xf3m[>\xdbq" +
-	"\xec\xf0\x9f\x1e.*\x7f{%z\x1dP;\x16=\x05" +
-	";\x9d\xa41j\xd9\xc2l\xc2\xa4>\x92\x1di\xb9/" +
-	"M\xc58_\x85\xc5x\xeb\xdb\x03J\xb0\xba\x9e\x80M" +
-	"\xacnW\xb0]V\xb79X\x0d[\xd7\x12\x8c\x9eU" +
-	"\x0fNu\xe9\xd9!C\x98\xce\x800D\xd2\xce\x99\x00" +
-	"\xe0\xdc\x93\xcbZ\xb6\xa9\xa7\x81f\xed\x9a\xee\xec\x90\x98" +
-	"h\xed\x17\xc9\x9c9\xe4\xf4\xea\x8f\x0c\x8a~\x91\x045" +
-	"g\x0e\xf1\x18\x0d\x01\x14v\x83\xfe\xa4\x19o\x01\xc2:" +
-	"TD\xbf\xc5\xa2\xf5\xdc\xd9\x00\x845\xaaH\x0a\x1aC" +
-	"\x9fXl];\x10\xb6Rm5r\xb9\x87GG\xda" +
-	"0\x91\xb6\x85\xd9\x86S\xa6\x18\x13\xa6%\xda\xb0\x0f\xb1" +
-	"0\x17\xea\xcfE\x8c7\xe5\x03j\xfbtS\xcf\xa0U" +
-	"2&\xdfh\x93)\x92c\xb5\xadn\xa4\xc5\x15\xaa\x00" +
-	"(\x08\xc0\xe2-\x00<B\x91W\x11l5\xddn\xb1" +
-	"\"\x98\x1b V@P\x9cx\x89\xbb\x84n\xda\x83B" +
-	"\xb7\xa1\x0f\x91W\x14\x92\xe9\xeb\x01\xf8\xfd\x14y\x8a " +
-	"C\xacB\xf9R\xc8\x0a\x0fR\xe4\x06A$UH\x00" +
-	"X\xba\x01\x80\x0fQ\xe4#\x04\x19\xc5*\xa4\x00,#" +
-	"_\xa6(\xf2}\x04U\xdb60\x02\x04#\x80\xad\x96" +
-	"0\xc7\x84\x89e@\xb0\x0c0\x91\xcaY6\xc6\x80`" +
-	"\x0c0\x91\x11\xb6\x8e\xe5\x80}\x14\xddw\xe5K\xd1\xba" +
-	"cpw[c\xea\xe9\xac-1G\xa8\x12s\x1c\x17" +
-	"t\xfdf\x00^K\x91o$\x18\xc7y\xc7C\xdd\xb8" +
-	"\x06\x80o\xa0\xc8\xb7\x10\xac1\xd2\x99\xb4\xed\xd7\xa7v" +
-	"\x0e+\x02\x9a\x95\x9e\x90[\xd3\xe5\x96j\xe7LY1" +
-	"\x16T\xec\x90cj\xa3\xc8\xef-\xae\xd8-ql\xa3" +
-	"\xc8\xfb\x08\xc6\xc9?\x8e7\xa9^9\x94.\x8a|;" +
-	"AU7\x0c\x08\xd7dt;\x99Z\x0a!\xb1\xdb\xcc" +
-	"e\x96A\x96'\xc5@z8+\xcc&+=\x9c\xf5" +
-	"xc\x01\x14\xf3\xa1\x1f\x80\xc7(\xf2U\x04\x9ddJ" +
-	"7\x0c\x91\x1d\x06\x14\x18\x07\x82\xf1\x1b4\xeaj\x05\\" +
-	"2T\x152M\xca\xbdOx\xeb\x8c\xa3\x93\xefr\xaf" +
-	"\xec\xe7Q\x8a|\xbf\xecr>\xdf\xe5\x932\xf6q\x8a" +
-	"\xfci\x82q*{\x97\x848 c\xf7Q\xe4\xcf\x12" +
-	"\x8c+sN\x15*\x00\xec\x90|\xbb\x9f\"\x7f\x81`" +
-	"\xeb\x88)v\xa7'\x10\x81 \x02&F\x840}j" +
-	"\xf8\xb4\xc9\x03/A\x9b\xfc\x1f\xcbJ\xab_X\xa3\x06" +
-	"\xb5\x17H\xa6!\x90Lb,-\xc6\x91\x15\xfb'\xb2" +
-	"\x1bL\xc9\xf5\x12\x1cZ$\x99\x86@2\xa5\x14\xc3\x08" +
-	"\xe6%\xb3\xbe\x94d\xe4\xb6\x0c\x8a|\x82\x94n>\xcf" +
-	"Y\xd5\x12{\xfcg'\x95W/\xa0-\xa5\xee\xdf\xed" +
-	"e\x88\xec\xda_\xde\x0b\x17\xab\xa7\xbd\xa4z\x1a\x02\xf5" +
-	"Lesv*\x9d\x1d\x86p\xe2\xa1Q\xcb^\xc6]" +
-	"\x8a\xe7\xefya\xc0\xd0Hab\xf5=A\xee\x82\xc9" +
-	"l\x1a\x04\xe0\x1b=I9V\xe0\xe9X\x11\x9c\x0a\xbf" +
-	"\x9a\xef\xf2j\xd6\xb6|\xeb\xa8\x08\x8e\x08\xe0\x02\x13Q" +
-	"\x8b@I_\xf6\x18a[\xe0\x07,\x05\xedE\xe02" +
-	"6\xeb\xfe^\x11\\\xa4ef\xef\xfa7\x15\xae\x87(" +
-	"\xee\xe1\xf1?\xc50\xfb\xf6\x07\xe3\xcdG\x1fx\x911" +
-	"y\\BjBz|\xc9kQ,\xfa\xffB'\x83" +
-	"\xc4\xd0\x12\xbd\xa3\x9f)!S\x05h\xfc\xcf-t?" +
-	"\x11`\xc5\xe5\x02\x1a\x99h!\x1a\xb2x\x96\xfe=\x8a" +
-	"\x15\xa0t\xb4\x07\xdeX\xd8nwO`\x82\x8c\xe4o" +
-	"\x08\x97+\xef\xf3T2\x95\xf2\xae\x1c\xb2\xe03!\xaf" +
-	"\xc4\xff\x83\x0c\xff\x06\x00\x00\xff\xff\xe7\x07\x05\xf0"
+type View_watch_Params capnp.Struct
+
+// View_watch_Params_TypeID is the unique identifier for the type View_watch_Params.
+const View_watch_Params_TypeID = 0xce1f478309867723
+
+func NewView_watch_Params(s *capnp.Segment) (View_watch_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return View_watch_Params(st), err
+}
+
+func NewRootView_watch_Params(s *capnp.Segment) (View_watch_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return View_watch_Params(st), err
+}
+
+func ReadRootView_watch_Params(msg *capnp.Message) (View_watch_Params, error) {
+	root, err := msg.Root()
+	return View_watch_Params(root.Struct()), err
+}
+
+func (s View_watch_Params) String() string {
+	str, _ := text.Marshal(0xce1f478309867723, capnp.Struct(s))
+	return str
+}
+
+func (s View_watch_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_watch_Params) DecodeFromPtr(p capnp.Ptr) View_watch_Params {
+	return View_watch_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s View_watch_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s View_watch_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s View_watch_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s View_watch_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s View_watch_Params) Handler() View_Watcher {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return View_Watcher(p.Interface().Client())
+}
+
+func (s View_watch_Params) HasHandler() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s View_watch_Params) SetHandler(v View_Watcher) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+func (s View_watch_Params) Selector() (View_Selector, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return View_Selector(p.Struct()), err
+}
+
+func (s View_watch_Params) HasSelector() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s View_watch_Params) SetSelector(v View_Selector) error {
+	return capnp.Struct(s).SetPtr(1, capnp.Struct(v).ToPtr())
+}
+
+// NewSelector sets the selector field to a newly
+// allocated View_Selector struct, preferring placement in s's segment.
+func (s View_watch_Params) NewSelector() (View_Selector, error) {
+	ss, err := NewView_Selector(capnp.Struct(s).Segment())
+	if err != nil {
+		return View_Selector{}, err
+	}
+	err = capnp.Struct(s).SetPtr(1, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s View_watch_Params) Constraints() (View_Constraint_List, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return View_Constraint_List(p.List()), err
+}
+
+func (s View_watch_Params) HasConstraints() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s View_watch_Params) SetConstraints(v View_Constraint_List) error {
+	return capnp.Struct(s).SetPtr(2, v.ToPtr())
+}
+
+// NewConstraints sets the constraints field to a newly
+// allocated View_Constraint_List, preferring placement in s's segment.
+func (s View_watch_Params) NewConstraints(n int32) (View_Constraint_List, error) {
+	l, err := NewView_Constraint_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return View_Constraint_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(2, l.ToPtr())
+	return l, err
+}
+
+// View_watch_Params_List is a list of View_watch_Params.
+type View_watch_Params_List = capnp.StructList[View_watch_Params]
+
+// NewView_watch_Params creates a new list of View_watch_Params.
+func NewView_watch_Params_List(s *capnp.Segment, sz int32) (View_watch_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3}, sz)
+	return capnp.StructList[View_watch_Params](l), err
+}
+
+// View_watch_Params_Future is a wrapper for a View_watch_Params promised by a client call.
+type View_watch_Params_Future struct{ *capnp.Future }
+
+func (f View_watch_Params_Future) Struct() (View_watch_Params, error) {
+	p, err := f.Future.Ptr()
+	return View_watch_Params(p.Struct()), err
+}
+func (p View_watch_Params_Future) Handler() View_Watcher {
+	return View_Watcher(p.Future.Field(0, nil).Client())
+}
+
+func (p View_watch_Params_Future) Selector() View_Selector_Future {
+	return View_Selector_Future{Future: p.Future.Field(1, nil)}
+}
+
+type View_watch_Results capnp.Struct
+
+// View_watch_Results_TypeID is the unique identifier for the type View_watch_Results.
+const View_watch_Results_TypeID = 0xbd86f813590d15f1
+
+func NewView_watch_Results(s *capnp.Segment) (View_watch_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return View_watch_Results(st), err
+}
+
+func NewRootView_watch_Results(s *capnp.Segment) (View_watch_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return View_watch_Results(st), err
+}
+
+func ReadRootView_watch_Results(msg *capnp.Message) (View_watch_Results, error) {
+	root, err := msg.Root()
+	return View_watch_Results(root.Struct()), err
+}
+
+func (s View_watch_Results) String() string {
+	str, _ := text.Marshal(0xbd86f813590d15f1, capnp.Struct(s))
+	return str
+}
+
+func (s View_watch_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (View_watch_Results) DecodeFromPtr(p capnp.Ptr) View_watch_Results {
+	return View_watch_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s View_watch_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s View_watch_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s View_watch_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s View_watch_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// View_watch_Results_List is a list of View_watch_Results.
+type View_watch_Results_List = capnp.StructList[View_watch_Results]
+
+// NewView_watch_Results creates a new list of View_watch_Results.
+func NewView_watch_Results_List(s *capnp.Segment, sz int32) (View_watch_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[View_watch_Results](l), err
+}
+
+// View_watch_Results_Future is a wrapper for a View_watch_Results promised by a client call.
+type View_watch_Results_Future struct{ *capnp.Future }
+
+func (f View_watch_Results_Future) Struct() (View_watch_Results, error) {
+	p, err := f.Future.Ptr()
+	return View_watch_Results(p.Struct()), err
+}
+
+const schema_fcf6ac08e448a6ac = "x\xda\xb4Y}pTU\x96?\xe7\xddn:\x09\xe9" +
+	"\x8f\x9b\x97\x90&+6\x0b\xc1\x85\xec\x92\x82D\xb7\x96" +
+	"\xd4R\x09\xd1\x08d\xc9\x9a\x97 \x0a\xa5+\x8f\xeek" +
+	"\xd2\xda_y\xfd\x9a\x98*\xd9\x14ka\xadZB\x8d" +
+	"\x0e5\x06\xc5Q\x07,PP\xa4D\xc5\x01J\xacQ" +
+	"g4:\xc3\xa8\xa3X8\x82\x05:35\xe5\x00\x03" +
+	"H \xf0\xa6\xee}\xfd>\x924\xe0|\xf8W\x1e\xef" +
+	"\x9d>\xf7\xdc\xdf9\xbf\xdf9\xf72\xab\xb3\xa8\xc93" +
+	"\xdb\xbf\x8e\x82\xa4\x0cz\xc7\x19\xfb^\xfd\xe6\xd8\xd4\xe9" +
+	"\x0f\xfd\x1fP\x19\x01<>\x00y{\xd19\xf0\x18\x7f" +
+	"\x8e\x9e<~\xf5\xda?>`~\xf0\xa2\x0f\xa0\xfe\x07" +
+	"Eu\x08(\x0f\x145\x02\x1a\x1b\xaa\x87\x97\xd5\x1f\x9f" +
+	"\xf4 \xd0\x001\xb6=\xbb\xe0h\xd1\xb63\xc3\x00(" +
+	"\xef-\xda \xbfU\xf4\x0e@}O\xb1\x0feZ\xe2" +
+	"\x030f.\xf8\xe2\xc4}\x07#\x0f9\xeb\xd4\x0f\x15" +
+	"\x97!x\x8c\xbdOn}\xe5\x83\xe4\xee\xb5@\xff\xc9" +
+	"^\xe8Hq\x03_\xe8\xf7\xc5|\xa1\xb5_Mxf" +
+	"\xef\xdcE\xeb@\x91Qr\x96\x15\x96rq\xc9I\xb9" +
+	"\xa2\xa4\x12@\x9eT\xf2\"\xa0q\xfa\xa3Ek\xd6>" +
+	"r\xeb:\xd7:\xbbJ\xaa\xf8:\x8b\xbem}^?" +
+	"\x90|\x84\xbbqE\xdf\x82\xbe\"\x00yc\xc9\x1f\xe4" +
+	"\xad<\xd2\xfa\xcd%\xef \xa0\xe1\xfb\xf0\x8e\xd8\xf0\xbe" +
+	"{\xd7\x8f\xd9\xde\xc3\xa5\x1b\xe4\xf5\xa5\x95\x00\xf5O\x97" +
+	"\xfaP^\xe8\xe7\xdb+/\xad\xfe\xed\x92\xea\x8e\x81Q" +
+	"1\x8a\xf5g\xfb\xebP\x9e\xc7\xcd\xe4\xb9~\x1e\xe3\xa3" +
+	"[^\xd8?\xa9}\xde\x06\xa02ql\x01\xe5\x83\xfe" +
+	"\xcf\xe4c\xc2\xf0\x88\x7f\xbe\\\x1c\xe0\x9e\xdf{b\xea" +
+	"\x84\xec\xc7O=\x09t\xa2d\xac\xfa\xf0\xe5\x9f|r" +
+	"\xcd\x7fo\xe5\xc6'\xfc'\xe5\x0b\xc2x\xc8\x7f\x13\xa0" +
+	"qtw\xae\xf3\xbf\xde\xf4lr'\xcc\x1f(\xe38" +
+	"V\x048\x8e\xa7w\\\xd5\xf9\xf8\x8b\xe7\x9fu\x03\xdd" +
+	"\x12\x10@\xb7\x09\x03\xdb\xfbH\x84L\xa0\x93\x81w\xe5" +
+	"\xbe\x00\x07zu\xa0\x17\xd0\x98\xfc\xed\xbf?\xe6\xeb\xd1" +
+	"\xb7\x82\x12@\xc9\x01\xc8+J\xe8\xf3\xc0\x9b\xf2\xb1\x80" +
+	"\xc8d`\x1d\x02\x1a\xb1\xb3/\xfe\xcf\x99\xd9\x87\xb7\x02" +
+	"\xbd\xca^{Uh\x05_\xfb\x81\x10_\xbbs\xf0\xcc" +
+	"\x8a\xc9s\xe5\xe7G\x01\xd8\x82>\x82\x1eyw\xe8\xa4" +
+	"\xfcV\x88{\xde\x1f\xe2\x08>x\xa0\xec\xd5o\x7f," +
+	"\xed\x1c\x9bK\x02 /\xa5_\xca\x8crk\x95r\xeb" +
+	"\x7f\x99?\xee\xc3\x09\xa5\xd3v\x02\xad\xb0\x17\x1f\xa2%" +
+	"|q,\xe3\x8b\xdf8\xb8\xfc\xf5\x06\xcf\xe3{\x80^" +
+	"-9\xe5\x06X?\xad\xac\x04\xe5\xeb\xca\xb8\xab\xd9e" +
+	"?\x024NT\xf8\x97\xcag\xef\xdf\xeb*\xaf\\Y" +
+	"\x09/\xaf\x19\x9e\xe0\x0bdyx\x9f\xeb\xcb\xede\x0d" +
+	"\xfc\xcb\xf8\x96[6\xad\xb9\xed\xa7\xef\xba\x13\xd3R&" +
+	"\xf1\xe5\x17\x8a\xe57\x9f\xf9\xe8\x9aA\xcf\xcc\xc1\xb1\xbb" +
+	"\xf1\"\xca}e\xef\xcakx\x08\xf5\xab\xcbnA@" +
+	"#\xf1\xfe\xf4]\xe7/\xfe\xef\xa0\xdb\xdf\x01\xb9\x8a\xfb" +
+	";(s\x7f\x93Wu\xee\xdc\xd7\xfc\xcb\xf7G\x13F" +
+	"\x12\xc5\"\xffZ\xf6\x96\xf3',\xff\x1a\xd0\x98\xda{" +
+	"\x7f\xf1}\xf3#\x1f\xe4\xbd\x11\xee\xed`\xb9\x88\xee\xf3" +
+	"r\x8e\x9egj`\xcfc'7\xfdflt\x12\x80" +
+	"\xfcp\xc59y\xa0\x82\xbb[_\xc1\xcb\"1\xfd_" +
+	"\xcf.><\xe3`\xde\x1d_\xb2\xfeD\x85\xc0zH" +
+	"\x18L\xfay\xea\xed\xe5\xe3[?\xe3u\xe3u\xeaF" +
+	"\x94Mr\xc2\x9brn\x02\xffI\xcf\x04\xb1\xd5o\x02" +
+	"\xc5\xa7sC\xff\x7f\xc8\x05\xea[\x955\x1cT\xe9\xde" +
+	"_\x0d\xbd7\xed\xf4\x97fA\x89/\xdb+\x97\xf1/" +
+	"\x7f\xfa$\xf2\xca\x0d\x83\xad\xc7\\\xbf\x19\xa8\x94\xf8\x97" +
+	"\x1b\x8f\xbe\xf3\xc8\xd9'n>\x064 \x8d\xa0\xf4\xaa" +
+	"\xca\xd7\xe55\x95<\x82\xd5\x95<\xcb\x03\xcf\x0d{s" +
+	"Sv\x1ds#\xfc~\xe5\x14\xbe\x89\x8f+9\xc2\xf2" +
+	"\xf0\x96\xf9e\xea\x17_\xb9\x0d\xbca\xc15\x7f\x98\x1b" +
+	"\x90\xff|fg\xf4\xd9G\xbf\x19C\xf1\x99\xe1\xcf\xe4" +
+	"9a\xbe\xd4u\xe1\xf9\xf2\xed\xfc\xe9\x8b\xaf\xcf\x1dz" +
+	"[\x1b\x7f\xdcU\x9d-\xa6\xaf6\xe1k\xcf\xc9\xfd\x1f" +
+	"\xdcp\xe4\xe4\x891J\x94\x0c?'\xe7\x84\xaf\x9e\xf0" +
+	"|y\x80?\x19G\xe7\xea\x87v\xed\xaa8%\x16\xb6" +
+	"5\x0cP^\x1d\xfeR~X\x18?\x10\x9e/\xbf\xc4" +
+	"\x9f.6]\xfb\x8b\x9b7\xaf?\xe5d^\x1e\x08\x9f" +
+	"\x03\x947\x86y\xe2\xc3\xfd\x07\xe9\x96\xf3;N\x15\xd2" +
+	"\xb4\xb6\x89%(\xdf>\x91\xffd\xe9\xc4^XjD" +
+	"\x13\xb9\xac\xce\xb4Z)\xaafR\x99\x86\x05\xe9\xac^" +
+	"\x9bd\xbaZ\xdd\xd8\xaejj2k\x1b\x10\x97\x81\x1a" +
+	"\x8b\xb5k,\xa3jL\xabn\x8f\x08;\xc5C<\x00" +
+	"\x1e\x04\xa0\xfeV\x00\xa5\x94\xa0\x12\x96\xd0\xc8\xe4\xed\x00" +
+	"\x00\xa9\xb3K@\xa4\x80\xb6s4\x9d/\x89\x13\xd6\xab" +
+	"\xccBw\x1e\x966\xbbt\xf7\xe6fG\xec\xa8R\xe7" +
+	"\xe8\x09mku\xa4\x88\xb6-s\xba\x06m\xebpT" +
+	"\x9e\xb6mpt\x82*\xcb\x1c\x0aso6p\xb4M" +
+	"s\xc8H\xdb\x1a\x1c.\xd1\x85+\xfa\x17\xa8\xa9X\x82" +
+	"i\xfd\xb7\xa8z\xb4\x9bi\x91\x96\x95,\xa5\x1b\x9d," +
+	"\xc1\xa2z\x9ao\xd4\xb8>\x9d\xca\xea\x9a\x1a\x07\x92\xd2" +
+	"\x8dv\x8d\xc5\xe2QU\x07dF\x07\xcb\xa6sZ\x94" +
+	"\xe1\xf5\xe9dF\xd5\xe2Y\x92N\x19\xd63\x90t*" +
+	"\xb20\x15c\xf7\xd8v\x10Y\xa2&r\xac\xb1\x83E" +
+	"\xd3Z\xcchS\xfbV\xb0\x0e\x16\x05_Z\x8b)!" +
+	"\xe2\x05\xb0\x89\x8bVm\xd3\x9e\x06\x90(\xf3!Zu" +
+	"\xe2\xa2\xd6\xd2\x1a\x90h\x9b\x0f%\xbb\xb1\xa3%Kt" +
+	"^3H\xf4:\x1f\x12[[\xd0\x92M:\xa3\x0e$" +
+	":\xc9\xd7\x98H\xa7\xef\xcee\x9a0\x18\xd7\x99\xd6\x84" +
+	"\xfd\x1a[\xc9\xb4,k\xc2H/G\xa3\x09\xdb\x11G" +
+	"\x97\xcc\x928\xeb\xad\xcd\x1bV\x8b\xaa\xc2lA\x9b<" +
+	"\xb0\xb5\x1a\x8b\xae\xb4\xea\xcf]W\x0d\x00J\x11A\xa5" +
+	"\\\xc2FM \x82!'O\x80\x18\x02\x1c]\xd0\xc2" +
+	"\xb1@8\xa2\xc5\xb3\xe9\x94\xe2Aw\xdf\xc0*rS" +
+	"F)\xb5\xd7h\x99\x02\xa04\x11T\x16I\x88X\x8e" +
+	"\xfc\xdd\xc2*\x00\xe5\x06\x82J\xbb\x84T\xc2r\x94\x00" +
+	"h[\x1d\x80\xb2\x80\xa0\xb2XB\xdf\xdd\xac\x0fKA" +
+	"\xc2R@\x92\xce`\xd0Y\x00\x10\x83\x80\x91\x95<\x8b" +
+	"8\x1e$\x1c\x0fX\x90RY\xa6\xb7q\xdau\xb0l" +
+	".A\xf4l\xc1}\x88R\x0aFU\x9d\xb5#*\x93" +
+	"\x89\xa7\xd40D\xd8\x07x\xd8\x83\x04\x95\xdfI\xe8\xc7" +
+	"\x8b\x86\x19\xf81\x1e\xf8a\x82\xca\xb0\x84~\xe9\x82a" +
+	"F>\xc4mO\x11\xec@\x09\xfdd\xd8(G\x02@" +
+	"/\xf0\xb7g\x09vz\xf8k\xcfy\xa3\x1c=\x002" +
+	"b\x0d\x802L\xb0\xb3\x88\xbf\xf7\x9e3\xca\xd1\x0b " +
+	"{\xb1\x19\xa0\x03\x09v\x96\xf2\xf7\xe3\x86\x8cr\x1c\xc7" +
+	"\xc7:n\xdf\xe9\xe1\x1fB\xfc\x83\xef\xacQ.\xc6\x10" +
+	"?\xb6\x02t\x96\xf2\x0fa\x94\xd0\xa7\xa6b\x18\x00l" +
+	"'\x88!\x87\xac\x80\xfc%Ik\x97\xfc\xe6K\xa5\xf5" +
+	"QoC\x80\xben5ke \xc8\xe5\xcb\xfaG\x7f" +
+	"T\x90\x8ba\xc8=\x1f\xf0\x9f\x04\xbb\xd3Y\xdd23" +
+	"4\x8bp\x00\x18r\xf4bTM\xa1\x950\x92\xd5E" +
+	"!9\xaa\x8d\xadF\xbb#uynZ\xa3\x1dZ\xd3" +
+	"-\xedi\xces\xd3\xeeUh\xf5J\xba\xb4\x03$\xaa" +
+	"pnZ\xc3=Z#\x08m\xe1\xbc\x9d\xc3\xb9i\xcd" +
+	"\xf7h\x0d.t\xe6\x0a\x90\xe84_\x7f\xbe\x84\x9a\xd0" +
+	"\xc8\xa5\xccg@\xb5\xc9\xc4\xa3\x09\x0dK\xb4\xc1\xa71" +
+	"\xed\xd2D\xb5\xa4\xc7\xc5\x19^l.\x8a\xb4\xba\xe8`" +
+	"q\xa4\xad\xca\xa1\x83\xcd\x11\x85sd\x11A\xe5Vi" +
+	"$\xc2A\xa7\xa5\x9b\x04\xf9kX\xe3&\x84\x90`\xc2" +
+	"4\x1e\xa1G@n\x0d\xcb\x98z\xe9\x8d\xde\xfa\x0dw" +
+	"\x0cP\xca\xa1\xf3\xfa\x82\\UFn\xdb\xedI\x88x" +
+	"\xa4vq_Fp\xabTl`R\x8dhT\x15\x0d" +
+	"\x00(Q\x7f\x1d@\xf0\xaet<\xd5\x98\xcb\xc4T\x9d" +
+	"E\x12L]\xc9.\xcb\xe6\xbc\xda\x15\x12\xb1j\x09\x1b" +
+	"\xef\x8c\xb3D,k\xd5:\xaf\xc5\x00\x14\xceJ\xbe\xd7" +
+	"\\R\x1a\xeb\x1ci\x8c0\xbe\x15\x0c\xb9\x0f\x1d\x97T" +
+	"F\xb1m\x00Q\xcc\xce\x89\x05k\x82\x1c\x08\xa5\xc8^" +
+	"`\x06\x97\x81j\x82\xca,G\x17g\xf2\xadL'\xa8" +
+	"\\+aP\xef\xcb0\x0c:>\xcc\x14~g\x99^" +
+	"\xc0TM_\xc1T\x1d8\xfaW\xd9\xeb\xee\xe2\xb2\xb4" +
+	"\x83\xa0\xb2\xc7Ul\xbb\xf9\xc2/\x13T\xde\x90\x10%" +
+	"\xb3\xd6\xf6\xf2\x00_#\xa8\xfcLBJ\xd0\x14\xb5\xfd" +
+	"\xfc\xe5\x1e\x82\xca\xa7\x12R\x8f$$\x8d~\xdc\x01\xa0" +
+	"|DP9,!\xf5\x12\xa1g\xf4sn\xf9)A" +
+	"\xe5\xa8\x84t\xdcd!f\xf4H3\x80r\xc8TU" +
+	"\x9f\xae'\xb0\x08$,\x02l\xcc2m%\xd3\xb0\x18" +
+	"$,\x1e\xa5$\xa6\xfa\x8c\xca\xa8]\xfb\x98\xc5\x903" +
+	"I\xe7\x85H\xcd\xe9\xdd\xe8\x07\x09\xfd\x80\xfd\xbc\xa6\xe2" +
+	"\xa9.D\x90\x10]8y\\\xd5e\x09Mm~\xb8" +
+	"\x12M\xc3\x97\xd0\xff\xc6:\x1b\xd9)SY=\xa2\xa9" +
+	"\xf1\x94\x9eg\xbd\xd5bZ\xea\x9c\xce\xe8\xb4\x18wo" +
+	"tZ\x8c\xbb9F\x12\xf1d\\\xb7\xb0\"z\x1aC" +
+	"\xce\xd4e\"\x10\xe9\xedf\x1a\x1b\xab\xea\x05#\x14s" +
+	"\x96OOk\xa3\xe3\x9bR0\xbe\xba\x82\xf1\xd5\xb8\x9a" +
+	"\xb7\x9aH\xc0\xb8H\x92\x13llh\xc1;\xb5tr" +
+	"\xec\xeb\xd1\x1c\xed\x8cw\xa5\x98V\x9b\x8dw\xa5L\xce" +
+	"g\x01\xdc\xc9\xe8pM\xc4\xd1n5\x91`\xa9.@" +
+	"fe\xbd \xe5\xad\xb10\x9d\xaa\xbd)\x03\x82\x17a" +
+	"\x11\xfd\xbc*\xa1Js\xaa\x84*\xcd\xe6\x7f\x08\x9d\xc1" +
+	"\xffx\xe8?\xf3?^:\xb1\x0a\x80\xb0\x1e\x92b$" +
+	"\xa1\x93\x04#]:\xe9b\x05\x97\x11s\x9b9v\xe8" +
+	"Y\x80+\xce\xfb\x1d\x8d\xa6iA\xc3\xa4=\xc2\xe8Y" +
+	"\xf8\xfb\xabQ\x0c\xc3\xf9\xad\xdb\xbe\x06\xb8\xaf\x1f\x12T" +
+	"\x9e\xe2\x896\xf2\x89\xde\xc8S\xfa\x18Ae\x13O\xf4" +
+	"\xc5|\xa2\x9f\xe6\xb6O\x10T\xb6\xf0Y\xe7B~\xd6" +
+	"\xd9\xccm\x9f\"\xa8l\xe3\xa3\xce\xb09\xea\xd0\xad\xfc" +
+	"\xed&\x82\xca\x0e>\xe8\x9c7\x07\x1d\xba\x9dw\xbbm" +
+	"\x04\x95\xd7$l\xcch\xec\xce\xf8=\x167\x83\x19\xc6" +
+	"4\x8b\xf7\x96&\xe43Z@\x13\x0a\x8f\x1a\xf6\x99\xa3" +
+	"pa\x8d\x98\x9d\xad\xd9\xd0\x0dl\x8d#\xfc\xc1\x95q" +
+	"\xd6\x8b\xd4}lEz\x09d\xc5\x81\x02c\x1c\xd9\x90" +
+	"\xedL\xe5\xcen#\xa8t;\"\xcf8\x82\xcb\x09*" +
+	"\x09Wc\x8fs\xae\xc5\x08*\x19\x97\xd8&y\x91'" +
+	"\x08*\xf7H\x85\xa1\xc9K\x80/\xcbz\xacg\xa3;" +
+	"\xaf\xfb\x80\xbcc\xd9wS\x97\x01\xc3\xac\xd7<\xc9\xdc" +
+	"cI\xb3\xc3\x7f\xbbS,lu\x8f%\xf9V\xa1\xac" +
+	"\x00P\xda\xcd-\xf5w\x9bg\x0e\xa4\xce)3\x0f[" +
+	"\xd69\xd0a\xc89iZ\xa1YG<_J\xcf:" +
+	"\xd3\xaa}\x065\xa7\xd5\x82\xd8\x8bS\\\xfeH\xc7\x13" +
+	"P\xe4\x88\xd8\x8cf\xa7\xcd:\"6\xb3\xc6i\xb4\xfd" +
+	"\xa9\xb4\xde\x1dOu\xc1\xb8\xe0]\xb9\xac~\x99\xce\xea" +
+	"\x06\xcd<\xb69\xd2\xe4j\xec\xad\x8eo\x1b\xb6\xd9\x1c" +
+	"\xa1Y&\x96\xdf\x0b\x0e\xd6\x90\x99\x1d\xcd\xed\x1a\x87\xdb" +
+	"v4\x1b\x9b\x1dj\xdbI|\xba\xd5\xe10%\xc4," +
+	"\xc1\xad\xcd\x0e\x85\xa9\x07M^o\xe7-`\x0bA\xe5" +
+	"e\xde\xef\x8bLZ\xbf\xd4\xea\xcc\x15\xc1h&\x97\xb5" +
+	"z{\x7f4\x93[\x94Vc\xf6\xd8\x99d\xc9\xc5i" +
+	"]M\x00\x80U\xb5\xfdI\x96\xbcQc\xcc\xfaw$" +
+	"\xa3\xa5\xa3\xb6\x07#\xaaf\xd4h\\\xef\xe3\xbf\xb0\xdf" +
+	"\x15\x10KkJ\x17\x8a\x19\x1c\xa1\xa9\x97\xed\xf6<\x8b" +
+	"\xc4u3\xe3u%\x9a\x1f\xcbm\x01\xb6\x0c,\xcc\x1b" +
+	"M\xd0]\x9d\xc4\x9co\xe74\x9b\x9d\xa4Ut\x92\x99" +
+	"\xcd\xa2\x93L\xab\x13\x9ddR+\x80\x00\xc8\x02\xc6\x0d" +
+	"\x88\x05\x84\x09\x80{\xe3W\xd8p\xbb\xaa\xf9FM\xb0" +
+	"5N\x87\x08\xde\xcd\xfa\xbe\xd3T\x9c/ks\xbfx" +
+	"\x99\xbb\x02\xf1=\xe4\\\xe3\\f\xbe\x10\x97\x10#\x8e" +
+	"\x14\xd6\x7ft|\x97#E\x81y\xe0J\xd1q#\x16" +
+	"\x1b3\x0a\xa0\xe5)\xd8\x95rGc]\x8a\xa3\xb8}" +
+	"\x84\xf1\xc7\xedh\xb8\xa3\x82\x07\x1c\xa7\x8c|\x9a\xdb\x95" +
+	"u\x17\x8b\xd6-?\xa5\xcd\xc2U\x7f\xbe\xd4.}\\" +
+	"\x12uf\x9dC\xfe\x81\"l\xdf\xfb}\x1f\"\\\xe8" +
+	"\x98+\xee\xd7\xcc\x11\xe3J\xa2\xc8u\xe4\xdf\x08*\xff" +
+	"q\xa5\xd3\xec\xc8\x83\xeb_\x06\x00\xdf\xe0\x8e\xb6"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
 		String: schema_fcf6ac08e448a6ac,
 		Nodes: []uint64{
+			0x828b2823e5eeb7be,
+			0x89ec8e1ef0f263f3,
 			0x8a1df0335afc249a,
 			0x8b1fd983f1df482d,
 			0x8eb96dceb6a99ebd,
+			0x8f4c3dbda216e68e,
+			0x8f58928e854cd4f5,
+			0x926dd174ab4af74c,
+			0x957cbefc645fd307,
+			0x99522456de240c14,
+			0x9a41501dc1aea893,
+			0x9ea0d57316239ccb,
+			0xa404c24b5375b9e4,
+			0xa6fbaf9b531cb0f5,
+			0xa94e26d7a3b4d37d,
 			0xa97471079836f720,
+			0xa9e031f65eaff864,
 			0xab133d2062f6cc53,
 			0xb2029ff7b712d18a,
 			0xb2250c16d3064727,
+			0xbc9b043aba60cc46,
+			0xbd86f813590d15f1,
+			0xbe186003ae0f0429,
+			0xcabb5c85a457450b,
 			0xcc2d04cc26d4f6a5,
 			0xcc7efefbb528cd6c,
 			0xcdcf42beb2537d20,
+			0xce1f478309867723,
 			0xd6a4f298bc0e2304,
 			0xd929e054f82b286c,
+			0xda4a0b60c66ec81d,
+			0xdc88f975f5090eee,
+			0xe2f525cbf9d07c02,
 			0xe54acc44b61fd7ef,
+			0xe5559cf892c7e446,
+			0xe5b5227505fcaa99,
 			0xe6df611247a8fc13,
 			0xee93a663b2a23c03,
 			0xf00b0072c6dcfae7,
 			0xf1f2e144cec1f2bc,
+			0xf415b5b5dc743de4,
 			0xf495a555c9344000,
+			0xf4b0fba811d97f18,
 		},
 		Compressed: true,
 	})
@@ -9,9 +9,14 @@ import (
 	schemas "capnproto.org/go/capnp/v3/schemas"
 	server "capnproto.org/go/capnp/v3/server"
 	context "context"
+	anchor "github.com/wetware/pkg/api/anchor"
 	capstore "github.com/wetware/pkg/api/capstore"
+	channel "github.com/wetware/pkg/api/channel"
 	cluster "github.com/wetware/pkg/api/cluster"
 	process "github.com/wetware/pkg/api/process"
+	pubsub "github.com/wetware/pkg/api/pubsub"
+	registry "github.com/wetware/pkg/api/registry"
+	strconv "strconv"
 )
 
 type Terminal capnp.Client
@@ -675,12 +680,12 @@ type Session_local Session
 const Session_TypeID = 0xc65521f186b6e059
 
 func NewSession(s *capnp.Segment) (Session, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 6})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 11})
 	return Session(st), err
 }
 
 func NewRootSession(s *capnp.Segment) (Session, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 6})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 11})
 	return Session(st), err
 }
 
@@ -850,13 +855,102 @@ func (s Session) NewExtra(n int32) (Session_Extra_List, error) {
 	err = capnp.Struct(s).SetPtr(5, l.ToPtr())
 	return l, err
 }
+func (s Session) PubSub() pubsub.Router {
+	p, _ := capnp.Struct(s).Ptr(6)
+	return pubsub.Router(p.Interface().Client())
+}
+
+func (s Session) HasPubSub() bool {
+	return capnp.Struct(s).HasPtr(6)
+}
+
+func (s Session) SetPubSub(v pubsub.Router) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(6, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(6, in.ToPtr())
+}
+
+func (s Session) Anchor() anchor.Anchor {
+	p, _ := capnp.Struct(s).Ptr(7)
+	return anchor.Anchor(p.Interface().Client())
+}
+
+func (s Session) HasAnchor() bool {
+	return capnp.Struct(s).HasPtr(7)
+}
+
+func (s Session) SetAnchor(v anchor.Anchor) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(7, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(7, in.ToPtr())
+}
+
+func (s Session) Registry() registry.Registry {
+	p, _ := capnp.Struct(s).Ptr(8)
+	return registry.Registry(p.Interface().Client())
+}
+
+func (s Session) HasRegistry() bool {
+	return capnp.Struct(s).HasPtr(8)
+}
+
+func (s Session) SetRegistry(v registry.Registry) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(8, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(8, in.ToPtr())
+}
+
+func (s Session) Host() cluster.Host {
+	p, _ := capnp.Struct(s).Ptr(9)
+	return cluster.Host(p.Interface().Client())
+}
+
+func (s Session) HasHost() bool {
+	return capnp.Struct(s).HasPtr(9)
+}
+
+func (s Session) SetHost(v cluster.Host) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(9, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(9, in.ToPtr())
+}
+
+func (s Session) Scheduler() Scheduler {
+	p, _ := capnp.Struct(s).Ptr(10)
+	return Scheduler(p.Interface().Client())
+}
+
+func (s Session) HasScheduler() bool {
+	return capnp.Struct(s).HasPtr(10)
+}
+
+func (s Session) SetScheduler(v Scheduler) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(10, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(10, in.ToPtr())
+}
 
 // Session_List is a list of Session.
 type Session_List = capnp.StructList[Session]
 
 // NewSession creates a new list of Session.
 func NewSession_List(s *capnp.Segment, sz int32) (Session_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 6}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 11}, sz)
 	return capnp.StructList[Session](l), err
 }
 
@@ -888,6 +982,26 @@ func (p Session_Future) CapStore() capstore.CapStore {
 	return capstore.CapStore(p.Future.Field(4, nil).Client())
 }
 
+func (p Session_Future) PubSub() pubsub.Router {
+	return pubsub.Router(p.Future.Field(6, nil).Client())
+}
+
+func (p Session_Future) Anchor() anchor.Anchor {
+	return anchor.Anchor(p.Future.Field(7, nil).Client())
+}
+
+func (p Session_Future) Registry() registry.Registry {
+	return registry.Registry(p.Future.Field(8, nil).Client())
+}
+
+func (p Session_Future) Host() cluster.Host {
+	return cluster.Host(p.Future.Field(9, nil).Client())
+}
+
+func (p Session_Future) Scheduler() Scheduler {
+	return Scheduler(p.Future.Field(10, nil).Client())
+}
+
 type Session_Extra capnp.Struct
 
 // Session_Extra_TypeID is the unique identifier for the type Session_Extra.
@@ -1096,6 +1210,46 @@ func (c Executor) DialPeer(ctx context.Context, params func(Executor_dialPeer_Pa
 
 }
 
+func (c Executor) NewChan(ctx context.Context, params func(Executor_newChan_Params) error) (Executor_newChan_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x804fe3440f678ff3,
+			MethodID:      5,
+			InterfaceName: "core.capnp:Executor",
+			MethodName:    "newChan",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Executor_newChan_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Executor_newChan_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c Executor) Selector(ctx context.Context, params func(Executor_selector_Params) error) (Executor_selector_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x804fe3440f678ff3,
+			MethodID:      6,
+			InterfaceName: "core.capnp:Executor",
+			MethodName:    "selector",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Executor_selector_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Executor_selector_Results_Future{Future: ans.Future()}, release
+
+}
+
 func (c Executor) WaitStreaming() error {
 	return capnp.Client(c).WaitStreaming()
 }
@@ -1178,6 +1332,10 @@ type Executor_Server interface {
 	BytecodeCache(context.Context, Executor_bytecodeCache) error
 
 	DialPeer(context.Context, Executor_dialPeer) error
+
+	NewChan(context.Context, Executor_newChan) error
+
+	Selector(context.Context, Executor_selector) error
 }
 
 // Executor_NewServer creates a new Server from an implementation of Executor_Server.
@@ -1196,7 +1354,7 @@ func Executor_ServerToClient(s Executor_Server) Executor {
 // This can be used to create a more complicated Server.
 func Executor_Methods(methods []server.Method, s Executor_Server) []server.Method {
 	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 5)
+		methods = make([]server.Method, 0, 7)
 	}
 
 	methods = append(methods, server.Method{
@@ -1259,6 +1417,30 @@ func Executor_Methods(methods []server.Method, s Executor_Server) []server.Metho
 		},
 	})
 
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x804fe3440f678ff3,
+			MethodID:      5,
+			InterfaceName: "core.capnp:Executor",
+			MethodName:    "newChan",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.NewChan(ctx, Executor_newChan{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x804fe3440f678ff3,
+			MethodID:      6,
+			InterfaceName: "core.capnp:Executor",
+			MethodName:    "selector",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Selector(ctx, Executor_selector{call})
+		},
+	})
+
 	return methods
 }
 
@@ -1347,6 +1529,40 @@ func (c Executor_dialPeer) AllocResults() (Executor_dialPeer_Results, error) {
 	return Executor_dialPeer_Results(r), err
 }
 
+// Executor_newChan holds the state for a server call to Executor.newChan.
+// See server.Call for documentation.
+type Executor_newChan struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Executor_newChan) Args() Executor_newChan_Params {
+	return Executor_newChan_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Executor_newChan) AllocResults() (Executor_newChan_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_newChan_Results(r), err
+}
+
+// Executor_selector holds the state for a server call to Executor.selector.
+// See server.Call for documentation.
+type Executor_selector struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Executor_selector) Args() Executor_selector_Params {
+	return Executor_selector_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Executor_selector) AllocResults() (Executor_selector_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_selector_Results(r), err
+}
+
 // Executor_List is a list of Executor.
 type Executor_List = capnp.CapList[Executor]
 
@@ -2277,6 +2493,1005 @@ func (p Executor_dialPeer_Results_Future) Session() Session_Future {
 	return Session_Future{Future: p.Future.Field(0, nil)}
 }
 
+type Executor_newChan_Params capnp.Struct
+
+// Executor_newChan_Params_TypeID is the unique identifier for the type Executor_newChan_Params.
+const Executor_newChan_Params_TypeID = 0xa30f8d4b539ce176
+
+func NewExecutor_newChan_Params(s *capnp.Segment) (Executor_newChan_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Executor_newChan_Params(st), err
+}
+
+func NewRootExecutor_newChan_Params(s *capnp.Segment) (Executor_newChan_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Executor_newChan_Params(st), err
+}
+
+func ReadRootExecutor_newChan_Params(msg *capnp.Message) (Executor_newChan_Params, error) {
+	root, err := msg.Root()
+	return Executor_newChan_Params(root.Struct()), err
+}
+
+func (s Executor_newChan_Params) String() string {
+	str, _ := text.Marshal(0xa30f8d4b539ce176, capnp.Struct(s))
+	return str
+}
+
+func (s Executor_newChan_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Executor_newChan_Params) DecodeFromPtr(p capnp.Ptr) Executor_newChan_Params {
+	return Executor_newChan_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Executor_newChan_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Executor_newChan_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Executor_newChan_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Executor_newChan_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Executor_newChan_Params) Size() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s Executor_newChan_Params) SetSize(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+// Executor_newChan_Params_List is a list of Executor_newChan_Params.
+type Executor_newChan_Params_List = capnp.StructList[Executor_newChan_Params]
+
+// NewExecutor_newChan_Params creates a new list of Executor_newChan_Params.
+func NewExecutor_newChan_Params_List(s *capnp.Segment, sz int32) (Executor_newChan_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[Executor_newChan_Params](l), err
+}
+
+// Executor_newChan_Params_Future is a wrapper for a Executor_newChan_Params promised by a client call.
+type Executor_newChan_Params_Future struct{ *capnp.Future }
+
+func (f Executor_newChan_Params_Future) Struct() (Executor_newChan_Params, error) {
+	p, err := f.Future.Ptr()
+	return Executor_newChan_Params(p.Struct()), err
+}
+
+type Executor_newChan_Results capnp.Struct
+
+// Executor_newChan_Results_TypeID is the unique identifier for the type Executor_newChan_Results.
+const Executor_newChan_Results_TypeID = 0xe07113a66bea48db
+
+func NewExecutor_newChan_Results(s *capnp.Segment) (Executor_newChan_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_newChan_Results(st), err
+}
+
+func NewRootExecutor_newChan_Results(s *capnp.Segment) (Executor_newChan_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_newChan_Results(st), err
+}
+
+func ReadRootExecutor_newChan_Results(msg *capnp.Message) (Executor_newChan_Results, error) {
+	root, err := msg.Root()
+	return Executor_newChan_Results(root.Struct()), err
+}
+
+func (s Executor_newChan_Results) String() string {
+	str, _ := text.Marshal(0xe07113a66bea48db, capnp.Struct(s))
+	return str
+}
+
+func (s Executor_newChan_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Executor_newChan_Results) DecodeFromPtr(p capnp.Ptr) Executor_newChan_Results {
+	return Executor_newChan_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Executor_newChan_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Executor_newChan_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Executor_newChan_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Executor_newChan_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Executor_newChan_Results) Chan() channel.Chan {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return channel.Chan(p.Interface().Client())
+}
+
+func (s Executor_newChan_Results) HasChan() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Executor_newChan_Results) SetChan(v channel.Chan) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// Executor_newChan_Results_List is a list of Executor_newChan_Results.
+type Executor_newChan_Results_List = capnp.StructList[Executor_newChan_Results]
+
+// NewExecutor_newChan_Results creates a new list of Executor_newChan_Results.
+func NewExecutor_newChan_Results_List(s *capnp.Segment, sz int32) (Executor_newChan_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Executor_newChan_Results](l), err
+}
+
+// Executor_newChan_Results_Future is a wrapper for a Executor_newChan_Results promised by a client call.
+type Executor_newChan_Results_Future struct{ *capnp.Future }
+
+func (f Executor_newChan_Results_Future) Struct() (Executor_newChan_Results, error) {
+	p, err := f.Future.Ptr()
+	return Executor_newChan_Results(p.Struct()), err
+}
+func (p Executor_newChan_Results_Future) Chan() channel.Chan {
+	return channel.Chan(p.Future.Field(0, nil).Client())
+}
+
+type Executor_selector_Params capnp.Struct
+
+// Executor_selector_Params_TypeID is the unique identifier for the type Executor_selector_Params.
+const Executor_selector_Params_TypeID = 0xcad0ff76692b378f
+
+func NewExecutor_selector_Params(s *capnp.Segment) (Executor_selector_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Executor_selector_Params(st), err
+}
+
+func NewRootExecutor_selector_Params(s *capnp.Segment) (Executor_selector_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Executor_selector_Params(st), err
+}
+
+func ReadRootExecutor_selector_Params(msg *capnp.Message) (Executor_selector_Params, error) {
+	root, err := msg.Root()
+	return Executor_selector_Params(root.Struct()), err
+}
+
+func (s Executor_selector_Params) String() string {
+	str, _ := text.Marshal(0xcad0ff76692b378f, capnp.Struct(s))
+	return str
+}
+
+func (s Executor_selector_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Executor_selector_Params) DecodeFromPtr(p capnp.Ptr) Executor_selector_Params {
+	return Executor_selector_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Executor_selector_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Executor_selector_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Executor_selector_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Executor_selector_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Executor_selector_Params_List is a list of Executor_selector_Params.
+type Executor_selector_Params_List = capnp.StructList[Executor_selector_Params]
+
+// NewExecutor_selector_Params creates a new list of Executor_selector_Params.
+func NewExecutor_selector_Params_List(s *capnp.Segment, sz int32) (Executor_selector_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Executor_selector_Params](l), err
+}
+
+// Executor_selector_Params_Future is a wrapper for a Executor_selector_Params promised by a client call.
+type Executor_selector_Params_Future struct{ *capnp.Future }
+
+func (f Executor_selector_Params_Future) Struct() (Executor_selector_Params, error) {
+	p, err := f.Future.Ptr()
+	return Executor_selector_Params(p.Struct()), err
+}
+
+type Executor_selector_Results capnp.Struct
+
+// Executor_selector_Results_TypeID is the unique identifier for the type Executor_selector_Results.
+const Executor_selector_Results_TypeID = 0x9f9e3edf227eb7f0
+
+func NewExecutor_selector_Results(s *capnp.Segment) (Executor_selector_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_selector_Results(st), err
+}
+
+func NewRootExecutor_selector_Results(s *capnp.Segment) (Executor_selector_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_selector_Results(st), err
+}
+
+func ReadRootExecutor_selector_Results(msg *capnp.Message) (Executor_selector_Results, error) {
+	root, err := msg.Root()
+	return Executor_selector_Results(root.Struct()), err
+}
+
+func (s Executor_selector_Results) String() string {
+	str, _ := text.Marshal(0x9f9e3edf227eb7f0, capnp.Struct(s))
+	return str
+}
+
+func (s Executor_selector_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Executor_selector_Results) DecodeFromPtr(p capnp.Ptr) Executor_selector_Results {
+	return Executor_selector_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Executor_selector_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Executor_selector_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Executor_selector_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Executor_selector_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Executor_selector_Results) Selector() channel.Selector {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return channel.Selector(p.Interface().Client())
+}
+
+func (s Executor_selector_Results) HasSelector() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Executor_selector_Results) SetSelector(v channel.Selector) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// Executor_selector_Results_List is a list of Executor_selector_Results.
+type Executor_selector_Results_List = capnp.StructList[Executor_selector_Results]
+
+// NewExecutor_selector_Results creates a new list of Executor_selector_Results.
+func NewExecutor_selector_Results_List(s *capnp.Segment, sz int32) (Executor_selector_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Executor_selector_Results](l), err
+}
+
+// Executor_selector_Results_Future is a wrapper for a Executor_selector_Results promised by a client call.
+type Executor_selector_Results_Future struct{ *capnp.Future }
+
+func (f Executor_selector_Results_Future) Struct() (Executor_selector_Results, error) {
+	p, err := f.Future.Ptr()
+	return Executor_selector_Results(p.Struct()), err
+}
+func (p Executor_selector_Results_Future) Selector() channel.Selector {
+	return channel.Selector(p.Future.Field(0, nil).Client())
+}
+
+type Scheduler capnp.Client
+
+// Scheduler_TypeID is the unique identifier for the type Scheduler.
+const Scheduler_TypeID = 0xc1c86c7ca202ebf7
+
+func (c Scheduler) Schedule(ctx context.Context, params func(Scheduler_schedule_Params) error) (Scheduler_schedule_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xc1c86c7ca202ebf7,
+			MethodID:      0,
+			InterfaceName: "core.capnp:Scheduler",
+			MethodName:    "schedule",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 4}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Scheduler_schedule_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Scheduler_schedule_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c Scheduler) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c Scheduler) String() string {
+	return "Scheduler(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c Scheduler) AddRef() Scheduler {
+	return Scheduler(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c Scheduler) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c Scheduler) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c Scheduler) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (Scheduler) DecodeFromPtr(p capnp.Ptr) Scheduler {
+	return Scheduler(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c Scheduler) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c Scheduler) IsSame(other Scheduler) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c Scheduler) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c Scheduler) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A Scheduler_Server is a Scheduler with a local implementation.
+type Scheduler_Server interface {
+	Schedule(context.Context, Scheduler_schedule) error
+}
+
+// Scheduler_NewServer creates a new Server from an implementation of Scheduler_Server.
+func Scheduler_NewServer(s Scheduler_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Scheduler_Methods(nil, s), s, c)
+}
+
+// Scheduler_ServerToClient creates a new Client from an implementation of Scheduler_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Scheduler_ServerToClient(s Scheduler_Server) Scheduler {
+	return Scheduler(capnp.NewClient(Scheduler_NewServer(s)))
+}
+
+// Scheduler_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Scheduler_Methods(methods []server.Method, s Scheduler_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 1)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xc1c86c7ca202ebf7,
+			MethodID:      0,
+			InterfaceName: "core.capnp:Scheduler",
+			MethodName:    "schedule",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Schedule(ctx, Scheduler_schedule{call})
+		},
+	})
+
+	return methods
+}
+
+// Scheduler_schedule holds the state for a server call to Scheduler.schedule.
+// See server.Call for documentation.
+type Scheduler_schedule struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Scheduler_schedule) Args() Scheduler_schedule_Params {
+	return Scheduler_schedule_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Scheduler_schedule) AllocResults() (Scheduler_schedule_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Scheduler_schedule_Results(r), err
+}
+
+// Scheduler_List is a list of Scheduler.
+type Scheduler_List = capnp.CapList[Scheduler]
+
+// NewScheduler creates a new list of Scheduler.
+func NewScheduler_List(s *capnp.Segment, sz int32) (Scheduler_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[Scheduler](l), err
+}
+
+type Scheduler_Code capnp.Struct
+type Scheduler_Code_Which uint16
+
+const (
+	Scheduler_Code_Which_bytecode Scheduler_Code_Which = 0
+	Scheduler_Code_Which_cid      Scheduler_Code_Which = 1
+)
+
+func (w Scheduler_Code_Which) String() string {
+	const s = "bytecodecid"
+	switch w {
+	case Scheduler_Code_Which_bytecode:
+		return s[0:8]
+	case Scheduler_Code_Which_cid:
+		return s[8:11]
+
+	}
+	return "Scheduler_Code_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+}
+
+// Scheduler_Code_TypeID is the unique identifier for the type Scheduler_Code.
+const Scheduler_Code_TypeID = 0xdafeb01b41ee6a23
+
+func NewScheduler_Code(s *capnp.Segment) (Scheduler_Code, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Scheduler_Code(st), err
+}
+
+func NewRootScheduler_Code(s *capnp.Segment) (Scheduler_Code, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Scheduler_Code(st), err
+}
+
+func ReadRootScheduler_Code(msg *capnp.Message) (Scheduler_Code, error) {
+	root, err := msg.Root()
+	return Scheduler_Code(root.Struct()), err
+}
+
+func (s Scheduler_Code) String() string {
+	str, _ := text.Marshal(0xdafeb01b41ee6a23, capnp.Struct(s))
+	return str
+}
+
+func (s Scheduler_Code) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Scheduler_Code) DecodeFromPtr(p capnp.Ptr) Scheduler_Code {
+	return Scheduler_Code(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Scheduler_Code) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+
+func (s Scheduler_Code) Which() Scheduler_Code_Which {
+	return Scheduler_Code_Which(capnp.Struct(s).Uint16(0))
+}
+func (s Scheduler_Code) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Scheduler_Code) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Scheduler_Code) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Scheduler_Code) Bytecode() ([]byte, error) {
+	if capnp.Struct(s).Uint16(0) != 0 {
+		panic("Which() != bytecode")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s Scheduler_Code) HasBytecode() bool {
+	if capnp.Struct(s).Uint16(0) != 0 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Scheduler_Code) SetBytecode(v []byte) error {
+	capnp.Struct(s).SetUint16(0, 0)
+	return capnp.Struct(s).SetData(0, v)
+}
+
+func (s Scheduler_Code) Cid() ([]byte, error) {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		panic("Which() != cid")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s Scheduler_Code) HasCid() bool {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Scheduler_Code) SetCid(v []byte) error {
+	capnp.Struct(s).SetUint16(0, 1)
+	return capnp.Struct(s).SetData(0, v)
+}
+
+// Scheduler_Code_List is a list of Scheduler_Code.
+type Scheduler_Code_List = capnp.StructList[Scheduler_Code]
+
+// NewScheduler_Code creates a new list of Scheduler_Code.
+func NewScheduler_Code_List(s *capnp.Segment, sz int32) (Scheduler_Code_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Scheduler_Code](l), err
+}
+
+// Scheduler_Code_Future is a wrapper for a Scheduler_Code promised by a client call.
+type Scheduler_Code_Future struct{ *capnp.Future }
+
+func (f Scheduler_Code_Future) Struct() (Scheduler_Code, error) {
+	p, err := f.Future.Ptr()
+	return Scheduler_Code(p.Struct()), err
+}
+
+type Scheduler_Placement capnp.Struct
+
+// Scheduler_Placement_TypeID is the unique identifier for the type Scheduler_Placement.
+const Scheduler_Placement_TypeID = 0xca4205ad3ed74c37
+
+func NewScheduler_Placement(s *capnp.Segment) (Scheduler_Placement, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Scheduler_Placement(st), err
+}
+
+func NewRootScheduler_Placement(s *capnp.Segment) (Scheduler_Placement, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Scheduler_Placement(st), err
+}
+
+func ReadRootScheduler_Placement(msg *capnp.Message) (Scheduler_Placement, error) {
+	root, err := msg.Root()
+	return Scheduler_Placement(root.Struct()), err
+}
+
+func (s Scheduler_Placement) String() string {
+	str, _ := text.Marshal(0xca4205ad3ed74c37, capnp.Struct(s))
+	return str
+}
+
+func (s Scheduler_Placement) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Scheduler_Placement) DecodeFromPtr(p capnp.Ptr) Scheduler_Placement {
+	return Scheduler_Placement(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Scheduler_Placement) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Scheduler_Placement) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Scheduler_Placement) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Scheduler_Placement) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Scheduler_Placement) Constraints() (cluster.View_Constraint_List, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return cluster.View_Constraint_List(p.List()), err
+}
+
+func (s Scheduler_Placement) HasConstraints() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Scheduler_Placement) SetConstraints(v cluster.View_Constraint_List) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewConstraints sets the constraints field to a newly
+// allocated cluster.View_Constraint_List, preferring placement in s's segment.
+func (s Scheduler_Placement) NewConstraints(n int32) (cluster.View_Constraint_List, error) {
+	l, err := cluster.NewView_Constraint_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return cluster.View_Constraint_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+func (s Scheduler_Placement) Avoid() (capnp.UInt64List, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return capnp.UInt64List(p.List()), err
+}
+
+func (s Scheduler_Placement) HasAvoid() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s Scheduler_Placement) SetAvoid(v capnp.UInt64List) error {
+	return capnp.Struct(s).SetPtr(1, v.ToPtr())
+}
+
+// NewAvoid sets the avoid field to a newly
+// allocated capnp.UInt64List, preferring placement in s's segment.
+func (s Scheduler_Placement) NewAvoid(n int32) (capnp.UInt64List, error) {
+	l, err := capnp.NewUInt64List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.UInt64List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
+	return l, err
+}
+func (s Scheduler_Placement) Spread() bool {
+	return capnp.Struct(s).Bit(0)
+}
+
+func (s Scheduler_Placement) SetSpread(v bool) {
+	capnp.Struct(s).SetBit(0, v)
+}
+
+func (s Scheduler_Placement) Attempts() uint32 {
+	return capnp.Struct(s).Uint32(4)
+}
+
+func (s Scheduler_Placement) SetAttempts(v uint32) {
+	capnp.Struct(s).SetUint32(4, v)
+}
+
+// Scheduler_Placement_List is a list of Scheduler_Placement.
+type Scheduler_Placement_List = capnp.StructList[Scheduler_Placement]
+
+// NewScheduler_Placement creates a new list of Scheduler_Placement.
+func NewScheduler_Placement_List(s *capnp.Segment, sz int32) (Scheduler_Placement_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[Scheduler_Placement](l), err
+}
+
+// Scheduler_Placement_Future is a wrapper for a Scheduler_Placement promised by a client call.
+type Scheduler_Placement_Future struct{ *capnp.Future }
+
+func (f Scheduler_Placement_Future) Struct() (Scheduler_Placement, error) {
+	p, err := f.Future.Ptr()
+	return Scheduler_Placement(p.Struct()), err
+}
+
+type Scheduler_schedule_Params capnp.Struct
+
+// Scheduler_schedule_Params_TypeID is the unique identifier for the type Scheduler_schedule_Params.
+const Scheduler_schedule_Params_TypeID = 0xb968a90226d58125
+
+func NewScheduler_schedule_Params(s *capnp.Segment) (Scheduler_schedule_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 4})
+	return Scheduler_schedule_Params(st), err
+}
+
+func NewRootScheduler_schedule_Params(s *capnp.Segment) (Scheduler_schedule_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 4})
+	return Scheduler_schedule_Params(st), err
+}
+
+func ReadRootScheduler_schedule_Params(msg *capnp.Message) (Scheduler_schedule_Params, error) {
+	root, err := msg.Root()
+	return Scheduler_schedule_Params(root.Struct()), err
+}
+
+func (s Scheduler_schedule_Params) String() string {
+	str, _ := text.Marshal(0xb968a90226d58125, capnp.Struct(s))
+	return str
+}
+
+func (s Scheduler_schedule_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Scheduler_schedule_Params) DecodeFromPtr(p capnp.Ptr) Scheduler_schedule_Params {
+	return Scheduler_schedule_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Scheduler_schedule_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Scheduler_schedule_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Scheduler_schedule_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Scheduler_schedule_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Scheduler_schedule_Params) Session() (Session, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return Session(p.Struct()), err
+}
+
+func (s Scheduler_schedule_Params) HasSession() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Scheduler_schedule_Params) SetSession(v Session) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewSession sets the session field to a newly
+// allocated Session struct, preferring placement in s's segment.
+func (s Scheduler_schedule_Params) NewSession() (Session, error) {
+	ss, err := NewSession(capnp.Struct(s).Segment())
+	if err != nil {
+		return Session{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s Scheduler_schedule_Params) Code() (Scheduler_Code, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return Scheduler_Code(p.Struct()), err
+}
+
+func (s Scheduler_schedule_Params) HasCode() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s Scheduler_schedule_Params) SetCode(v Scheduler_Code) error {
+	return capnp.Struct(s).SetPtr(1, capnp.Struct(v).ToPtr())
+}
+
+// NewCode sets the code field to a newly
+// allocated Scheduler_Code struct, preferring placement in s's segment.
+func (s Scheduler_schedule_Params) NewCode() (Scheduler_Code, error) {
+	ss, err := NewScheduler_Code(capnp.Struct(s).Segment())
+	if err != nil {
+		return Scheduler_Code{}, err
+	}
+	err = capnp.Struct(s).SetPtr(1, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s Scheduler_schedule_Params) Args() (capnp.TextList, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return capnp.TextList(p.List()), err
+}
+
+func (s Scheduler_schedule_Params) HasArgs() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s Scheduler_schedule_Params) SetArgs(v capnp.TextList) error {
+	return capnp.Struct(s).SetPtr(2, v.ToPtr())
+}
+
+// NewArgs sets the args field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Scheduler_schedule_Params) NewArgs(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = capnp.Struct(s).SetPtr(2, l.ToPtr())
+	return l, err
+}
+func (s Scheduler_schedule_Params) Placement() (Scheduler_Placement, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return Scheduler_Placement(p.Struct()), err
+}
+
+func (s Scheduler_schedule_Params) HasPlacement() bool {
+	return capnp.Struct(s).HasPtr(3)
+}
+
+func (s Scheduler_schedule_Params) SetPlacement(v Scheduler_Placement) error {
+	return capnp.Struct(s).SetPtr(3, capnp.Struct(v).ToPtr())
+}
+
+// NewPlacement sets the placement field to a newly
+// allocated Scheduler_Placement struct, preferring placement in s's segment.
+func (s Scheduler_schedule_Params) NewPlacement() (Scheduler_Placement, error) {
+	ss, err := NewScheduler_Placement(capnp.Struct(s).Segment())
+	if err != nil {
+		return Scheduler_Placement{}, err
+	}
+	err = capnp.Struct(s).SetPtr(3, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// Scheduler_schedule_Params_List is a list of Scheduler_schedule_Params.
+type Scheduler_schedule_Params_List = capnp.StructList[Scheduler_schedule_Params]
+
+// NewScheduler_schedule_Params creates a new list of Scheduler_schedule_Params.
+func NewScheduler_schedule_Params_List(s *capnp.Segment, sz int32) (Scheduler_schedule_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 4}, sz)
+	return capnp.StructList[Scheduler_schedule_Params](l), err
+}
+
+// Scheduler_schedule_Params_Future is a wrapper for a Scheduler_schedule_Params promised by a client call.
+type Scheduler_schedule_Params_Future struct{ *capnp.Future }
+
+func (f Scheduler_schedule_Params_Future) Struct() (Scheduler_schedule_Params, error) {
+	p, err := f.Future.Ptr()
+	return Scheduler_schedule_Params(p.Struct()), err
+}
+func (p Scheduler_schedule_Params_Future) Session() Session_Future {
+	return Session_Future{Future: p.Future.Field(0, nil)}
+}
+func (p Scheduler_schedule_Params_Future) Code() Scheduler_Code_Future {
+	return Scheduler_Code_Future{Future: p.Future.Field(1, nil)}
+}
+func (p Scheduler_schedule_Params_Future) Placement() Scheduler_Placement_Future {
+	return Scheduler_Placement_Future{Future: p.Future.Field(3, nil)}
+}
+
+type Scheduler_schedule_Results capnp.Struct
+
+// Scheduler_schedule_Results_TypeID is the unique identifier for the type Scheduler_schedule_Results.
+const Scheduler_schedule_Results_TypeID = 0xb4d6d1f144b28e83
+
+func NewScheduler_schedule_Results(s *capnp.Segment) (Scheduler_schedule_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Scheduler_schedule_Results(st), err
+}
+
+func NewRootScheduler_schedule_Results(s *capnp.Segment) (Scheduler_schedule_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Scheduler_schedule_Results(st), err
+}
+
+func ReadRootScheduler_schedule_Results(msg *capnp.Message) (Scheduler_schedule_Results, error) {
+	root, err := msg.Root()
+	return Scheduler_schedule_Results(root.Struct()), err
+}
+
+func (s Scheduler_schedule_Results) String() string {
+	str, _ := text.Marshal(0xb4d6d1f144b28e83, capnp.Struct(s))
+	return str
+}
+
+func (s Scheduler_schedule_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Scheduler_schedule_Results) DecodeFromPtr(p capnp.Ptr) Scheduler_schedule_Results {
+	return Scheduler_schedule_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Scheduler_schedule_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Scheduler_schedule_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Scheduler_schedule_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Scheduler_schedule_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Scheduler_schedule_Results) Process() process.Process {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return process.Process(p.Interface().Client())
+}
+
+func (s Scheduler_schedule_Results) HasProcess() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Scheduler_schedule_Results) SetProcess(v process.Process) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+func (s Scheduler_schedule_Results) Peer() (string, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.Text(), err
+}
+
+func (s Scheduler_schedule_Results) HasPeer() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s Scheduler_schedule_Results) PeerBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Scheduler_schedule_Results) SetPeer(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
+func (s Scheduler_schedule_Results) Server() uint64 {
+	return capnp.Struct(s).Uint64(0)
+}
+
+func (s Scheduler_schedule_Results) SetServer(v uint64) {
+	capnp.Struct(s).SetUint64(0, v)
+}
+
+// Scheduler_schedule_Results_List is a list of Scheduler_schedule_Results.
+type Scheduler_schedule_Results_List = capnp.StructList[Scheduler_schedule_Results]
+
+// NewScheduler_schedule_Results creates a new list of Scheduler_schedule_Results.
+func NewScheduler_schedule_Results_List(s *capnp.Segment, sz int32) (Scheduler_schedule_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[Scheduler_schedule_Results](l), err
+}
+
+// Scheduler_schedule_Results_Future is a wrapper for a Scheduler_schedule_Results promised by a client call.
+type Scheduler_schedule_Results_Future struct{ *capnp.Future }
+
+func (f Scheduler_schedule_Results_Future) Struct() (Scheduler_schedule_Results, error) {
+	p, err := f.Future.Ptr()
+	return Scheduler_schedule_Results(p.Struct()), err
+}
+func (p Scheduler_schedule_Results_Future) Process() process.Process {
+	return process.Process(p.Future.Field(0, nil).Client())
+}
+
 type ProcessInit capnp.Client
 
 // ProcessInit_TypeID is the unique identifier for the type ProcessInit.
@@ -2789,109 +4004,157 @@ func (f ProcessInit_events_Results_Future) Struct() (ProcessInit_events_Results,
 	return ProcessInit_events_Results(p.Struct()), err
 }
 
-const schema_e82706a772b0927b = "x\xda\xacV}lTY\x15?\xe7\xbe\x99\xbe\xb2\xb4" +
-	"\xcc\xdcyo\x06\x17e't\x8b\xeb\xd6l]Z\xcd" +
-	"fk\xd6\x99 \x0d\xb6)\xd9y\xed\x9aM\x1b\xff\xf0" +
-	"1si'\x99\xceL\xdf{e\xdb\xa8\xack\xdc\xba" +
-	"\xa0\xd1\xe0W\x04\x82I\xd1\xf2\x11\x05\x85X\"\x84\x1a" +
-	"\x91H\"\x09\x1a4\x0a~\x8c\x80P\x84&\x8dP\x05" +
-	"\x12\x05\x9e\xb9w\xe6}\x0c-\xad&\xfbW\x9b\xb9\xe7" +
-	"\xfd\xce9\xbf\xdf\xef\x9c{_\xfcEM2\xb0\xae\xde" +
-	"\x8e\x03\xe9\xb10Xc\xff\xf3k\xfd\xa1\x0d\xd7^\xfd" +
-	"<\xd0\xe5\x92\xfd\x99\xaf\xff\xd88P\xf3\xdcM\x00T" +
-	"\xa6C\xfb\x94\xd9\x90\x0c\xd0z+\xb4\x11\x95\xa9\xb0\x0c" +
-	"`\xe7?\xb6\xff\xd0\x91M;\xdf\x02\xaa\"@\x10\xf9" +
-	"\xf1D\xb8\x0d\x01\x95\xc3\xe1\x04\xa0\xddpo\xd5\xbe\x03" +
-	"\xaf\xde\x1f\x03\x1aC\x80\x00??\x1f^\x85\x10\xb0\xbb" +
-	"6\xef\xd7\x8cm\xab\xbf\\\xfeT\x9cL\x86;\xf9\xc9" +
-	"\xe0\xc5\x1f<u\xbad~u^\x09\xe3\xe1c\xca!" +
-	"\x9eW\x99\x08oT\xce\x8b\x0az3\xd3Wn\xe6\x1f" +
-	"\xcd\x0f\x9e\x0c\x9f,\x17\xa9\x9c\x08\x7fI\xa9\xa72\xc0" +
-	"\xa3\xd7\xc7\x7f\x9b<\x18\xfd\x86\xaf\xd8\xbb\xe1n^," +
-	"R^\xec7\xf7^\xdcv\xeb\x9d\xef~\x1b4\x15y" +
-	"\x84\xc4#\xd6P\xc2#\xd6\xd2\xbf\x03\xdakF\xef~" +
-	"\xab\xef\xc8\x91=\xfe~\xeb#\x11\x1e\x10\x8dp\x88\x1f" +
-	"\xae\xdd\x96^Q\x9a\xda\xeb@\x88\x88\x8fD\x9ax\xc4" +
-	"+\x917\x00m\xf5\xbd\xdd'N\xedy\xf6\x18\xd0\x95" +
-	"n\xc0\xae\xc8z\x1e0. \x9eV.7\xe0\xe1\xa6" +
-	"\xc9\xaa*~\x19i\xe1\x11\xe7#\xbc\x8a\xd2\x87f\x9e" +
-	"\xc3K3\xc7\x81*h\xf7^9>vg\xcd'\xcf" +
-	"B\x90\xf0f\x8f*\xe7\x94)E\xb4\xad\xf0t$W" +
-	"\xfaIo\xeb\xb5S\xa0)\xe8\x0b\x8e\xd6\xc8\x08\xa0D" +
-	"\xd5s\x80\xca\xd3\xea\x8f\x00\xed\xd7\xb7\x8c~pz\xb8" +
-	"\xf1g\xfe\xde\x8e\xaa\x0d<\xed\x09\x95\x17\xf6p\xea\xe4" +
-	"\xc3;\xdf;\xfd\xf3yT\xffQ\xdd\xa7\\Uy\xce" +
-	"\x92\xbaQ\x09F\xb9.\x97\xec\x0b\x17\x82c/\x9f-" +
-	"\xb7)\xe4\x9dU[\xb8\xbcn\x0d\xdarD\x0f'X" +
-	"\xc3\x01~\xaf\xeeVJ\xeaJ\x80\xd6i5\x8e\x80v" +
-	"\xc7l\xcb\xf5\xdf\xcc\xbd}\xceO\xd7lT\xf0y7" +
-	"\xca\xab*\xfd\xe7\xaf\xbf\xfa\xecO?z\xc1W\xb6\x12" +
-	"\x8d\xfd\x9b\xb7\x15\xe3\xe7\xf2\x9e\x8e\xc2\xd0\x83\xef\xfc\xc1" +
-	"\xdfVG\xec)\x0e\xa0\x89\x803\xdf\xefQ\xff\xf2L" +
-	"\xe9F\xd9\xa2\xe5\x80\xa1\x98\xe8{T\x04|\xe1\xcf+" +
-	"\xdf\xb7=68\xe3keW\xac\x8d\xb7\xb2\xe2\xa5d" +
-	"\xff\xbf\x9e\xe9\xb9\xef\xc7~\xab\x8c\xbd]|J.\x91" +
-	"\x97z3\x97m\xcf\xe4\xca\xa1\xd8\x1c\xd8`\xc3m;" +
-	"]0XsZ/b\xbe\xd8\xd6>\xc2\xd2\xc3\xb2U" +
-	"0R\x88\x9a*\x05\x01\\7\xa2\xd3\x01\xdd\xd9\x04\x84" +
-	"\xbe-\xa3\xe7\x11t\x06\x90\x8e\xf6\x01\xa1C2\x127" +
-	"':\xccP\xb6\x0a\x08\xed\x95Qr\x87\x0e\xc1\x19\x85" +
-	"M\x06\x10\xda.c\xc0\xd5\x1f\x1d\x0f\xd3\x97;\x81\xd0" +
-	"ur\x88\x8d\xb0t\x12m\xfe\xe7\xe3zz\x00$\x96" +
-	"I\xa2T4\x93ho\x1e\xb5X\xba\x90a\x10\xe7'" +
-	",\x89v&\xab\xe7R\x8c\x19\x00\x90\xc4\x14\xa2\xdb\xa6" +
-	"\xe4\xb4i\x15\x8cf\x07\x8be\x1a\xbb\x13\xcc\x1c\xceY" +
-	"\xa6\x16\x90\x02\x00\x01\x04\xa0\xf5\xeb\x01\xb4Z\x095\x95" +
-	"\xe0\x9bE\xa3\x90f\xa6\x89\xd4n\xadY{\xf0w\xb7" +
-	"\x9f\xfd\x13\x00\"\x85jd\x9e\xb2+kZ\xcch." +
-	"2f\x98\x8d)\xdd\xd0\xa5A\xd3\x0d\x0a\xf8\xd3;e" +
-	"\x8b\x12D\xe8\xa0\x09P\xa5\x88\x00\x8c\x0bD\xaeI@" +
-	"h\xe2\xac3tLCi\x0b\x10\x1a\x94\xe3\"iu" +
-	"\xc3\x02\x85\x17\x9f0\xcd\x8e|\xd6\xe20\xb5\x02\xc6q" +
-	"\x14:\xcb\x80\xae\xe3T?\xcf\xa5u\x0c\x8f\xce\x04\xd1" +
-	"\xd5m@(\x95m\x8b\x19\x83\xd9\xbc\x9e\x13\xcc&\xd8" +
-	"V\x96\xb7\x1e\xcb\xb8H\x8f\xdd\x82d\xacb\xb9\xc5c" +
-	"9\x9e\xe6QHm\x0b\x97\xdd\x93\xaew\xddX\x88\xe3" +
-	"*\xf5\x1c\xd6\xb4\xb0\x0b\xa8s\xd9>%\xa16@\x90" +
-	"\"\x8a\xf5EY'\x80\x96\x91P+\x12D\xa2\"\x01" +
-	"\xa0\x83M\x00\xda\x80\x84\x9aE\x90JDE\x09\x80\x0e" +
-	"\xf1\x1fs\x12j\xef\x10|\xd3d\xa6\x99-\xe41\xec" +
-	"\xad\x0b@\x0c\x83\xcfq\x00X\x0f\x04\xeb\x01C\xc5b" +
-	"6\x83\xb5@\xb0\x160\xa4\x1b\xfd&\xae\x00LI\x88" +
-	"u@\xf8\xbfU]\xbcV\xa1\xb19W\xe8\xcf\xe6\x17" +
-	"$\xc6o\xbf'W\xb2 5\x8e\xfd9nH\xd8\xba" +
-	"\xd6\xc5}\x9e\xe36J\xa8\xbdH\xd0\xa1\xe7\x05\xde\xf5" +
-	"\x07$\xd4>\xbcX\xae\x90\xc9r[\x10\x81 B\xb5" +
-	"\xdc\xa9\xf2tp\x7f5;\x06\xa9\xf4d\x82\xbf'." +
-	"C\x9d\x84\xda{\x08\xfa\x9d\x84\xd4\xdb\xebKI^\x19" +
-	"\xd8T\\(\xbf\xa4\xf0\x0d\x00\xda\xa7%\xd4r\x9e\xf0" +
-	"\xd9&\xcf\x0c\xae\xf0\xae\x1b\xbe\xb8\x18\x05r:\x9b\xf9" +
-	"\xff\x05'\xf9b[O\x19\xb2\xb9}\xc42t\x80\xf2" +
-	"\x18\xba\x9a4y\x9a\xb8\xa5\xbf\xd0\xe6\x89\x12\xca\xeb\x83" +
-	"L\xe0\xd6\x01&\xd2\xb9,\xcb[\x18\x09H\x80\x18y" +
-	"B\xa2\\!\xcd\xb9\xd5\xea\xdc,\xed<KRB\xad" +
-	"\xcbS\xbe\x83'\xd9 \xa1\x96\"H\x09\x96\x09\xda\xc4" +
-	"\x03?!\xa1\xf6\x1a\xc1\x10\xdf)nf\x93\x19[\x99" +
-	"\x81\xcb\x80\xe02\xc0\xd0@\xc1\xb4\x9c\xb3%\x9c\x98\xd2" +
-	"\x0d\x99\xeb\xe53C\x9bg\xf0\x04\xcf\xd2\xe1R[\xb5" +
-	"\xbd\xc4\xa8\xc8y=\xe7m@\xe7\xcaC\xe7)\xe4n" +
-	"@1N\xf3W\xbe\xdf\xa0\xe5\x9d\xd5\xd8\xcd\xe2\xc2\x9f" +
-	"U\xb9\x04yR!\xaf\x05\xd0\xff\xc2\xc1\x96\xb8\xd0M" +
-	"Sy\xf5\xe8{\xd0\xd0\xcf\xf1\xc4X1\x11k\xf2\xdc" +
-	"F\x89\xa4b`\x9e\xdd\x02*\x06\xb9\xdd:+{f" +
-	"\x84 \x0d\x04U\xac\x01\xa0\xc3|\x17\x16%\xd4\xbeB" +
-	"0.\xf4\x0bm\xcd\xb27\x90\xda\xbb\x1b\x1f\xf4\xb5\xde" +
-	"^\xbd\xa32\x1d\xe22D\xea\xbd\x93\x9d\xa1\xd1\x8b=" +
-	"V\xc1`\xe5\x91:\xdb\xfe\x8f\x1dc\xcb'\xfeV9" +
-	"\x8d3\xde\x82\xe3\xd1\xb0\xd7\x1e\xe0\xbc\xf5\xb4\x00_)" +
-	"=d<&\xa0\x7fC\x0d\xe8\xf9L\x8e\x19H\xed-" +
-	"\x9b\xfb\xd8\xfa\x99\xc9[\x8fO2\xf1\xfb\xa2h.x" +
-	"\xe5:\x97A#\xc18\xbfrM\xaf\xdc\xf7\xefxe" +
-	"|\"\x11=\xb3P\xb9\xd5w\x82\xb3x\xe0]\xbe\xcc" +
-	"9\xae\xbc\xc8\x1b\xe1\x7f[\xd2\x0b\xee\xcaD\xf9\x1e[" +
-	"\xec\x8ap\xde\x07O\xca\xae\xa7\xd3\x85\xe1\xbc\x85\xd4>" +
-	"5w\xfa\xd7\x1b\xae\xce\xddYJ\x80\xca\x0a\xfdo\x00" +
-	"\x00\x00\xff\xffw<\xaaJ"
+const schema_e82706a772b0927b = "x\xda\x9cW}pT\xd5\x15?\xe7\xbd\xdd\xbc,!" +
+	"l.o\xe3\x07\x82\x91\x98\xcd\xc7\xae\xd9H\xd0I\x1b" +
+	"\x077\x02\xa9\x84\xe2\xb8/k\xc7\x09S\xa7}\xd9\xbd" +
+	"\x90m7\xbb\xcb{\x9bhZDi+U;\xa0\xb4" +
+	"\xd2i\xfd\x1c\xb1\xa8\xd4b\xd5\x8a-\x8e8\xcd8\xa5" +
+	"\x96:m\xa3\xa3\xa06\x02\x15Qi\xab\x04+\xa8\x05" +
+	"^\xe7\xbc\xdd\xf7\xb1!$\xda\xc9\x1f\xd9\xb9\xf7\xbcs" +
+	"\xcf\xf9\x9d\xdf\xf9\xdds/\xd6+;<\xf3*\xdb\x96" +
+	"\x80\x10_\"z\xcb\x8c\x8f\xeeX\xe9_\xfc\xf6\xd57" +
+	"\x03\xab\x10\x8d\xef\xfe\xf8\x09\xed\x91\xb2\x86\xf7\x00P\xc6" +
+	"\xba\xcd\xb2\xafN\x02\x98\xef\xad\xfb#\xca\xcdA\x09\xc0" +
+	"\xc8\\\xfe\xf0\xd6\xc7\xaf\xda\xb8\x16X\x00\x01\xbcH\xdb" +
+	"\xd5\xc1v\x04\x94\xe7\x04\xa3\x80F\xed\xb1Y\x9b\x1f\xb9" +
+	"\xfa\xf8:`g!\x80\x87\xf6\x17\x04g!x\x8ce" +
+	"\xbd\x0f+\xda\x9a9?*|j\xee\x04\x83Ki\xa7" +
+	"\x7f\xcfc\xd3\x86G\xf5\x0d\xa7\x85\xc0\x82O\xc9\xe7\xd2" +
+	"\xb9ru\xf0Jy\x81\x19AO\xf2\x9d\xfd\xefeN" +
+	"\x9dn\x1c\x0c>[\x08Rn\x0a\xfeP\xbe\x91~\x9d" +
+	"\xba\xf6\xc1\x97;\x1e\xad\xfe\x89+X\x1e\xec\xa6`W" +
+	"\x99\xc1\xdeu\xdf\x9e5\xef\xdfz\xffOA\x09 Y" +
+	"\x88d\xb11(\x90\xc5\xa6\xe0\xbb\x80\xc6\xdc\xa1\x8f7" +
+	"-\x7f\xfc\xf1{\xdc\xf9\xdeX?\x93\x0cn\xa9'\x17" +
+	"\xbf\x0a\xaeI\xcc\x18\xddy\x9f\xe5\xc2\xb4\xd8R\x1f\"" +
+	"\x8bm\xf5\xd7\x03\x1aG~\xbb\xa6v\xdf\xe5\xf7?\xe0" +
+	"v\xe1k0\x0dX\x03\xb9\x18<po\xfc\xab\xeb\xfd" +
+	"\x0f\x15]\x98\xc8\\\xda0\x8b\x0c\x16\x98\x06\x81\xf3\xba" +
+	"w<w\xcf\x85O\x01;\xdb\xf6p]\xc3B2\xe0" +
+	"\xa6\xc1\xf77<\xb5xl\xe4\xb5\xa7A\xa96\x83\x10" +
+	"\xc8\xe2\xb6\x86V\xb2\xd8\xd8\xf0k@\xe3\\y_-" +
+	"n\x0bm/\xc9\xb4\xa9\xd1\xb4\x98\xd7H\x99\x8e\xb6\x1c" +
+	"n\xc0\xbd\x87\x9f\x01&\xa3\xd1\xb3\xff\x99ucs\xbf" +
+	"\xb6\xab\xe0J\xaen\xda-\xcfm\xa2_s\x9a(\xa5" +
+	"\xe0\xdaW\xeb\x85\xad};\x80U\x9333\xe2\xa1&" +
+	"3\xa5\xb5M\xe4LH\x8f>\xdd3\xff\xed\xe7@\x91" +
+	"\xd1\xe5\xad\xbaBB\x009\x15\xda\x0d(\xf7\x87(\xb0" +
+	"kW\x0c\x85\xdf\x19\xa8{\xde\x8d\x8e7\\K\xae*" +
+	"\xc3\x94\xdb\xc9\x9d\xcf\x9e\x1c{h\xf8\xf7\xa7\xd5\xbb9" +
+	"\xbcY\xbe4LA\xcd\x0b_)\xf7\xd0/\xe3\xf8?" +
+	"\x85\xcd\xab\xd3/\x0e\x9ff|E\xf8\x97rW\xb8\x01" +
+	"@V\xc3W\xca\xebM\xe3\xbd\xc6\xc8\x88w\xdd\x97w" +
+	"\x15`5\x93\x18\x08\xb7\x12!\xed\x80\x95\x0aD\xc7\x8f" +
+	"\xb7\x82N\xbb.|\xb7\xcc\xc3g\x03\xcc\xef\x0f\xb7\x09" +
+	"\x80F\xdb\xb2=\x97o\xf3.\xdcm\xa2\xef\x84P\x80" +
+	"nk\xf3g\xf2\xf6f\xfa\xf5d3!\xd3\xf5\xef\xd6" +
+	"\x83\x7f=z\xcbnw-\xb7EL\xe8\xb6G(\xdf" +
+	";\xda\xc2\xa9A\xe3o\xbb]m\xf2j\xa4\x96\xa2\x1a" +
+	"\xfd\xef[\x7fZ\xfd\xbb\xcbF\\P\xc9;#\x9f\x01" +
+	"\xca\xc3\xe6\x97\xd2=]\xd9U'~\xf6\x9a\x1b\xca\xb1" +
+	"\xc84r\xfd\xa9ip\xe1\xb7>\xb8\xe2\xbc'N\xbd" +
+	"1.\xd0N\x94\x04\x00\xf9\xdc\x96\x97\xe5`\x0b9\x9d" +
+	"\xdbBE~s\xc9\xe1o?,\xaf\xda\xefv\xb7\xb6" +
+	"\xc5\xac\xccm-\xe4\xee\x85_\xc4\x03\x7f?\x7f\xf4P" +
+	"\xa1\xd5\x0b\x06[\x0b\x06O\x9a\x06\xdf{\xf3\xec\xd9\xb7" +
+	"\x9d\xd5\x7f\xd8\x05\xf0HK;\xa52\xa3\xadc\xe5\x7f" +
+	"\xce\x8f\x1fw\xfb\xde\xd1b\x86:l~*\xec\x15\xda" +
+	"z\x92\xfb\x0c\x07\x05\xf9@\xcbQ0J\xfe\xee2\x12" +
+	"Y\x8dG\x12j\x0e3\xb9\xf6\xce\x1bxb@\xcag" +
+	"\xb5\x18\xa22[\xf4\x02\xd8\xfd\x8d\x164l$\x04\x02" +
+	"\xfb\x83\x84NG\xa0%il\xc7r\x10\xd8\x93\x12\x0a" +
+	"\xf6\xe9hA\xce\xb6\xcc\x02\x81\xfd\\B\xd1\x961\x04" +
+	"K\\\xd6k \xb0[$\xf4\xd8dFK\x15\xd8\xd0" +
+	"R\x10\xd8*\x09\xbdv\x97\xa3\x85+\xe3\x0bA`=" +
+	"\x12\x96\xd95GK+\xd8U\xf4]\xa7\xe4\xe77\xf0" +
+	"D\x07\x1a\xf4o\x91\x9a\xe8\x03\x91';P\xcc\xe9\x1d" +
+	"h\xf4\x0e\xe5y\"\x9b\xe4PC;\xbc\x03\x8ddJ" +
+	"M\xc78\xd7\x00\xa0\x03o\xca\xf0\xeb\x17\xf5\xa9\x99\x0e" +
+	"4t\x9e\xe6\x89|\xb6\xb0\x1eC\xb4a\x13-\xd8\xf2" +
+	"Y-b\x9d\xc1\x93u\xddQ\xae\x0f\xa4\xf3\xba\xe2\x11" +
+	"=\x00\x1e\x04`\x95\x0b\x01\x94r\x11\x95\x80\x807\xe5" +
+	"\xb4l\x82\xeb:2c~Y\xf0\xd1W\x8e\\\xf8\x06" +
+	"\x00\"\x83R\xcf\x14\xca\xb2\x94\x9e\xe7Z$\xc7\xb9\xa6" +
+	"\xd7\xc5TM\x15\xfbu\xdb\xc8\xe3>\xdeJ\xc7\x0c\xc1" +
+	"4\xed\xd7\x01J*l:\xac1=R\x8d=f\x8d" +
+	"\xad\x0b\x07-:2\xd6\x0a\x02\xf3J5\xe6\xa1\xa5\x09" +
+	"\x9b^(\xf8\xa8\xaeweRyrSn\xba\xb1\xb8" +
+	"\x8a\x96\xd8\xb2yT\x82&\x09\x9d\x9eEK1\xd8\x9c" +
+	"v\x10\x18\x93\x8c<\xd7\xfaS\x195m\"\x1b\xe5\x83" +
+	"<\x93\x1fw\xe2$9v\x9b c\x09\xca\xad\x0e\xca" +
+	"5\x09\xb2Bf\xe4\xd1wL<\xb8\xec\xd0D\x18\x97" +
+	"T\xcfBM\xa9\xb2\x1d\xaaT\xb6\xaf\x8b\xa8\xf4\x09\xc8" +
+	"\x10M\xf1g|)\x80\x92\x14Q\xc9\x09\x88B\x00\x05" +
+	"\x00\xd6\x1f\x02P\xfaDT\xf2\x022Q\x08\xa0\x08\xc0" +
+	"V\xd1bZD\xe5V\x01o\xd2\xb9\xae\xa7\xb2\x19\xac" +
+	"r\xe4\x11\x10\xab\xc0\xc5D\x00\xac\x04\x01+\x01\xfd\xb9" +
+	"\\*\x89\xe5 `9\xa0_\xd5V\xea8\x030&" +
+	"\"N\x07\x01g\x8c\xcb\xe2\x9a\"\x8c\x91tve*" +
+	"3!0n\xfa\x9d9\x92\x09\xa1\xb1\xda\x82\xfc\xfaM" +
+	"Z\x97\xdb~\x9b\xc8o\x9d\x88\xca\xc5\x02Z\xf04S" +
+	"\xd6\x8d\"*\x97Lv\x96_\xe7\xe9\x15\x88  \x9e" +
+	"\xe9`\xab\xef\x9c\x83]\x09Q\x0d\xa6\x8b\xa8\x9c#\x94" +
+	"4(2\x03?\xdc\xd1\xf3\xd2\x86\xcb>\x9a\xb4\xde\xc5" +
+	"\xee\xb6z\xca\xed9\xe4@\xe5\xd7S\xdf\xe1\xe8\x01\x01" +
+	"=P\xca\xc9X\xa1\x85\xa9\x09\"\x16\x8b\x8b\xc0\xebp" +
+	"\xa68]tG\xe6\xdc\xcc\x13\xc4\x19'\x1d\x19Hs" +
+	"-\xa2\x17\x7f\xd5u\xf3\x9a\x82\xa8L\xb7\x9dw\x12\xfa" +
+	"\x1d\"*\xcb\\\xec\xec\xa2\xf8\x17\x8b\xa8\xc4\x1cv^" +
+	"\xd5\x0e\xa0,\x11Q\xb9f2\xf5\xf1S\xc7\x9b\x04\x9b" +
+	"\x0e\x18\xd5\xb96\xc85\xf4\x81\x80>\x98Z\xf3b5" +
+	"f\xf3L\xd9;\xb5\x00\xca7ET\xd2Nt\xa9\x90" +
+	"\xd3Ov\xef\xd8\x0d\xf5\x83\xc9X$%R\xc9/\xde" +
+	"3\x02!\\p\x19\xe9\xbc!\xaf\xa9\x00\x05%\xb3i" +
+	"\x1drhm\x87\xde\xdc\xee\xf0\xda\x9fQ\xfb\xb9\x0dU" +
+	"\"\x9d\xe2\x99<\xce\xf4\x88\x808s\xeaR\xc6T\xff" +
+	"\xe7\xc2*\xe4`\xc5\x84\xf1`\xddL`\x89\x05\xb0n" +
+	"\xec\x06PVO%4~\x12\x19\xacr\xe6\x98\xe2\xf2" +
+	"DP\xe5\xd2j\x82\xf7\xf3\x0c`\x1e\xab\x9c\x11m\x9c" +
+	"N\xb8\x81Lg\x13\xc4l7=C\x0e=mv\xb6" +
+	";\xecd\x02\x16\xe9\x19r\xe89\x19\x09\xfd}Y=" +
+	"o\xedM!V1U\x93\xd4\xd2\xc6nw\x1a;J" +
+	"\xa7t\xd9\xd4)\xb9\xe0L5\x952j\xda\xb9$\xad" +
+	"y\x0b\xad\xf7\x8c}I\x9a\x8a{\xfa%i\x16\xddO" +
+	"UW\xca\xd1592_\xc8\x01\x93y\xbb\xfd\x8b\xb2" +
+	"In\xc4\x1c\xb0\x8b\x07Z/\x04\xb4\x9e&\x8c\xd1u" +
+	"\xea\x93\x0c\x8bD\x13\x0e#nU*\xdc\xa6\xb6l\x94" +
+	"\x86G5\x13\xb3\x19\xc5\x83\xee\x97\x0b\xb6\xd6\x98\xed\xa0" +
+	"4\x12h\xe8z\x87\xc8>\xa4\x84\xb1\xd8\x9ccT\xb0" +
+	"\x7f\x89\xa8|BU\x14\x03\xe8\x01`\x1f\xd3\xe2\x11\x11" +
+	"\x95\x13\xc4LO\x00\xbd\x00\xecS\xd2\xbfOD\x8c{" +
+	"P@\xe6\xf1\x06\xb0\x0c@F\xa4\x8b\xfa\x84\x88\xf1s" +
+	"h\xd9[\x160\xa7\xf1jl\x07\x88W\xa1\x88\xf1\xd9" +
+	"\xb4^&\x05\xb0\x9cFks=@\xeb\x17\xd0\xbaT" +
+	"\x1e@\x1f\xbd\xa6p)@|6\xad7\xd2z\xb9/" +
+	"\x80\xd3\x00\xe4 \x86\x00\xe2\x17\xd0\xfaE\xb4\xee\x9b\x16" +
+	"\xc0\x0az\xd8b7@\xbc\x91\xd6/A\x01kL\xca" +
+	"\xfa\x07S\xfczd\xc6\xddu'\x96\xcf?2\xe7v" +
+	"K\x0cI\xdb\x909\xef{K\xa5\xd5\\<\x9f\xd5x" +
+	"A\xc3wu~x\xfb\xba\x8a-\xff(\xee\xd6p\x82" +
+	"\xcf\xea\xa5*\x07Z@Z\x8c\xe6\x06z\xe3\x03\xbd\xc8" +
+	"\x8c\xf6\xec\xe0y\x87~\x13{\xab\xf8]T\xcd$\xfa" +
+	"\xb2\x1a2\xa3\xf6\xf5\x0d\xbe\x83m3\x0fZ\xc7i|" +
+	"eJ\xcfkC\x85\xe3\xbe\xf2\xe7\xa1DV\xfa\xe0\xa4" +
+	"\x15\xa3\xd9\x0f\xcc\x90^\xf9F\xf2\xc4\xf3\xab7Y\x1f" +
+	"\xd9\x1cAri?T\xc6\xdd3B\x898\xc5\xd2j" +
+	"\xd4$\xa19\xd4\xb9\x94\xa9\xb7(B\xab]\xca4D" +
+	"\xe5\xcb\x8b\xa8\xdc\xe9\xa8\xf8zj\xaf[ET\xee\xa2" +
+	"\xf2c\x81(\x1b\xa9\xfcw\x8a\xa8\xdc+\xd0\xc1\x19=" +
+	"\xaf\xa9)\x902y\xdd\x01)\xfe\xd2\xb1\xde\x0b\x16\xc8" +
+	"\x8f\x15A\xaaQ\x07\xb3\xa9\xa4\xb5\xed+\xe8QT\xcf" +
+	"i\\M\xda\xf3\x82\x9a\xcf\xf3\xfe\\^\x07\x00K\xf1" +
+	"\xa7j\x04Kt\xcf0\x14\xf5\xa9\x99d\x9a\x13^+" +
+	"z\x97\xf3\x85\x87\xb7\xbf?\xe9\xfc`\xcf&E\x9d)" +
+	"A\xd5\xb6\xca\xe9\x13\xbe\x05\xac)\xb5N\xc0\x1a\xba\x8d" +
+	"]`\xd4\xdf\xbe\xe0\xc1-\xd1\xea\x17\x8a`L2\xac" +
+	"Z\xc3\x06\xfc\xff\xaf\x8cR\x06,\xca&\x91\x17\xef\xc1" +
+	"\xe9\x86Q\xb8\x08\x97:w^%\x9e2\x0a\xe5\x9fG" +
+	"\x97\xf8E\"*_\x12&\x9c^\xdd\xb7\xf2\xe4\xe3\x17" +
+	"%!\x8dC\xc7=\x7f%\xfa\xd4\x0c2C9\xbe\xe2" +
+	"\xf5w\x1fxq\xd3\xe7z&M\xe4\xf3\x8b\x8f\xbf\x13" +
+	"\x0ex\xd1\xc2\x0ba\xb2\xe1\xbb`q\xe6\xaa\xa8\x89D" +
+	"v C]\xfb\xdc\xd1\xe1\xbf,>ptl\xa2\xaa" +
+	"\xb8\x19T\x9c\xac\xfe7\x00\x1f\x0dqh"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -2907,17 +4170,26 @@ func RegisterSchema(reg *schemas.Registry) {
 			0x969e88e97ed79d94,
 			0x9baeae5a95f57921,
 			0x9dbddd0e637e25ac,
+			0x9f9e3edf227eb7f0,
+			0xa30f8d4b539ce176,
 			0xb2239bbcb9521b14,
+			0xb4d6d1f144b28e83,
 			0xb52aad0122df1319,
 			0xb6ead80127ea2fdd,
+			0xb968a90226d58125,
 			0xbce33359b4dd6c02,
 			0xbe2475e52b796657,
 			0xc0c1a3f1fdbabdfd,
+			0xc1c86c7ca202ebf7,
 			0xc6398605d1d1ffd8,
 			0xc65521f186b6e059,
+			0xca4205ad3ed74c37,
 			0xca85f2cfe432ed49,
+			0xcad0ff76692b378f,
 			0xd13bb87cc9defbdd,
 			0xd698fc716f499b07,
+			0xdafeb01b41ee6a23,
+			0xe07113a66bea48db,
 			0xe6dd1edc1453a4c3,
 			0xea6d16891c17db82,
 			0xf7531ef46740370e,
@@ -2921,6 +2921,26 @@ func (c Events) Resume(ctx context.Context, params func(Events_resume_Params) er
 
 }
 
+func (c Events) Terminate(ctx context.Context, params func(Events_terminate_Params) error) (Events_terminate_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe9b5ea42655a6266,
+			MethodID:      2,
+			InterfaceName: "process.capnp:Events",
+			MethodName:    "terminate",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Events_terminate_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Events_terminate_Results_Future{Future: ans.Future()}, release
+
+}
+
 func (c Events) WaitStreaming() error {
 	return capnp.Client(c).WaitStreaming()
 }
@@ -2997,6 +3017,8 @@ type Events_Server interface {
 	Pause(context.Context, Events_pause) error
 
 	Resume(context.Context, Events_resume) error
+
+	Terminate(context.Context, Events_terminate) error
 }
 
 // Events_NewServer creates a new Server from an implementation of Events_Server.
@@ -3015,7 +3037,7 @@ func Events_ServerToClient(s Events_Server) Events {
 // This can be used to create a more complicated Server.
 func Events_Methods(methods []server.Method, s Events_Server) []server.Method {
 	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 2)
+		methods = make([]server.Method, 0, 3)
 	}
 
 	methods = append(methods, server.Method{
@@ -3042,6 +3064,18 @@ func Events_Methods(methods []server.Method, s Events_Server) []server.Method {
 		},
 	})
 
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe9b5ea42655a6266,
+			MethodID:      2,
+			InterfaceName: "process.capnp:Events",
+			MethodName:    "terminate",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Terminate(ctx, Events_terminate{call})
+		},
+	})
+
 	return methods
 }
 
@@ -3079,6 +3113,23 @@ func (c Events_resume) AllocResults() (Events_resume_Results, error) {
 	return Events_resume_Results(r), err
 }
 
+// Events_terminate holds the state for a server call to Events.terminate.
+// See server.Call for documentation.
+type Events_terminate struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Events_terminate) Args() Events_terminate_Params {
+	return Events_terminate_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Events_terminate) AllocResults() (Events_terminate_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Events_terminate_Results(r), err
+}
+
 // Events_List is a list of Events.
 type Events_List = capnp.CapList[Events]
 
@@ -3348,105 +3399,239 @@ func (f Events_resume_Results_Future) Struct() (Events_resume_Results, error) {
 	return Events_resume_Results(p.Struct()), err
 }
 
-const schema_9a51e53177277763 = "x\xda\xbcV\x7fh\x14w\x16\x7fof\xb33Y\xb2" +
-	"\xd9\xfbf\x92x\x09z9s\xc9\xa99\xf3K9\xee" +
-	"N\xf4\xb2FD\x94\x1c\xec,\x84\xe3<Z2\xd9\x8c" +
-	"\xee\xe2&\xbb\xee\xce\x1a\xad\x86\xdab\xfcE\x8b\x15\xda" +
-	"j\xc5h\x02\x8d\xd5\xb6\x966\xd5Zi-\x8db5" +
-	"H\xa1j\x8b\xa6j\x8bQ\x8bQ\x14\x85F\x1bj\xbb" +
-	"\xe5;\xb3\xdf\xc9lv\xd7H)\xfdga\xf6\xfb\xde" +
-	"\xe7}\xde\xefWSks\xdbj\x9d\x93\x9c\xc0\xc9;" +
-	"\xb3\xec\xf1\xd0\x95\xcb\x97\x9e\xe9z\xf8<\x90\x02\x04\xb0" +
-	"\x09\x00\xb3W\xda\x8b\x11l\xf1\x15\xeec\xc5\xce\xf9\xd7" +
-	"6\x82\\\x88\x08\x90\x85\xf4\xed\x7f\xf6R\x04\x94\x14{" +
-	";`\xfc\x93\x7fWU\xb8\xf2>\xd8\x0ed\xb2)\xd0" +
-	"o\x9fC\x05\x06\xecu\x80\xf1\xbf5u\xef]\xa85" +
-	"u\x01)d\xe0w\x0c\xf0\x92\x1b[\x1a\x85\xbcu\xbd" +
-	"\x96\x97\xaf\xec\x15\xf4\xa5\xea\xa2\xefv\xf5P\xf5>\x0b" +
-	"\xa1c\xf6<\xfa\xf2=\xaf\xfd\xff\xe4\xfd\xcf\xfa\xac\xe6" +
-	"z\xed\xf5\xd4\xdcA\xdd\\\xd3\xd6N\xcf\xe0\xfc\xf2#" +
-	"\x09\xc6\xba\xeeY\x83\xcf\xa0.p\xd1{\xc6\x99[\xec" +
-	"\xfd\xd0\x82=j\xf0Yyf\xcf\xfc\xf0\x0f{>2" +
-	"\xf8\x18\xd8W\xed\xb3\xa8\xea\xb0\xae\xba\xe1\xe6\xe8\xc6\xe6" +
-	"I{?\xb5\x10\xce\x16J\xa9j\xe3\xcf\xbb\x17\xef\x9f" +
-	"\xb4\xf4\xb8\x95\xd6}\xc3\xea\xa8\xae\xfa\xd7\xad\xf3zz" +
-	"\xeb\x0aN\x80\x9c\x8b\\\xdc\xd7>\xad\xbd\xf6\x86\xbc\x0b" +
-	"\xb28\x01@*\x12vIS\x05\xaa3E(A\xc0" +
-	"\xf8\xdd\x1d_F\xfb\xfc\xb5'-\x86\xe6\x89:\xc7\xf6" +
-	"\xbe\x86\xb5?\xfd\xf7\xda)\xcbK\xb9\xa8\xc7\xec\xe6\xa6" +
-	"~\xfb\xb7\xb1\xeasI\xa9r\x8ay\x94C\x81HS" +
-	"\xf5\xf6\xa2\x9a\xb2\xeeC\x15\x17\xac\xa1\x89QX\x94:" +
-	"DJ\xf2^\xe5\xa1\x9e\xe3\xfb\xfa\x93\x04zD\x07\x15" +
-	"8\xa0\x0b\xcc\x1dX\xdb\xdc\xbdk\xee\xa0\xc5\xfa\x80a" +
-	"}\xb6\xbd|\xff\xf9{\x7f\xf9\x1aH.?\xe6\x1e\xa0" +
-	"\xf4\x9e\xd8'\x1d\x15\xa9\xe4aq\x13'\xfd\xc7!\x00" +
-	"\xc4\x95\xc1\x9eG_L\x7f\xf5\xba\x05\xe7\xef\x0e\xdd?" +
-	"\x0d\xb3\x1f\xf0\xd7\x1b\xbeK\xc1\x99\xe2\x18\x92fPe" +
-	"\xa9\xdcqJ\xea\xd2a\x8e|\xbc\xf6\xd4\x1f.\xbd4" +
-	"lIe\xa7C\xcf\xc7\xb2\xe6\xa5j\xfd\xad\xc3\xc3)" +
-	"0\xad\x8e7\xa5\x98\x0e\xb3\xd2\xb1I\xea\xd7a\xe4\xd3" +
-	"\x81\x7f45\xfdx\xcb\xc2\xe6\x80\xc3Ka\x16=U" +
-	"\xfdn\xd1;o\xdc\xb5\xbc\xbc\xe2\xd0\xeb\xd0\x7f\xf4\xcf" +
-	"\x8f\xcew\xfci\xc4\x9a\xf0\x0e\x87^\x87\x9d\x0e\x1a\xaa" +
-	"m\xf3n\xf9\x0b/o\x19\xb1\xa8\xf6\xd2w[<\xdc" +
-	"\xf8M\xe5\xc1\x0b\xc7G\xacQ~\xd1\xb1\x84\xaa\xbe\xa6" +
-	"\xab\xae\xdb\x7f;\xb6;\xef\xe5\x07V\xec\xa3\x0e\xbd\x98" +
-	"\xfau\x81i\xde\xfa\xb7No.|h\xc1\xbe\xea\xe0" +
-	"(\xb6\xeb\xe9\x07'\xce\xcdl\x1a\x05y\xb2\x89}\xd2" +
-	"\xa0\xf5\xb9\xaez\xed\xfdK\xe2\xd0\x92\xc0\xa8\xb5\x1b\x0d" +
-	"\x8f\xd6\xef\x18\xdav\xf3\xce`\xdc\xda\x8d4\x985\xf1" +
-	"p$\xe4S\xa3\xd1*\xde\xa7\x84\xdb\xc2s\x16\xaeR" +
-	"\xdb\xb4hUX\x89E\xd52\xaf\x1a\x8d\x05y-:" +
-	"^\xc8\x93\xf8\x8c\xb5\x05\x03m+\xca<JDPZ" +
-	"\xa3\xb2\xc8\xdb\x00l\x08@f\xcc\x02\x90\xcbx\x94k" +
-	"8D\xcc\xa7lI\xa5\x17@\x9e\xc9\xa3\xfcO\x0eK" +
-	"B\x9a_\x8d \x19\xab,@$\x80\xf1H(\xd6\xd6" +
-	"\xa2E\x02\x80aD\xe0\x90\xf6\xcb8\xe3\xf5k4\xd5" +
-	"\x17jQ\x17(>\xbfZ\x15\x8eieu\x1e%B" +
-	"\xed\xdbL\xfb\xce%\x00r\x0e\x8f\xf2\x1f9\x8c7'" +
-	"\x14\x00\x00\x9d\xc0\xa13\x15\x939\xa4\xbb3\x91\xd7\x11" +
-	"5\x1ak\xd5\x83\xe3\x8a\x05S\xc5\x92\"\xa83\xc31" +
-	"\x19[:\x1f\x96\xab\x9aaT\x8b\xc2o\xe4DC\xc8" +
-	"\xa7\x04\xd3\x05\x86&F\xe4Q\xce7\x93 \x02\x87b" +
-	"*Z\xc2\x8d\x84\xb3\x14\x88o\xcd\x18\x92\xd6P[@" +
-	"\x0bE\xca\xbcj\x89\xeeF&\x8b*\x05\xc5\x1c\xe00" +
-	"'3\xff\xb1\xda\x13\xd2\x8479t~%\x9a\xce\xcb" +
-	"\xd21\x9b\x82/\xd0\x92\x1214\xb0\x16\xb7\xf1\xcbB" +
-	"\x1eD9\xdfT\xed\xa0\xaa\xaby\x947pHX\xe9" +
-	">W\x01 \xaf\xe3Q\xde\xcc!r\xf9\xc8\x01\x90N" +
-	"*\xb8\x9eG\xf9\x05\x0e\x09\x8f\xf9\xc8\x03\x90-Tp" +
-	"\x03\x8fr7\x87\xc4\x86\xf9h\x03 ]\xf4\xcf\x9d<" +
-	"\xca\xafs(\x84\x03-,\xe2\xae\xb0\xe5\xc3J\xd3\xa5" +
-	"D\x96\xaf\xc2\\@\x0f\x8fz\xb0r\x01]Z\xa0U" +
-	"\xc5,\xe00+s\xe4V\x04\x82\xc1\x09\xcb\x97\xe5\xca" +
-	"\xa3\xb8h\xd8\x1e\xdb\x0a\x89\xf2\xfd\x9d:\x9b\x99nW" +
-	"\x02\x9a\xe9F\xa6\x86PW\x07\xb4\x05\x89\x86\xc8P\xc2" +
-	"\x0c/\xd0b\xb6WR\x7f\x15\x8fU\x09\x1fh\x99(" +
-	"\xb8\x89a\x97\xd2\xf6\xc8\xc4J\xf4oZN\xd3\xf9," +
-	"\x00s\x1a#[\xcdR-V\x00'\x95\xa3\x80hn" +
-	"\x1fd\xe7\x80T\xa4\xbf:Q@\xce\xdc\xf7\xc8\x0e," +
-	"\x09\xe9+\x19\x11\x907\xef6d\x1b\x9b\x0c\xcf\x01\x8e" +
-	"\\\x11\xd0f^H\xc8V\x149\xeb\x05\x8e\x0c\x08\x98" +
-	"e\xee&d;\x91\x1ck\x06\x8e\x1c\x16\xd0n\x9e\x1e" +
-	"\xc8\xee$r\xa0\x1e8\xd2%\xa0`.td'\x12" +
-	"\xd9>\x0b8\xd2)\xa0h.\x16d\xf7\x1eYC\xb9" +
-	"\xb4\x0a\x98mn2d\xa7\x07Q\x8a\x81#\x8d\x82\x8b" +
-	"f\xd8\x8d.Z\xafnt\xd1\xb8\xba\xb1\xce\x08\xb0\x1b" +
-	"\xe3l\x80\x01\x06\xdd\x187\xfen\x08\x81\xe0S\x82n" +
-	"|6Q\xbfn,\xd1\x07\x85\x1b\xeb\x8c\x19\xe5\xa6I" +
-	"t\xa3\x07'\x18,\xe3G\x197~\xae\x08>\xbfJ" +
-	"\xb3\x98\xa3g\x91\x9d\xc0\xc8\x8e\x02\"\x97\x02G\x16\xd2" +
-	"\x1c\xb2]\x8e\xecp%\xff\xa2o\x954\x83\xechD" +
-	"\xb6\xb5\xc9T\xfaV \x08\xe1\x98\xe6Fa\xb9J\x7f" +
-	"\xfdJ4-\xe5\xe4\xe9\x9b2\x0b\x91I\xb9\xa8\x18%" +
-	"+\xead\xd9i\x8d\xec\xe8'\xb54S\xe5\x94,;" +
-	"\x8d\x91\x1dV\xa4\x88f\xca)\xa4\xc4\xd1\xca\xc7\x96\xae" +
-	"\x09\x8c\xedb\xf4\x14F\x1f;\x8d\x9eh\x01\xd2%\x9e" +
-	"n\x01N4\xc6\xb33->\x06\xc6\x043;a\xec" +
-	"\x8e\xe4\xb9\xf0\xc4;2u\x8d\xff\x8a]$\xa6\x0c\xab" +
-	"\x04\xa7d\xf2\xa9{o\xa2\x88\xf9\x95\xe8\x13M\xda\xf1" +
-	")J\x7f\xee$\x8e\xbc_\x02\x00\x00\xff\xffQ\x9e " +
-	"~"
+type Events_terminate_Params capnp.Struct
+
+// Events_terminate_Params_TypeID is the unique identifier for the type Events_terminate_Params.
+const Events_terminate_Params_TypeID = 0xfa45ca5ec6290c9f
+
+func NewEvents_terminate_Params(s *capnp.Segment) (Events_terminate_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Events_terminate_Params(st), err
+}
+
+func NewRootEvents_terminate_Params(s *capnp.Segment) (Events_terminate_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Events_terminate_Params(st), err
+}
+
+func ReadRootEvents_terminate_Params(msg *capnp.Message) (Events_terminate_Params, error) {
+	root, err := msg.Root()
+	return Events_terminate_Params(root.Struct()), err
+}
+
+func (s Events_terminate_Params) String() string {
+	str, _ := text.Marshal(0xfa45ca5ec6290c9f, capnp.Struct(s))
+	return str
+}
+
+func (s Events_terminate_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Events_terminate_Params) DecodeFromPtr(p capnp.Ptr) Events_terminate_Params {
+	return Events_terminate_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Events_terminate_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Events_terminate_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Events_terminate_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Events_terminate_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Events_terminate_Params_List is a list of Events_terminate_Params.
+type Events_terminate_Params_List = capnp.StructList[Events_terminate_Params]
+
+// NewEvents_terminate_Params creates a new list of Events_terminate_Params.
+func NewEvents_terminate_Params_List(s *capnp.Segment, sz int32) (Events_terminate_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Events_terminate_Params](l), err
+}
+
+// Events_terminate_Params_Future is a wrapper for a Events_terminate_Params promised by a client call.
+type Events_terminate_Params_Future struct{ *capnp.Future }
+
+func (f Events_terminate_Params_Future) Struct() (Events_terminate_Params, error) {
+	p, err := f.Future.Ptr()
+	return Events_terminate_Params(p.Struct()), err
+}
+
+type Events_terminate_Results capnp.Struct
+
+// Events_terminate_Results_TypeID is the unique identifier for the type Events_terminate_Results.
+const Events_terminate_Results_TypeID = 0xa66966629ce6e8e9
+
+func NewEvents_terminate_Results(s *capnp.Segment) (Events_terminate_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Events_terminate_Results(st), err
+}
+
+func NewRootEvents_terminate_Results(s *capnp.Segment) (Events_terminate_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Events_terminate_Results(st), err
+}
+
+func ReadRootEvents_terminate_Results(msg *capnp.Message) (Events_terminate_Results, error) {
+	root, err := msg.Root()
+	return Events_terminate_Results(root.Struct()), err
+}
+
+func (s Events_terminate_Results) String() string {
+	str, _ := text.Marshal(0xa66966629ce6e8e9, capnp.Struct(s))
+	return str
+}
+
+func (s Events_terminate_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Events_terminate_Results) DecodeFromPtr(p capnp.Ptr) Events_terminate_Results {
+	return Events_terminate_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Events_terminate_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Events_terminate_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Events_terminate_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Events_terminate_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Events_terminate_Results_List is a list of Events_terminate_Results.
+type Events_terminate_Results_List = capnp.StructList[Events_terminate_Results]
+
+// NewEvents_terminate_Results creates a new list of Events_terminate_Results.
+func NewEvents_terminate_Results_List(s *capnp.Segment, sz int32) (Events_terminate_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Events_terminate_Results](l), err
+}
+
+// Events_terminate_Results_Future is a wrapper for a Events_terminate_Results promised by a client call.
+type Events_terminate_Results_Future struct{ *capnp.Future }
+
+func (f Events_terminate_Results_Future) Struct() (Events_terminate_Results, error) {
+	p, err := f.Future.Ptr()
+	return Events_terminate_Results(p.Struct()), err
+}
+
+const schema_9a51e53177277763 = "x\xda\xbcW}l\x1bg\x19\x7f\x9e;\xdb\xef\xc5\x8e" +
+	"c\xde\\\x92\x91h\"$$l\x09\xcb\xe7\xfe\x80D" +
+	"\x1bq2ES\xabL\xf2Y\x8a\xd0\x06\x9drq\xae" +
+	"\xf5\xa9\x8em|\xe7e\xa3\xad\x18\xa8\xdd\xd6\x094&" +
+	"!\x86\xaafM%6\xca\xc7\x10\x0b\x0d\xa5\x82\"\xda" +
+	"\xaaMK\xff\x81\x16\xa9\x0dmA\xa4\x1fJZ\xb5j" +
+	"%\xd2\x12h1z\xcf~\xcf\xe78N*\x84\xf8'" +
+	"\xd2\xf9\xf9\xfa=\x1f\xef\xf3\xfc\xd2\xf9\x15W\xd0\xd5\xe5" +
+	"\x9f\xf5\x83\xa0L\xb9=\x99\xc4\xe5K\x17\xbf6y\xff" +
+	"\x9b@\xab\x11\xc0E\x00\x9e>\xed\xa9Cpe\xb6\x06" +
+	"\x8f\xd4\xf9\xfb\xaf\xbc\x01J\x0d\"\x80\x1b\x99\xeccO" +
+	"#\x02\xca\x87=\x13\x80\x99\xdf~\xb1\xbd5P\xf9\xcb" +
+	"w\x81>n+\xd4\x92^\xa6\xd0@\xfa\x003\x9f\x1b" +
+	"\x99\xda7h\x8eL\x02\xad\xe1\xce\x07\x89\xe5\xbc\xfe\xda" +
+	"\xeeaR\xb9\xfd\x03\x87\xa4\x8d\xb42I\xfb\x85\xc8\xcd" +
+	"\x8e\xf9\x8e\x0f\x1d\x80\xaaI%\x93,.\\\xdf;\xba" +
+	"YwJ\x1ezz\x99\xe4\xef\xa2\xf9\xe5\x13wON" +
+	";\x81\\\xf3\x0c0 \xb7<\x0c\xc8\xc8\xdb\xbbBs" +
+	"\xfd\xcd\x87r\xb9X\xb64\x8b\xb4\xd6Bz!|\xc6" +
+	"_Q\x17\xfe\x95\xc3wO\x16\xe9W\xcf\xbc\xdf\x9f\xfc" +
+	"\xc7\xfb\xbf\xce\"\xcd\xfan \xdd\xcc\xb4\xc52\xdd\xb9" +
+	"\xb0\xfc\xc6\xe8c\xfb~\xe7He\x03id\xa6\xc3\xff" +
+	"\xde\xbb\xe1\xc0c/\x1ds\xc2\xea\xcaF\xed\xb1L?" +
+	"\xfb\xf6\xb3\xfb?\xe8\xab>\x0eJ\x05\x0a\x99\xc8\xc4\x13" +
+	"\x13]\xd7\x94=\xe0\x16\x08\x80\xfc\"\xd9#\xab\x84\xd9" +
+	"l\"\xf5\x08\x98\xb9\xfd\xde\x9f\x8c\xe9h\xd7\x09G\xa0" +
+	"\x1d\x92\x85qbzh\xdb\xc3/]\x99uH4\xc9" +
+	"\xaa\xe6\xc2\x9bG=\x7fMw\x9c-h\xe2\x0bR%" +
+	"\xc30,\xb1&\xfe\xf4\xf9\xce\xa6\xa9\x83\xad\xe7\x9d\xa5" +
+	"\x99anQ>\"1\x90w\xda\x0e\xee?\xf6\xe1\xd1" +
+	"\x02\x85\xbfI^\xa6\xb0h)<sz\xdb\xe8\xd4\x9e" +
+	"g\xe6\x1c\xd1\xcb\xca\xac\xe8O{\x9a\x0f\x9c\xbb\xf3\x99" +
+	"?\x03\xad\x10\xf3\xe9\x01\xcaw\xa5iyYb\x9aK" +
+	"\xd2\x9b\x82\xfcm/\x01\xc8\xa8s\xfb\x1f\xfc\xe1\xc9\xef" +
+	"]u\xf8I{\xad\xfcL,\xbb'^\x1d\xba^\xe4" +
+	"g\x93w^\xd6\x99\xb1\xacyg\xe5\xcb\x96\x9bC\xbf" +
+	"\xd96\xfb\x89\x8b\xdfYt\xb4\xf2\x84\xd7\xea\xc7\xe6\xd1" +
+	"\x97\xb4\x81\x1b3\x8bEn>\xf2\xfeX\x9e\xb1\xdc|" +
+	"\xec\x9d\x95\xdb|\xcc\x8drJ\xff\xfc\xc8\xc8\xbfn8" +
+	"\xd0T\xfb\xc2\xcc\xcd\xf3\x9b:~^\xfb\xb3\x1f\xdev" +
+	"H\xd0gMh\xf4\xf0\xa7\x1f\x9c\xdb\xf1\xa9%g\xc3" +
+	"\x17\xbd\xd6\x1c\xde\xf5\xb2R\xbd\xf3\xec\x8dh\xcd\xa5\xdd" +
+	"K\x0eS\xea\x1b`\xa6\xc9\xe1\xbf\xb4}t\xfe\xd8\x92" +
+	"\xb3\xca\xcb\xde\x8d\xcc\xd4\xedc\xa6\xdb\x0f\xdcL\xef\xad" +
+	"\xfc\xee=\xa7\xeff\x9f5Lm\x96\xc2\x13\xe1\x81\x9f" +
+	"\x9cz\xab\xe6\xbe\xc3\xf7\x0b>\x81\xf9\x0e\xbc|\xef\xf8" +
+	"\xd9\xa7F\x96Ay\xdc\xf6\xdd\xe5\xb3`\xf5X\xa6W" +
+	"~qQ\x9a\xdf\xa8/;L_\xccf\xb4\xaf\xbc\xe5" +
+	"\xe4\xcb\xbf\x1f\xfc\xa7\xa3\x98\xfd\xben&y\xfd\xbd\xf9" +
+	"w\x16n\xcde\x1c6-\xbeF\x84\xceL2\x95\x88" +
+	"h\x86\xd1.F\xd4d<\xd9;\xf8\x8a\x167\x8d\xf6" +
+	"\xa4\x9a6\xb4\xa6\xb0f\xa4c\xa2i\xacT\x0a\xe5>" +
+	"\xd3\xf1\x98\x1e\xdf\xda\x14RSD\x1d7\x14It\x01" +
+	"\xb8\x10\x80\xb6t\x03(M\"*\x9d\x02\"V\xb1<" +
+	"h[\x18@yJD\xe5\x0b\x02\xd6'\xcc\xa8\x96B" +
+	"\x9a\x9f9@\xa4\x80\x99T\"\x1d\x1f3S:`\x12" +
+	"\x11\x04d/iE\xf0\x81\xd7L-\x92\x18\xd3\x9eS" +
+	"#Q\xad=\x996\x9b\xfaBj\x8a\xc5w\xd9\xf1\xfd" +
+	"\x1b\x01\x94r\x11\x95O\x0a\x98\x19\xcd\x19\x00\x00\xfaA" +
+	"@?`\xa9\x84\xact\xd6\xcb:\xa5\x19\xe9q\xab8" +
+	"\x81t\xcc4\xd6\xac\xa0\x85\x0cK\xe9\x98Zj\\\x8f" +
+	"\xab\xa6\xd6\x14\xee\xd3\x8c\x02g\xae\xd5\x92\xdd\xa2\x99Y" +
+	"t\xa6\x01\xff\xa3l\x87\x12\x115\xb6Z\x05Y\x07%" +
+	"\x11\x95*\xbb[\x12\x08(\x01\x96\xc8%W\x15\xe6H" +
+	"\x1c/Y\xbb\xf1D\\7\x13\xa9\xa6\xb0Vo\xa5Q" +
+	"*\xa2\xc6\x9cb9\x08X^\x1a\x7f~H\xc9*}" +
+	"(,]T5V\xcb\xb21\x1f\x93D\xf4\xb1\xa2\x8a" +
+	"a\xd6\xd7\x86\xb8\xb89\x11BT\xaal\xd3\x1d\xcc\xf4" +
+	"U\x11\x95\x9d\x02R>\xe3\xdfh\x05P\xb6\x8b\xa8\xbc" +
+	"% \x0aU(\x00\xd0]L\xf1u\x11\x95o\x09H" +
+	"E\xacB\x11\x80\xeef\x8a;ET\xa6\x04\xa4.\xac" +
+	"B\x17\x00\x9dd?~_D\xe5\x07\x02\x92\xa4>\xc6" +
+	"+\x1eH:>\x9c0\x03jj\xcb+X\x01\x18\x12" +
+	"\xd1*V\x05`\xc0\xd4\xc75t\x83\x80\xee\xd2\x95\xdb" +
+	"\xaa\xc7b\xeb\xce9\xefUH\x0d\xb0\xb2\xad\xf9fr" +
+	"s\xfe\x7fZ\x01<\xf4\x84\xaa\x9bv\x1a\xa5\x1e\x84\xf6" +
+	"\xaan>\x97{\x10%F\x98\xfb\xd3\xc7\xec\xe7U\xf0" +
+	"\xbe\xea\xf2S\"\xeac\xeb\x157\xb7\x15\x8b\xf6\x03r" +
+	"\xb5z\xeb\x9b\x8d\xd3\x93\xa2\x1b\xc0^\xe8\xc8\xaf\xbb\xdc" +
+	"\x85\xad \xc8\xcdH\x10\xed\x03\x86\x9cQ\xc8\xb5\x96\xd4" +
+	"\x8f\x04\x05\x9b2 go22)]\"(\xda\xa4" +
+	"\x10\xf9\xd1\xa7\x8b\xbd \xd0\xcb\x04]6\xc9B~\xe5" +
+	"\xe8\x1f\xc3 \xd0\xd3\x04\xdd\xf6yC~V\xe9\x91Q" +
+	"\x10\xe8\x0cA\x8f\xcd^\x90S-\xfa\xa3\x01\x10\xe8$" +
+	"Abs\x02\xe4,\x8b\xbe\xdb\x0d\x02\xddEP\xb2/" +
+	"\x10r2I_cX\xc6\x09\x96\xd9\xc7\x109{\xa1" +
+	"j\x1d\x08t\x98\x04X\x87\x83\x18`\xf3\x1a\xc4\x00\xab" +
+	"k\x10\xfb\xb2\x05\x0eb\x86/0\xc0X\x103\xd9\x9f" +
+	"\x87\x12@\"j,\x88_\xcf\xcdo\x10\xeb\xadE\x11" +
+	"\xc4\xbe\xec\x8e\x0a\xb2&\x061\x84\xeb,\x96\x95\xabL" +
+	"X\xb9WH$\xaa\xb1.\x96[]\xe4\xfc\x1a9\xaf" +
+	"\xa0J#\x08t\x90`\x9e\x0e \xe7\xbe\xb4\x87\xc9\xda" +
+	"X\x079\xefD~\xf8i\x03\x93U\x13\x92L\x9bA" +
+	"$[4\xf67\xaa\x1a\xabB.\xdc\xbeE\xbb\x10\xb9" +
+	"V\x80\xa9\xe5\xc1r\xde\x8e\xfc?\x0a\xaats\xb0\x9c" +
+	"]#\xe7f\xb4\xa7\x97\x83\xe5\x0c\x039\xbd\xa7\x0dl" +
+	"jjIQ\x8d3\xfc\xb8\x01j\x85\xc8]\xab=\x97" +
+	"\xec\x1d\xca\xbe>4\xd6\xdc[+o\xaa\xab\x14/X" +
+	"\xedT\xae\xb7\xf0\xcbJ\x9dH\xee\x8c+\x96N\"{" +
+	"e\x0a7\xc8#_\xd3\xe2\x83\xff_\\-\xa9h\xad" +
+	"\xe50\x15\x82/\xbe\x90\xebU,\xaa\x1a\x8f\xb4\x93\x1f" +
+	"\x95\xf6\x84\xea\xd5\xb5NK\x9eS0~\xf9\x9f\x01\x00" +
+	"\xd9\xd3Xk"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -3458,6 +3643,7 @@ func RegisterSchema(reg *schemas.Registry) {
 			0x9d6074459fa0602b,
 			0xa57c12075589e51f,
 			0xa62fe22feb63d82e,
+			0xa66966629ce6e8e9,
 			0xb2c6f1c55b7403f4,
 			0xb72541d950858a60,
 			0xb8521a0e0dcb52d8,
@@ -3485,6 +3671,7 @@ func RegisterSchema(reg *schemas.Registry) {
 			0xf71688c8ab425227,
 			0xf9602cd2c3f65e0f,
 			0xf9694ae208dbb3e3,
+			0xfa45ca5ec6290c9f,
 			0xffd9ede88fe29780,
 		},
 		Compressed: true,
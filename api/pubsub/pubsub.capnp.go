@@ -10,6 +10,7 @@ import (
 	server "capnproto.org/go/capnp/v3/server"
 	stream "capnproto.org/go/capnp/v3/std/capnp/stream"
 	context "context"
+	strconv "strconv"
 )
 
 type Topic capnp.Client
@@ -46,7 +47,7 @@ func (c Topic) Subscribe(ctx context.Context, params func(Topic_subscribe_Params
 		},
 	}
 	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 1}
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 2}
 		s.PlaceArgs = func(s capnp.Struct) error { return params(Topic_subscribe_Params(s)) }
 	}
 
@@ -75,6 +76,26 @@ func (c Topic) Name(ctx context.Context, params func(Topic_name_Params) error) (
 
 }
 
+func (c Topic) SetValidator(ctx context.Context, params func(Topic_setValidator_Params) error) (Topic_setValidator_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x986ea9282f106bb0,
+			MethodID:      3,
+			InterfaceName: "pubsub.capnp:Topic",
+			MethodName:    "setValidator",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Topic_setValidator_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Topic_setValidator_Results_Future{Future: ans.Future()}, release
+
+}
+
 func (c Topic) WaitStreaming() error {
 	return capnp.Client(c).WaitStreaming()
 }
@@ -153,6 +174,8 @@ type Topic_Server interface {
 	Subscribe(context.Context, Topic_subscribe) error
 
 	Name(context.Context, Topic_name) error
+
+	SetValidator(context.Context, Topic_setValidator) error
 }
 
 // Topic_NewServer creates a new Server from an implementation of Topic_Server.
@@ -171,7 +194,7 @@ func Topic_ServerToClient(s Topic_Server) Topic {
 // This can be used to create a more complicated Server.
 func Topic_Methods(methods []server.Method, s Topic_Server) []server.Method {
 	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 3)
+		methods = make([]server.Method, 0, 4)
 	}
 
 	methods = append(methods, server.Method{
@@ -210,6 +233,18 @@ func Topic_Methods(methods []server.Method, s Topic_Server) []server.Method {
 		},
 	})
 
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x986ea9282f106bb0,
+			MethodID:      3,
+			InterfaceName: "pubsub.capnp:Topic",
+			MethodName:    "setValidator",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.SetValidator(ctx, Topic_setValidator{call})
+		},
+	})
+
 	return methods
 }
 
@@ -264,6 +299,23 @@ func (c Topic_name) AllocResults() (Topic_name_Results, error) {
 	return Topic_name_Results(r), err
 }
 
+// Topic_setValidator holds the state for a server call to Topic.setValidator.
+// See server.Call for documentation.
+type Topic_setValidator struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Topic_setValidator) Args() Topic_setValidator_Params {
+	return Topic_setValidator_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Topic_setValidator) AllocResults() (Topic_setValidator_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Topic_setValidator_Results(r), err
+}
+
 // Topic_List is a list of Topic.
 type Topic_List = capnp.CapList[Topic]
 
@@ -479,17 +531,28 @@ func (s Topic_Consumer_consume_Params) Message() *capnp.Message {
 func (s Topic_Consumer_consume_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Topic_Consumer_consume_Params) Msg() ([]byte, error) {
+func (s Topic_Consumer_consume_Params) Msg() (Message, error) {
 	p, err := capnp.Struct(s).Ptr(0)
-	return []byte(p.Data()), err
+	return Message(p.Struct()), err
 }
 
 func (s Topic_Consumer_consume_Params) HasMsg() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Topic_Consumer_consume_Params) SetMsg(v []byte) error {
-	return capnp.Struct(s).SetData(0, v)
+func (s Topic_Consumer_consume_Params) SetMsg(v Message) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewMsg sets the msg field to a newly
+// allocated Message struct, preferring placement in s's segment.
+func (s Topic_Consumer_consume_Params) NewMsg() (Message, error) {
+	ss, err := NewMessage(capnp.Struct(s).Segment())
+	if err != nil {
+		return Message{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
 }
 
 // Topic_Consumer_consume_Params_List is a list of Topic_Consumer_consume_Params.
@@ -508,6 +571,127 @@ func (f Topic_Consumer_consume_Params_Future) Struct() (Topic_Consumer_consume_P
 	p, err := f.Future.Ptr()
 	return Topic_Consumer_consume_Params(p.Struct()), err
 }
+func (p Topic_Consumer_consume_Params_Future) Msg() Message_Future {
+	return Message_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Topic_Since capnp.Struct
+type Topic_Since_Which uint16
+
+const (
+	Topic_Since_Which_live Topic_Since_Which = 0
+	Topic_Since_Which_seq  Topic_Since_Which = 1
+	Topic_Since_Which_time Topic_Since_Which = 2
+)
+
+func (w Topic_Since_Which) String() string {
+	const s = "liveseqtime"
+	switch w {
+	case Topic_Since_Which_live:
+		return s[0:4]
+	case Topic_Since_Which_seq:
+		return s[4:7]
+	case Topic_Since_Which_time:
+		return s[7:11]
+
+	}
+	return "Topic_Since_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+}
+
+// Topic_Since_TypeID is the unique identifier for the type Topic_Since.
+const Topic_Since_TypeID = 0xf0e44996e747571a
+
+func NewTopic_Since(s *capnp.Segment) (Topic_Since, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return Topic_Since(st), err
+}
+
+func NewRootTopic_Since(s *capnp.Segment) (Topic_Since, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return Topic_Since(st), err
+}
+
+func ReadRootTopic_Since(msg *capnp.Message) (Topic_Since, error) {
+	root, err := msg.Root()
+	return Topic_Since(root.Struct()), err
+}
+
+func (s Topic_Since) String() string {
+	str, _ := text.Marshal(0xf0e44996e747571a, capnp.Struct(s))
+	return str
+}
+
+func (s Topic_Since) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Topic_Since) DecodeFromPtr(p capnp.Ptr) Topic_Since {
+	return Topic_Since(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Topic_Since) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+
+func (s Topic_Since) Which() Topic_Since_Which {
+	return Topic_Since_Which(capnp.Struct(s).Uint16(0))
+}
+func (s Topic_Since) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Topic_Since) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Topic_Since) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Topic_Since) SetLive() {
+	capnp.Struct(s).SetUint16(0, 0)
+
+}
+
+func (s Topic_Since) Seq() uint64 {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		panic("Which() != seq")
+	}
+	return capnp.Struct(s).Uint64(8)
+}
+
+func (s Topic_Since) SetSeq(v uint64) {
+	capnp.Struct(s).SetUint16(0, 1)
+	capnp.Struct(s).SetUint64(8, v)
+}
+
+func (s Topic_Since) Time() int64 {
+	if capnp.Struct(s).Uint16(0) != 2 {
+		panic("Which() != time")
+	}
+	return int64(capnp.Struct(s).Uint64(8))
+}
+
+func (s Topic_Since) SetTime(v int64) {
+	capnp.Struct(s).SetUint16(0, 2)
+	capnp.Struct(s).SetUint64(8, uint64(v))
+}
+
+// Topic_Since_List is a list of Topic_Since.
+type Topic_Since_List = capnp.StructList[Topic_Since]
+
+// NewTopic_Since creates a new list of Topic_Since.
+func NewTopic_Since_List(s *capnp.Segment, sz int32) (Topic_Since_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return capnp.StructList[Topic_Since](l), err
+}
+
+// Topic_Since_Future is a wrapper for a Topic_Since promised by a client call.
+type Topic_Since_Future struct{ *capnp.Future }
+
+func (f Topic_Since_Future) Struct() (Topic_Since, error) {
+	p, err := f.Future.Ptr()
+	return Topic_Since(p.Struct()), err
+}
 
 type Topic_publish_Params capnp.Struct
 
@@ -592,12 +776,12 @@ type Topic_subscribe_Params capnp.Struct
 const Topic_subscribe_Params_TypeID = 0xc772c6756fef5ba8
 
 func NewTopic_subscribe_Params(s *capnp.Segment) (Topic_subscribe_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
 	return Topic_subscribe_Params(st), err
 }
 
 func NewRootTopic_subscribe_Params(s *capnp.Segment) (Topic_subscribe_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
 	return Topic_subscribe_Params(st), err
 }
 
@@ -659,12 +843,36 @@ func (s Topic_subscribe_Params) SetBuf(v uint16) {
 	capnp.Struct(s).SetUint16(0, v^32)
 }
 
+func (s Topic_subscribe_Params) Since() (Topic_Since, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return Topic_Since(p.Struct()), err
+}
+
+func (s Topic_subscribe_Params) HasSince() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s Topic_subscribe_Params) SetSince(v Topic_Since) error {
+	return capnp.Struct(s).SetPtr(1, capnp.Struct(v).ToPtr())
+}
+
+// NewSince sets the since field to a newly
+// allocated Topic_Since struct, preferring placement in s's segment.
+func (s Topic_subscribe_Params) NewSince() (Topic_Since, error) {
+	ss, err := NewTopic_Since(capnp.Struct(s).Segment())
+	if err != nil {
+		return Topic_Since{}, err
+	}
+	err = capnp.Struct(s).SetPtr(1, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
 // Topic_subscribe_Params_List is a list of Topic_subscribe_Params.
 type Topic_subscribe_Params_List = capnp.StructList[Topic_subscribe_Params]
 
 // NewTopic_subscribe_Params creates a new list of Topic_subscribe_Params.
 func NewTopic_subscribe_Params_List(s *capnp.Segment, sz int32) (Topic_subscribe_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
 	return capnp.StructList[Topic_subscribe_Params](l), err
 }
 
@@ -679,6 +887,10 @@ func (p Topic_subscribe_Params_Future) Consumer() Topic_Consumer {
 	return Topic_Consumer(p.Future.Field(0, nil).Client())
 }
 
+func (p Topic_subscribe_Params_Future) Since() Topic_Since_Future {
+	return Topic_Since_Future{Future: p.Future.Field(1, nil)}
+}
+
 type Topic_subscribe_Results capnp.Struct
 
 // Topic_subscribe_Results_TypeID is the unique identifier for the type Topic_subscribe_Results.
@@ -891,6 +1103,635 @@ func (f Topic_name_Results_Future) Struct() (Topic_name_Results, error) {
 	return Topic_name_Results(p.Struct()), err
 }
 
+type Topic_setValidator_Params capnp.Struct
+
+// Topic_setValidator_Params_TypeID is the unique identifier for the type Topic_setValidator_Params.
+const Topic_setValidator_Params_TypeID = 0xb7d7265fac9e3cf5
+
+func NewTopic_setValidator_Params(s *capnp.Segment) (Topic_setValidator_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Topic_setValidator_Params(st), err
+}
+
+func NewRootTopic_setValidator_Params(s *capnp.Segment) (Topic_setValidator_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Topic_setValidator_Params(st), err
+}
+
+func ReadRootTopic_setValidator_Params(msg *capnp.Message) (Topic_setValidator_Params, error) {
+	root, err := msg.Root()
+	return Topic_setValidator_Params(root.Struct()), err
+}
+
+func (s Topic_setValidator_Params) String() string {
+	str, _ := text.Marshal(0xb7d7265fac9e3cf5, capnp.Struct(s))
+	return str
+}
+
+func (s Topic_setValidator_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Topic_setValidator_Params) DecodeFromPtr(p capnp.Ptr) Topic_setValidator_Params {
+	return Topic_setValidator_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Topic_setValidator_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Topic_setValidator_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Topic_setValidator_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Topic_setValidator_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Topic_setValidator_Params) Validator() Validator {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return Validator(p.Interface().Client())
+}
+
+func (s Topic_setValidator_Params) HasValidator() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Topic_setValidator_Params) SetValidator(v Validator) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// Topic_setValidator_Params_List is a list of Topic_setValidator_Params.
+type Topic_setValidator_Params_List = capnp.StructList[Topic_setValidator_Params]
+
+// NewTopic_setValidator_Params creates a new list of Topic_setValidator_Params.
+func NewTopic_setValidator_Params_List(s *capnp.Segment, sz int32) (Topic_setValidator_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Topic_setValidator_Params](l), err
+}
+
+// Topic_setValidator_Params_Future is a wrapper for a Topic_setValidator_Params promised by a client call.
+type Topic_setValidator_Params_Future struct{ *capnp.Future }
+
+func (f Topic_setValidator_Params_Future) Struct() (Topic_setValidator_Params, error) {
+	p, err := f.Future.Ptr()
+	return Topic_setValidator_Params(p.Struct()), err
+}
+func (p Topic_setValidator_Params_Future) Validator() Validator {
+	return Validator(p.Future.Field(0, nil).Client())
+}
+
+type Topic_setValidator_Results capnp.Struct
+
+// Topic_setValidator_Results_TypeID is the unique identifier for the type Topic_setValidator_Results.
+const Topic_setValidator_Results_TypeID = 0xd2e5674e29781e04
+
+func NewTopic_setValidator_Results(s *capnp.Segment) (Topic_setValidator_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Topic_setValidator_Results(st), err
+}
+
+func NewRootTopic_setValidator_Results(s *capnp.Segment) (Topic_setValidator_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Topic_setValidator_Results(st), err
+}
+
+func ReadRootTopic_setValidator_Results(msg *capnp.Message) (Topic_setValidator_Results, error) {
+	root, err := msg.Root()
+	return Topic_setValidator_Results(root.Struct()), err
+}
+
+func (s Topic_setValidator_Results) String() string {
+	str, _ := text.Marshal(0xd2e5674e29781e04, capnp.Struct(s))
+	return str
+}
+
+func (s Topic_setValidator_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Topic_setValidator_Results) DecodeFromPtr(p capnp.Ptr) Topic_setValidator_Results {
+	return Topic_setValidator_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Topic_setValidator_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Topic_setValidator_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Topic_setValidator_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Topic_setValidator_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Topic_setValidator_Results_List is a list of Topic_setValidator_Results.
+type Topic_setValidator_Results_List = capnp.StructList[Topic_setValidator_Results]
+
+// NewTopic_setValidator_Results creates a new list of Topic_setValidator_Results.
+func NewTopic_setValidator_Results_List(s *capnp.Segment, sz int32) (Topic_setValidator_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Topic_setValidator_Results](l), err
+}
+
+// Topic_setValidator_Results_Future is a wrapper for a Topic_setValidator_Results promised by a client call.
+type Topic_setValidator_Results_Future struct{ *capnp.Future }
+
+func (f Topic_setValidator_Results_Future) Struct() (Topic_setValidator_Results, error) {
+	p, err := f.Future.Ptr()
+	return Topic_setValidator_Results(p.Struct()), err
+}
+
+type Message capnp.Struct
+
+// Message_TypeID is the unique identifier for the type Message.
+const Message_TypeID = 0xd9ebf7184b4ca23a
+
+func NewMessage(s *capnp.Segment) (Message, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
+	return Message(st), err
+}
+
+func NewRootMessage(s *capnp.Segment) (Message, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
+	return Message(st), err
+}
+
+func ReadRootMessage(msg *capnp.Message) (Message, error) {
+	root, err := msg.Root()
+	return Message(root.Struct()), err
+}
+
+func (s Message) String() string {
+	str, _ := text.Marshal(0xd9ebf7184b4ca23a, capnp.Struct(s))
+	return str
+}
+
+func (s Message) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Message) DecodeFromPtr(p capnp.Ptr) Message {
+	return Message(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Message) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Message) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Message) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Message) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Message) Data() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s Message) HasData() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Message) SetData(v []byte) error {
+	return capnp.Struct(s).SetData(0, v)
+}
+
+func (s Message) From() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return []byte(p.Data()), err
+}
+
+func (s Message) HasFrom() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s Message) SetFrom(v []byte) error {
+	return capnp.Struct(s).SetData(1, v)
+}
+
+func (s Message) Seqno() uint64 {
+	return capnp.Struct(s).Uint64(0)
+}
+
+func (s Message) SetSeqno(v uint64) {
+	capnp.Struct(s).SetUint64(0, v)
+}
+
+func (s Message) Seq() uint64 {
+	return capnp.Struct(s).Uint64(8)
+}
+
+func (s Message) SetSeq(v uint64) {
+	capnp.Struct(s).SetUint64(8, v)
+}
+
+// Message_List is a list of Message.
+type Message_List = capnp.StructList[Message]
+
+// NewMessage creates a new list of Message.
+func NewMessage_List(s *capnp.Segment, sz int32) (Message_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2}, sz)
+	return capnp.StructList[Message](l), err
+}
+
+// Message_Future is a wrapper for a Message promised by a client call.
+type Message_Future struct{ *capnp.Future }
+
+func (f Message_Future) Struct() (Message, error) {
+	p, err := f.Future.Ptr()
+	return Message(p.Struct()), err
+}
+
+type Validator capnp.Client
+
+// Validator_TypeID is the unique identifier for the type Validator.
+const Validator_TypeID = 0xc202fd016958cbe0
+
+func (c Validator) Validate(ctx context.Context, params func(Validator_validate_Params) error) (Validator_validate_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xc202fd016958cbe0,
+			MethodID:      0,
+			InterfaceName: "pubsub.capnp:Validator",
+			MethodName:    "validate",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Validator_validate_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Validator_validate_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c Validator) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c Validator) String() string {
+	return "Validator(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c Validator) AddRef() Validator {
+	return Validator(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c Validator) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c Validator) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c Validator) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (Validator) DecodeFromPtr(p capnp.Ptr) Validator {
+	return Validator(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c Validator) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c Validator) IsSame(other Validator) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c Validator) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c Validator) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A Validator_Server is a Validator with a local implementation.
+type Validator_Server interface {
+	Validate(context.Context, Validator_validate) error
+}
+
+// Validator_NewServer creates a new Server from an implementation of Validator_Server.
+func Validator_NewServer(s Validator_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Validator_Methods(nil, s), s, c)
+}
+
+// Validator_ServerToClient creates a new Client from an implementation of Validator_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Validator_ServerToClient(s Validator_Server) Validator {
+	return Validator(capnp.NewClient(Validator_NewServer(s)))
+}
+
+// Validator_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Validator_Methods(methods []server.Method, s Validator_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 1)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xc202fd016958cbe0,
+			MethodID:      0,
+			InterfaceName: "pubsub.capnp:Validator",
+			MethodName:    "validate",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Validate(ctx, Validator_validate{call})
+		},
+	})
+
+	return methods
+}
+
+// Validator_validate holds the state for a server call to Validator.validate.
+// See server.Call for documentation.
+type Validator_validate struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Validator_validate) Args() Validator_validate_Params {
+	return Validator_validate_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Validator_validate) AllocResults() (Validator_validate_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Validator_validate_Results(r), err
+}
+
+// Validator_List is a list of Validator.
+type Validator_List = capnp.CapList[Validator]
+
+// NewValidator creates a new list of Validator.
+func NewValidator_List(s *capnp.Segment, sz int32) (Validator_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[Validator](l), err
+}
+
+type Validator_Result uint16
+
+// Validator_Result_TypeID is the unique identifier for the type Validator_Result.
+const Validator_Result_TypeID = 0xbf7d4c0573b1d9e6
+
+// Values of Validator_Result.
+const (
+	Validator_Result_ignore Validator_Result = 0
+	Validator_Result_accept Validator_Result = 1
+	Validator_Result_reject Validator_Result = 2
+)
+
+// String returns the enum's constant name.
+func (c Validator_Result) String() string {
+	switch c {
+	case Validator_Result_ignore:
+		return "ignore"
+	case Validator_Result_accept:
+		return "accept"
+	case Validator_Result_reject:
+		return "reject"
+
+	default:
+		return ""
+	}
+}
+
+// Validator_ResultFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Validator_ResultFromString(c string) Validator_Result {
+	switch c {
+	case "ignore":
+		return Validator_Result_ignore
+	case "accept":
+		return Validator_Result_accept
+	case "reject":
+		return Validator_Result_reject
+
+	default:
+		return 0
+	}
+}
+
+type Validator_Result_List = capnp.EnumList[Validator_Result]
+
+func NewValidator_Result_List(s *capnp.Segment, sz int32) (Validator_Result_List, error) {
+	return capnp.NewEnumList[Validator_Result](s, sz)
+}
+
+type Validator_validate_Params capnp.Struct
+
+// Validator_validate_Params_TypeID is the unique identifier for the type Validator_validate_Params.
+const Validator_validate_Params_TypeID = 0xbbd5df42b267f060
+
+func NewValidator_validate_Params(s *capnp.Segment) (Validator_validate_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Validator_validate_Params(st), err
+}
+
+func NewRootValidator_validate_Params(s *capnp.Segment) (Validator_validate_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Validator_validate_Params(st), err
+}
+
+func ReadRootValidator_validate_Params(msg *capnp.Message) (Validator_validate_Params, error) {
+	root, err := msg.Root()
+	return Validator_validate_Params(root.Struct()), err
+}
+
+func (s Validator_validate_Params) String() string {
+	str, _ := text.Marshal(0xbbd5df42b267f060, capnp.Struct(s))
+	return str
+}
+
+func (s Validator_validate_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Validator_validate_Params) DecodeFromPtr(p capnp.Ptr) Validator_validate_Params {
+	return Validator_validate_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Validator_validate_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Validator_validate_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Validator_validate_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Validator_validate_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Validator_validate_Params) Msg() (Message, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return Message(p.Struct()), err
+}
+
+func (s Validator_validate_Params) HasMsg() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Validator_validate_Params) SetMsg(v Message) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewMsg sets the msg field to a newly
+// allocated Message struct, preferring placement in s's segment.
+func (s Validator_validate_Params) NewMsg() (Message, error) {
+	ss, err := NewMessage(capnp.Struct(s).Segment())
+	if err != nil {
+		return Message{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// Validator_validate_Params_List is a list of Validator_validate_Params.
+type Validator_validate_Params_List = capnp.StructList[Validator_validate_Params]
+
+// NewValidator_validate_Params creates a new list of Validator_validate_Params.
+func NewValidator_validate_Params_List(s *capnp.Segment, sz int32) (Validator_validate_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Validator_validate_Params](l), err
+}
+
+// Validator_validate_Params_Future is a wrapper for a Validator_validate_Params promised by a client call.
+type Validator_validate_Params_Future struct{ *capnp.Future }
+
+func (f Validator_validate_Params_Future) Struct() (Validator_validate_Params, error) {
+	p, err := f.Future.Ptr()
+	return Validator_validate_Params(p.Struct()), err
+}
+func (p Validator_validate_Params_Future) Msg() Message_Future {
+	return Message_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Validator_validate_Results capnp.Struct
+
+// Validator_validate_Results_TypeID is the unique identifier for the type Validator_validate_Results.
+const Validator_validate_Results_TypeID = 0xa0b6ee1fa2e169f5
+
+func NewValidator_validate_Results(s *capnp.Segment) (Validator_validate_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Validator_validate_Results(st), err
+}
+
+func NewRootValidator_validate_Results(s *capnp.Segment) (Validator_validate_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Validator_validate_Results(st), err
+}
+
+func ReadRootValidator_validate_Results(msg *capnp.Message) (Validator_validate_Results, error) {
+	root, err := msg.Root()
+	return Validator_validate_Results(root.Struct()), err
+}
+
+func (s Validator_validate_Results) String() string {
+	str, _ := text.Marshal(0xa0b6ee1fa2e169f5, capnp.Struct(s))
+	return str
+}
+
+func (s Validator_validate_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Validator_validate_Results) DecodeFromPtr(p capnp.Ptr) Validator_validate_Results {
+	return Validator_validate_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Validator_validate_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Validator_validate_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Validator_validate_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Validator_validate_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Validator_validate_Results) Result() Validator_Result {
+	return Validator_Result(capnp.Struct(s).Uint16(0))
+}
+
+func (s Validator_validate_Results) SetResult(v Validator_Result) {
+	capnp.Struct(s).SetUint16(0, uint16(v))
+}
+
+// Validator_validate_Results_List is a list of Validator_validate_Results.
+type Validator_validate_Results_List = capnp.StructList[Validator_validate_Results]
+
+// NewValidator_validate_Results creates a new list of Validator_validate_Results.
+func NewValidator_validate_Results_List(s *capnp.Segment, sz int32) (Validator_validate_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[Validator_validate_Results](l), err
+}
+
+// Validator_validate_Results_Future is a wrapper for a Validator_validate_Results promised by a client call.
+type Validator_validate_Results_Future struct{ *capnp.Future }
+
+func (f Validator_validate_Results_Future) Struct() (Validator_validate_Results, error) {
+	p, err := f.Future.Ptr()
+	return Validator_validate_Results(p.Struct()), err
+}
+
 type Router capnp.Client
 
 // Router_TypeID is the unique identifier for the type Router.
@@ -1219,50 +2060,85 @@ func (p Router_join_Results_Future) Topic() Topic {
 	return Topic(p.Future.Field(0, nil).Client())
 }
 
-const schema_f9d8a0180405d9ed = "x\xda\x94\x93MHTQ\x14\xc7\xcfy\xf7\x8eO\xc2" +
-	"Ao\xd7\xa8H\xb1L\xc3\x86\x1cS\xa3\x0f\xa1fJ" +
-	"L\x92\x82\xb9\"\x81F\xc4\xcc\xf0\xaa)\xdf\xcc\xf4\xde" +
-	"<EZ\xb81r\xd7\xa2M\x0aA\x05E\xd2F\x89" +
-	"j\x15\xd4&\xfb\x84\x16E\xb5i\xd1\xa2\x82\x10\x09\x84" +
-	"0\x83\x17\xf7\x8d\xef\xc3\x92>v\x03\xf7\xcc\xff\xff?" +
-	"\xbf\xf3\x7f[\xefa\x9c6\x87/\x94\x80\"\x0e\x86J" +
-	"\xec\x96\xe75O\xc6\xb7\xe7G\x80q\x04\xa0*@k" +
-	"#\x89 P\xbb}\xe4\xd9\xd0\xe8\xc5\x8a\xd1\xe2K\x08" +
-	"\xe5\xd3*\xb2\x12\x01y5\x89\x01\xda\x93\xa7+\x9a\x1a" +
-	"&\xb2\x97\x80\x85\x89=\xf3.D\xd7\\y;\x0f\x80" +
-	"|7\x19\xe7\x1dd5\x00\x17d\x9a\xbf *\x80}" +
-	"\xf3\xc8l\xcezdL\x83\xe0\xe8\xc9\xdd!\xb5R\xee" +
-	">\x19\x04\xb4c\x9b\x0eW\xdd\xea\x1bx\x1d\xf4\xab\xa6" +
-	"\x8a\x1c\xd8@\xa5\xdf\xc6\xd9\x85\xbb\x8fw4\xbe\x01\xc6" +
-	"\x89o\x0e\xc8\xf7\xd2\x0f\xfc\x90\x8c\xce\x0f\xd0N~F" +
-	"\xfe\xb2\xdbr\x03\xeb>\xdeN\xbc\xff-\\/\xbd\xc6" +
-	"\x93\xce\xf0Q\xda\xc9\xcf9\xc3\xa3_\xce\xceM\xf6\x15" +
-	">\x01\xab\xf2\xacu\xda'\xad\x87\x1c\xeb\xfa\xfd\x97_" +
-	">\x9d\xba\xf1\x19X\xa570FW\xc8\x81\xab\xce\x80" +
-	"\xba\xe7\xd5\xdc|\xee\xc7W\x1f#\x7fH\xbf\x03\xb5{" +
-	"\xce\x7f\xa3\xdaL\xd3B\xf0\x9f\xd7\x8b[M\xd0\x18\xf4" +
-	"\xday+eZ\xa9h\x9a$\xf3\xd9|[O.\x9f" +
-	"IGM+e\xa6\x8dLJ\xab\xeb\xd6\xccr\xab\xbf" +
-	"`.;\x96\xb7R\xfd\x19\xf3d]\"i$u4" +
-	"\x05%\x14\x80\"\x00\x0b\xd7\x02\x88R\x82\xa2RAU" +
-	"7O`\x18\x14\x0c\x03z2\xe8\xca\x90LZP\x0c" +
-	"\xc2\xc5.\xbb=\x975-]3\x00@\x94\x91\x10\x80" +
-	"W\x06\xccN=\x18l\x1d?6\xc6\xc4>PX\x87" +
-	"\x8a\xe8\x9d\x16\xdd.\xb1]\xdd\xa0\xb0f\x15\x15\x8f\x0b" +
-	"\xba\xd7e\xf5\x11P\xd8Zux1|\x1cmw[" +
-	"@-\x8e\xe5\xd9\xa4\xae\xc51\x81\xf8\x172\x89\xa4\xa1" +
-	"&uS\x94zKo\xee\x02\x10\x0d\x04\xc56\x05\x11" +
-	"+\x11\x11Y\xb3\x04\xb1\x85\xa0\xd8\xa9\xa0\x9d\xf6\xd7B" +
-	"\xe6o\x0c\x88\x0cPMY\xc7Q\x05%\xa4\xae\x87\xe5" +
-	"\xbde2y\x10y\x0f\x08\xc2\x8e\xf8\xb0\x9d\xf8X\x06" +
-	"\x0a\x96\x05T\x94\x80\x8a\xc3V\xd55#\x81(\xa8\x03" +
-	"\xd7\xad\x9f\x0f\x97I\xb8!ux1\xf1R\x1e\xc5\xdb" +
-	"u\xe7,\xb5\x10Tq\x9b\x86nY\x19\x8b8*\xe5" +
-	"\xa7r\x99\xecR\x09\xfak ]3\xa2\x8bfN\x9d" +
-	"\x88\xfe_u\"n\xa4\x82fD\xa5\x9d\xc7i\x09\xa8" +
-	"\x16_\xa6\xa6 \xcd\x91\x05?dy\x86e\x999\xe4" +
-	"cN\xcd\xcd?x\x16\x07\xfe\xe94?\x03\x00\x00\xff" +
-	"\xff\xcf\xfce\x94"
+const schema_f9d8a0180405d9ed = "x\xda\x9cU]l\x14U\x14>\xe7\xce\xcc\xde]m" +
+	"\xb3{\x9d\x9a\xa2RW\x11P\x9bP\xb4$\x1a7\xc6" +
+	"m\x0aX\xc1Vz+\"\xa0\x09L\x97K\x19\xdc?" +
+	"ff\xab\xc6\x10^H\xe4\xc5'\x83\x11\x12\x0c\x92h" +
+	"D%RM0j\x88?\x89?\x80\x0fbl\xb0/" +
+	"\xfeE@B\x100\x10\x82\x88c\xee\x9d\x9d\x9diY" +
+	"\x8c\xf1\x89e\xef\xd9s\xbe\xf3\xfd\x9c\xdeu\xad\xd6\xa3" +
+	"\xdf\xdd\xba$\x05\x84\x17\x8d\x84\xdf\xfdu\xf6\xc0\xf6{" +
+	"\xaa\x9b\x81\x99\x08\xa0S\x80y/\xe8\x9d\x08\xba?\x7f" +
+	"\xf3\xa1g\xb7\xbc\x98\xd9\x12\xbc\x18(\x9fj\xfau\x08" +
+	"hn\xd4\xf3\x80\xfe\xde\xa72s\xef\xd8]~\x19X" +
+	"\xab\xe6\x9f\x9a0\xf4i;\xbf\xbf\x08\x80\xe6\x0e}\xbb" +
+	"\xf9\x9a~;\xc0\xbc\xfd:ES\x18\x14\xc0?o\xff" +
+	"\xbc+\xfb\xfb\xbe\x9d\xc0\xdb1\x9c4`\xf4\xcav\x8f" +
+	"\x19\xb2\xdd\xf9\xfb_y{\xd5\xec#\xefO\x9ag\xe4" +
+	"\xd4<U\xb0\xfa\xcc\xc8\xbb\xbd?\x8e\x7f\x04\xac\xbdQ" +
+	"\xf0jP\xb0[\x15\x1c\x9b\x18s\x8d\xfe\x8d\x1f\x03k" +
+	"'\xfeO\x87\x96\xdbx\x99|&\x01\x1d0\xfe0\xc7" +
+	"%\x0a\xf3\xb0\xb1\x040z\x9b\x8a\xfc\xa8\xf1\xa1y\xca" +
+	"h\x070\xcf\x1b}\xe6\xad\x09\x09\xfc\x8d'NWj" +
+	"_8_\x027Q\xce%rn*1C\xcee\x89" +
+	"w\x00}\xfd\xe6g\xee|d\xe4\xe8\xb71\x0e\xf7$" +
+	"z%\x87\xf9\xd9\xcb\xa6\xbf\xb5rt<\xbe\xd3\xd6\x04" +
+	"\x91?\xdd\x96\x90\x90o;}i\xdfW\xf7\xce9\x02" +
+	"\xcc\xd4\"B\x01\xcd\x0f\x12\xbf\x98\x9f\xcb\xf9\xe6\xa7\x89" +
+	">\xf3\x84B\x92\xdb\xd5\xff\xf0\xb4\x0b''\x80\xb7\"" +
+	"\x89p+D\xe6\xe1\xc4\x9b\xe6\x84\xfa\xc1x\xe28\xa0" +
+	"\x9f\xab\x8c\xdet\xec\xbd\xc1\x1f\xae\xd8q7\xdde\x8e" +
+	"QY\xb8\x87\xf6\x99\xe3\xf2\x93\xbf\xe5\xe4s\xe7\xf6\xae" +
+	"\xf4\x8e\x03\x9b\xde\xc0\xb9\x9f\xae\x948\x0fP\x89s\xd6" +
+	"\x83;\xbe98\xf6\xfao\xc0\xda\x1a\x05'\xe85\xb2" +
+	"\xe0\xac*\xb8\xf1\xf1\xbe\xe3/-\xfa\xf5\x8cd\x89D" +
+	"\x9b,\xa0\x1a\x80\xc9\x92\x07\xcd\x8e\xa4\x1cyCR\x12" +
+	"F\x1f\xf8\xee\xdc\xc5\xca_g#\xc2\xcc\xb1\xe4\x9f\xa0" +
+	"\xfbK\x9f\xbf\xa0\x8bSs/\xc5\xc7lM\x06|%" +
+	"\xf3\xb0\xc2\xaf\xd6\x86\xdd\xdapWA\xb3\xaa\xe5jn" +
+	"i\xa5j\x17\xba\xdc\xda\xb0[p\xeca1sH\xb8" +
+	"\xe9Z\xd1s\x9b\x96Uk\xc3E\xdb]7s\xd0r" +
+	"\xac\x12\xba\\\xd7t\x00\x1d\x01X\xeb\x0c\x00\x9e\xd4\x90" +
+	"\xb7\x11\xa4%w\x04[\x81`+`\xa3\x0d\x86m4" +
+	"\xbb\xc0\x93\x18\x97-\xb58Z\x9d\xa5\xba\xfd\xf9\x95\xb2" +
+	"[+\x09\x07\x00\xb2\x8f\xda\xe5\x82\xe0\x19\xcd\x00h\xa4" +
+	"\x09\xcbc\x9f<=o\xfb\xaamlC/\x10&(" +
+	"b\xc3a\x18\x86\x91\xad\x18\x02\xc28E\xd2\xa0\x0aC" +
+	"+\xb1\x85\x9d@\xd8}\x14\xb5Fb04 \x9b\xb3" +
+	"\x1e\x08\x9bE7\xd5w\xedA?$\x07P\xf4`\xba" +
+	"l\x95\x84\xfcRx\xcb\xac\xa2\xbd\x06\xd2\x96Wqz" +
+	"p\x10\xa3]u\xb5\xabz\x96\x8f]\xa3\xc1'E\xae" +
+	"\xe4\x16\xe2\xc4\xe5\"\xe2\xf2\x8ez\xc7t\x14C@L" +
+	"\x036\x15#D G\xcc\xcc+I&)2\x04\xc0" +
+	"[4\xe4\xd3\x08\xfau\x04\x15@\x07Y<\xd7\xc8\xae" +
+	"\xe8\xde\x04w\x93\xeeS\xf5\xceD\xd1\x02\xc4L\xac+" +
+	"\x99\xd2u(\xab\xb6\x1cD\xe4-H\x00XGN\x01" +
+	"\xb9^\xfeC\x14!y{\xa4\\qD\xde*\x14D" +
+	"\xd5\xcb;b\xbd(xS\xac\xb4\xcc*\xe6\x83\x8e\\" +
+	"\xc7\xf8\xe1\xc2\\>\xe0\x99\xeb\xca6\xe1\xcd\xc3\xf0|" +
+	"2\xb6\x18\x08K\xd1\x90\x15\x01\x00\x93\x05l\x1e\x8dA" +
+	"\xcb\xa1\x92\x85\x96\x06\x0b\x0b\x17\x03\xf0\x05\x1a\xf2A\x82" +
+	"\x88m\x88\x88l@2\xf3\x90\x86|)AF\xb0M" +
+	"\xad\xc8\xbb\x01x\xbf\x86|9A\xbf\x10\xf9\x1bY\x94" +
+	"\x83@\x0c:\\[\x8b\x14\x88Ao\x01\xcc\xba\xd2\xfe" +
+	"\x98\x89\xf21\x85\xdb\xe4\xd5\xfc\x10:-,\x8c\xd5I" +
+	"\x037ubg$\xa9r9\xb6\x00\xc1\x96+\x94\x0c" +
+	"\xba\xa8\x90\xd2\x92p\xa4\x8e\x01\xd1\xe1\x05\x8c\xf2\xc9d" +
+	">\x0d\xba\xa9\xbe\xf1d\x92\x03\x19\x07\x84\x9bv\xad\x11" +
+	"!\xdbd\x1aX,\x89\xe5I\x0d\xf9:\x82L1\x0b" +
+	"\xc0\x84\xfcr\xb5\x86\xbcH\x10I@\xac-\x89]\xa3" +
+	"!\xaf\x12d\x1a\xb6\xa1\x06\xc0JR\x82u\x1ar\x8f" +
+	"`z\x8d\xe5Y\xe15J\xafu*\xa5\xf0?YW" +
+	"l(W0\x05\x04S\x80\xd4\x15\x1b\xc2\xcfS \x0e" +
+	"Uj\xd4\x8b/\x1a\x9eX\x0cO:c\x9dj\xd1\xf4" +
+	"\xfa\x8a]nv\x0bb\x9c\x95\x84\xd3U\xe7C\xddQ" +
+	"\xed\xff\xe6J\x0b\xc1y\xc2\xe9\x92\x83\x1b\xa2NR\xb5" +
+	";j\x98\xf5$\x0cd\xf1\xbf\x92\xc8\xae\"\xb0\xba\xbc" +
+	"\x00*\xa6\x9a\xde\xe2\xfb\x81\xe3\xa5\x06=\x1a\xf2~\x82" +
+	"\x1d\xf8\xb7_\x97f\xd1\x8c(\x08\x1d\xe4\xb2_\xb7\xfd" +
+	"@g\x94\x85t\xd1\x1e\x15\x90\x88\xf3\x9c\xf6\xec\x92@" +
+	"\x03\x08\x1aW\x01\xa1\xbcZ\xbf>\xff\xb2xP\xf0\x9f" +
+	"\xcc\xfc\xcf\x00\x89\xf1\xb6\xb2"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -1271,12 +2147,20 @@ func RegisterSchema(reg *schemas.Registry) {
 			0x8470369ac91fcc32,
 			0x8810938879cb8443,
 			0x986ea9282f106bb0,
+			0xa0b6ee1fa2e169f5,
+			0xb7d7265fac9e3cf5,
+			0xbbd5df42b267f060,
+			0xbf7d4c0573b1d9e6,
+			0xc202fd016958cbe0,
 			0xc772c6756fef5ba8,
+			0xd2e5674e29781e04,
 			0xd5765aab1c56263f,
 			0xd72d37c8b6fbef23,
+			0xd9ebf7184b4ca23a,
 			0xde50b3e61b766f3a,
 			0xe7745ab0f47beb88,
 			0xe8a6b1cad09d4625,
+			0xf0e44996e747571a,
 			0xf1fc6ff9f4d43e07,
 			0xfb2fed6504f78754,
 		},
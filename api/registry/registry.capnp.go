@@ -9,6 +9,7 @@ import (
 	schemas "capnproto.org/go/capnp/v3/schemas"
 	server "capnproto.org/go/capnp/v3/server"
 	context "context"
+	capstore "github.com/wetware/pkg/api/capstore"
 	channel "github.com/wetware/pkg/api/channel"
 	pubsub "github.com/wetware/pkg/api/pubsub"
 	strconv "strconv"
@@ -30,7 +31,7 @@ func (c Registry) Provide(ctx context.Context, params func(Registry_provide_Para
 		},
 	}
 	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 2}
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 3}
 		s.PlaceArgs = func(s capnp.Struct) error { return params(Registry_provide_Params(s)) }
 	}
 
@@ -50,7 +51,7 @@ func (c Registry) FindProviders(ctx context.Context, params func(Registry_findPr
 		},
 	}
 	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 2}
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 2}
 		s.PlaceArgs = func(s capnp.Struct) error { return params(Registry_findProviders_Params(s)) }
 	}
 
@@ -232,12 +233,12 @@ type Registry_provide_Params capnp.Struct
 const Registry_provide_Params_TypeID = 0xbf9edfd4684337f6
 
 func NewRegistry_provide_Params(s *capnp.Segment) (Registry_provide_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
 	return Registry_provide_Params(st), err
 }
 
 func NewRootRegistry_provide_Params(s *capnp.Segment) (Registry_provide_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
 	return Registry_provide_Params(st), err
 }
 
@@ -304,12 +305,25 @@ func (s Registry_provide_Params) SetEnvelope(v []byte) error {
 	return capnp.Struct(s).SetData(1, v)
 }
 
+func (s Registry_provide_Params) Withdrawal() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return []byte(p.Data()), err
+}
+
+func (s Registry_provide_Params) HasWithdrawal() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s Registry_provide_Params) SetWithdrawal(v []byte) error {
+	return capnp.Struct(s).SetData(2, v)
+}
+
 // Registry_provide_Params_List is a list of Registry_provide_Params.
 type Registry_provide_Params_List = capnp.StructList[Registry_provide_Params]
 
 // NewRegistry_provide_Params creates a new list of Registry_provide_Params.
 func NewRegistry_provide_Params_List(s *capnp.Segment, sz int32) (Registry_provide_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3}, sz)
 	return capnp.StructList[Registry_provide_Params](l), err
 }
 
@@ -395,12 +409,12 @@ type Registry_findProviders_Params capnp.Struct
 const Registry_findProviders_Params_TypeID = 0xd589c56f3d6a445e
 
 func NewRegistry_findProviders_Params(s *capnp.Segment) (Registry_findProviders_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
 	return Registry_findProviders_Params(st), err
 }
 
 func NewRootRegistry_findProviders_Params(s *capnp.Segment) (Registry_findProviders_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
 	return Registry_findProviders_Params(st), err
 }
 
@@ -472,12 +486,28 @@ func (s Registry_findProviders_Params) SetChan(v channel.Sender) error {
 	return capnp.Struct(s).SetPtr(1, in.ToPtr())
 }
 
+func (s Registry_findProviders_Params) Limit() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s Registry_findProviders_Params) SetLimit(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+func (s Registry_findProviders_Params) Timeout() uint32 {
+	return capnp.Struct(s).Uint32(4)
+}
+
+func (s Registry_findProviders_Params) SetTimeout(v uint32) {
+	capnp.Struct(s).SetUint32(4, v)
+}
+
 // Registry_findProviders_Params_List is a list of Registry_findProviders_Params.
 type Registry_findProviders_Params_List = capnp.StructList[Registry_findProviders_Params]
 
 // NewRegistry_findProviders_Params creates a new list of Registry_findProviders_Params.
 func NewRegistry_findProviders_Params_List(s *capnp.Segment, sz int32) (Registry_findProviders_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
 	return capnp.StructList[Registry_findProviders_Params](l), err
 }
 
@@ -567,15 +597,18 @@ type Message_Which uint16
 const (
 	Message_Which_request  Message_Which = 0
 	Message_Which_response Message_Which = 1
+	Message_Which_withdraw Message_Which = 2
 )
 
 func (w Message_Which) String() string {
-	const s = "requestresponse"
+	const s = "requestresponsewithdraw"
 	switch w {
 	case Message_Which_request:
 		return s[0:7]
 	case Message_Which_response:
 		return s[7:15]
+	case Message_Which_withdraw:
+		return s[15:23]
 
 	}
 	return "Message_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
@@ -655,6 +688,26 @@ func (s Message) SetResponse(v []byte) error {
 	return capnp.Struct(s).SetData(0, v)
 }
 
+func (s Message) Withdraw() ([]byte, error) {
+	if capnp.Struct(s).Uint16(0) != 2 {
+		panic("Which() != withdraw")
+	}
+	p, err := capnp.Struct(s).Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s Message) HasWithdraw() bool {
+	if capnp.Struct(s).Uint16(0) != 2 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Message) SetWithdraw(v []byte) error {
+	capnp.Struct(s).SetUint16(0, 2)
+	return capnp.Struct(s).SetData(0, v)
+}
+
 // Message_List is a list of Message.
 type Message_List = capnp.StructList[Message]
 
@@ -676,13 +729,14 @@ type Location capnp.Struct
 type Location_Which uint16
 
 const (
-	Location_Which_maddrs Location_Which = 0
-	Location_Which_anchor Location_Which = 1
-	Location_Which_custom Location_Which = 2
+	Location_Which_maddrs     Location_Which = 0
+	Location_Which_anchor     Location_Which = 1
+	Location_Which_custom     Location_Which = 2
+	Location_Which_capability Location_Which = 3
 )
 
 func (w Location_Which) String() string {
-	const s = "maddrsanchorcustom"
+	const s = "maddrsanchorcustomcapability"
 	switch w {
 	case Location_Which_maddrs:
 		return s[0:6]
@@ -690,6 +744,8 @@ func (w Location_Which) String() string {
 		return s[6:12]
 	case Location_Which_custom:
 		return s[12:18]
+	case Location_Which_capability:
+		return s[18:28]
 
 	}
 	return "Location_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
@@ -699,12 +755,12 @@ func (w Location_Which) String() string {
 const Location_TypeID = 0xe61540af32cf81b6
 
 func NewLocation(s *capnp.Segment) (Location, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3})
 	return Location(st), err
 }
 
 func NewRootLocation(s *capnp.Segment) (Location, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3})
 	return Location(st), err
 }
 
@@ -859,13 +915,60 @@ func (s Location) SetCustom(v capnp.Ptr) error {
 	capnp.Struct(s).SetUint16(0, 2)
 	return capnp.Struct(s).SetPtr(2, v)
 }
+func (s Location) Capability() (CapRef, error) {
+	if capnp.Struct(s).Uint16(0) != 3 {
+		panic("Which() != capability")
+	}
+	p, err := capnp.Struct(s).Ptr(2)
+	return CapRef(p.Struct()), err
+}
+
+func (s Location) HasCapability() bool {
+	if capnp.Struct(s).Uint16(0) != 3 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s Location) SetCapability(v CapRef) error {
+	capnp.Struct(s).SetUint16(0, 3)
+	return capnp.Struct(s).SetPtr(2, capnp.Struct(v).ToPtr())
+}
+
+// NewCapability sets the capability field to a newly
+// allocated CapRef struct, preferring placement in s's segment.
+func (s Location) NewCapability() (CapRef, error) {
+	capnp.Struct(s).SetUint16(0, 3)
+	ss, err := NewCapRef(capnp.Struct(s).Segment())
+	if err != nil {
+		return CapRef{}, err
+	}
+	err = capnp.Struct(s).SetPtr(2, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s Location) Ttl() uint32 {
+	return capnp.Struct(s).Uint32(4)
+}
+
+func (s Location) SetTtl(v uint32) {
+	capnp.Struct(s).SetUint32(4, v)
+}
+
+func (s Location) Seq() uint64 {
+	return capnp.Struct(s).Uint64(8)
+}
+
+func (s Location) SetSeq(v uint64) {
+	capnp.Struct(s).SetUint64(8, v)
+}
 
 // Location_List is a list of Location.
 type Location_List = capnp.StructList[Location]
 
 // NewLocation creates a new list of Location.
 func NewLocation_List(s *capnp.Segment, sz int32) (Location_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3}, sz)
 	return capnp.StructList[Location](l), err
 }
 
@@ -879,55 +982,359 @@ func (f Location_Future) Struct() (Location, error) {
 func (p Location_Future) Custom() *capnp.Future {
 	return p.Future.Field(2, nil)
 }
+func (p Location_Future) Capability() CapRef_Future {
+	return CapRef_Future{Future: p.Future.Field(2, nil)}
+}
+
+type CapRef capnp.Struct
+type CapRef_Which uint16
+
+const (
+	CapRef_Which_sturdyRef CapRef_Which = 0
+	CapRef_Which_cell      CapRef_Which = 1
+)
+
+func (w CapRef_Which) String() string {
+	const s = "sturdyRefcell"
+	switch w {
+	case CapRef_Which_sturdyRef:
+		return s[0:9]
+	case CapRef_Which_cell:
+		return s[9:13]
+
+	}
+	return "CapRef_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+}
+
+// CapRef_TypeID is the unique identifier for the type CapRef.
+const CapRef_TypeID = 0x8eaaa375591f38ad
+
+func NewCapRef(s *capnp.Segment) (CapRef, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	return CapRef(st), err
+}
+
+func NewRootCapRef(s *capnp.Segment) (CapRef, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	return CapRef(st), err
+}
+
+func ReadRootCapRef(msg *capnp.Message) (CapRef, error) {
+	root, err := msg.Root()
+	return CapRef(root.Struct()), err
+}
+
+func (s CapRef) String() string {
+	str, _ := text.Marshal(0x8eaaa375591f38ad, capnp.Struct(s))
+	return str
+}
+
+func (s CapRef) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CapRef) DecodeFromPtr(p capnp.Ptr) CapRef {
+	return CapRef(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CapRef) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+
+func (s CapRef) Which() CapRef_Which {
+	return CapRef_Which(capnp.Struct(s).Uint16(0))
+}
+func (s CapRef) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CapRef) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CapRef) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s CapRef) Host() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s CapRef) HasHost() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s CapRef) HostBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s CapRef) SetHost(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s CapRef) Maddrs() (capnp.DataList, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return capnp.DataList(p.List()), err
+}
 
-const schema_fcba4f486a351ac3 = "x\xda\x8cSKh$U\x14=\xe7\xbd\xaa.\x07\xd2" +
-	"i\xdfT\xfb\x85\xa6\x11\x12\x98\x88\x19g2\x88C@" +
-	"\xba\x89\x1fD\x14\xfbe\xe3N(\xaak&\x9dIW" +
-	"\x95U\x9d\xe8\x80C\x18P\xc4]\\\x08Y\xb9\x124" +
-	"\xa2&\x0b\x11\x8c\x0b1\xe2B\\\x08~@W\xeeT" +
-	"\x02FD\x0c\x08&O^\xff\xd2\xc4\x09d\xd9\xe7\x9e" +
-	"~\xe7s\xeb^\x98\x11u\xe7b\xf1\x9d3\x10:u" +
-	"\x0bf\xff\xe1G\x17\xbe\xff\xf9\xad\xcf\xa0\xee&\xe0\x0a" +
-	"\x0f\xb8\xf4\x893K\xd0\xff\xdcy\x114\xd5\xaf\xd2\xa5" +
-	"\xbfv7\xbf\x85.\x91\xe6\x8b{\x1fZ|\xf2\xd9\xed" +
-	"\x7f\xf18=\x01\xf8\xf7\xb9\xdb\xfe\x94\xeb\x01\xfe\xa4k" +
-	"\xd9\xcf?\xb6\xf8H\xf2\xe5\xeb?\x8c>\xf7\xaa\xbbh" +
-	"\x9f{\xa3KX\xdb\xffp:|\xef\xda\x8f=\x82c" +
-	"\xe7\x95BF8\xe6\xbb\xe7\xd4x\xe1\xca\x1f?\x8dL" +
-	"\xce\x14\xe6\xec\xe4\xe3\x9b\xdf\xccl\xd6\xef\xf8\xe5\xb8\x05" +
-	"\xe9I\xc0\xff\xd3\xdd\xf1\xff\xb1\x16.\xfd\xedV\x09\x9a" +
-	"'\xbe\xbe\x1e&\xde\xde\x01TI\x1e\xb1A\x7f\xd2\xdb" +
-	"\xf1\xa7=kv\xca{\xcd\xbf\xe1y\xf8\xd5d\xd1\xd5" +
-	"V\xde\xc9\xae\xcb\xf3a\x90\xc6\xe9\xec|\xff\xf7\xf94" +
-	"KVZ\xcdh\xa2\xd6\x08\xb2\xa0\x9d\xeb\xdb\xa4\x038" +
-	"\x04\xd4\xd4\x0c\xa0'$\xf5\x05AE\x96i\xc1\xe9\xa7" +
-	"\x00\xfd\x80\xa4\xbe,X\xed$i+\xa42[\xd7n" +
-	"\x7f\xf0\xdcF\xbc\x0e\x90\x0a4Q\xbc\x12-%i\x04" +
-	"\x80E\x08\x16\xc1\xa1\x01\xf6\x0d<S\x8b\xf2<\xb8\x1a" +
-	"5H\xab9fLOt\xeeH\xb4\xc8C\xf3\x7f\xd5" +
-	"\xd5,za9\xca;(\x98,\xca\xd3$\xceo\xa9" +
-	"\xe3\x1c\x0fz\xa5\x157\x1b\xbd\xb0Y>\xd1\x08J\xa7" +
-	"\x8a{?\xa0\xcfI\xea\xe6\xc9qK\xe1B\x10S\x99" +
-	"\xbd;w/\x97\x7f\xff\xf47\x00u*V\xb5#8" +
-	"\x0a*\xde\xa5\x1d\x92lHv\xed\xaa\xd3\xdb\x9d\x8f\xaa" +
-	"\xf9\xf2R'\x1f\xf2\xbd\x93\xf68\x1fu\x89\x18\x10\xfb" +
-	"\xbc\xa7\x930\xe8\xb4\x92\x18\xb6\xef\xf20\xf4\x0d[\xf7" +
-	"K\x92\xfa\x95\x91\xd07m\xe8\x97%\xf5\xba`E\x18" +
-	"#\xca\x14\x80zs\x16\xd0k\x92\xfa}\xc1\x8a<\xb4" +
-	"\xb0\x04\xd4\x86\x85\xdf\x96\xd4[\x82\x15\xe7\xc0\xc2\x0e\xa0" +
-	">\xb0\xf0\xbb\x92\xfa#\xc1\xd5<\xcaVZa\xc41" +
-	"\x08\x8e\x81\xa5v\xd4\x098\x8en\x13\x16\x1b\x07k\xed" +
-	"\xa0\xd9\xcc\xf2\x01Z\xec\xa3A\x1c.$\xd9\xe0\x8f\xb5" +
-	"p9\xef$m\x9e\x85\xe0\xd9\x91\xf6\xc4\xb16\xd0\xfb" +
-	"\xaa\\`x\xf8\x1c\xdc\x9d\xba8\x07\xa1&=\x1e]" +
-	"1\x07\xd7\xaa\xee\xc9 \x94\xf2V\xfbm\xd6i\x06{" +
-	"@\xb5\xbb\x89:\x1b\xe4\x7f\x01\x00\x00\xff\xff\x7f\xc2(" +
-	"-"
+func (s CapRef) HasMaddrs() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s CapRef) SetMaddrs(v capnp.DataList) error {
+	return capnp.Struct(s).SetPtr(1, v.ToPtr())
+}
+
+// NewMaddrs sets the maddrs field to a newly
+// allocated capnp.DataList, preferring placement in s's segment.
+func (s CapRef) NewMaddrs(n int32) (capnp.DataList, error) {
+	l, err := capnp.NewDataList(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.DataList{}, err
+	}
+	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
+	return l, err
+}
+func (s CapRef) SturdyRef() (capstore.SturdyRef, error) {
+	if capnp.Struct(s).Uint16(0) != 0 {
+		panic("Which() != sturdyRef")
+	}
+	p, err := capnp.Struct(s).Ptr(2)
+	return capstore.SturdyRef(p.Struct()), err
+}
+
+func (s CapRef) HasSturdyRef() bool {
+	if capnp.Struct(s).Uint16(0) != 0 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s CapRef) SetSturdyRef(v capstore.SturdyRef) error {
+	capnp.Struct(s).SetUint16(0, 0)
+	return capnp.Struct(s).SetPtr(2, capnp.Struct(v).ToPtr())
+}
+
+// NewSturdyRef sets the sturdyRef field to a newly
+// allocated capstore.SturdyRef struct, preferring placement in s's segment.
+func (s CapRef) NewSturdyRef() (capstore.SturdyRef, error) {
+	capnp.Struct(s).SetUint16(0, 0)
+	ss, err := capstore.NewSturdyRef(capnp.Struct(s).Segment())
+	if err != nil {
+		return capstore.SturdyRef{}, err
+	}
+	err = capnp.Struct(s).SetPtr(2, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s CapRef) Cell() (string, error) {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		panic("Which() != cell")
+	}
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.Text(), err
+}
+
+func (s CapRef) HasCell() bool {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s CapRef) CellBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s CapRef) SetCell(v string) error {
+	capnp.Struct(s).SetUint16(0, 1)
+	return capnp.Struct(s).SetText(2, v)
+}
+
+// CapRef_List is a list of CapRef.
+type CapRef_List = capnp.StructList[CapRef]
+
+// NewCapRef creates a new list of CapRef.
+func NewCapRef_List(s *capnp.Segment, sz int32) (CapRef_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3}, sz)
+	return capnp.StructList[CapRef](l), err
+}
+
+// CapRef_Future is a wrapper for a CapRef promised by a client call.
+type CapRef_Future struct{ *capnp.Future }
+
+func (f CapRef_Future) Struct() (CapRef, error) {
+	p, err := f.Future.Ptr()
+	return CapRef(p.Struct()), err
+}
+func (p CapRef_Future) SturdyRef() capstore.SturdyRef_Future {
+	return capstore.SturdyRef_Future{Future: p.Future.Field(2, nil)}
+}
+
+type Withdrawal capnp.Struct
+
+// Withdrawal_TypeID is the unique identifier for the type Withdrawal.
+const Withdrawal_TypeID = 0x8d9eb48bc8398d04
+
+func NewWithdrawal(s *capnp.Segment) (Withdrawal, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Withdrawal(st), err
+}
+
+func NewRootWithdrawal(s *capnp.Segment) (Withdrawal, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Withdrawal(st), err
+}
+
+func ReadRootWithdrawal(msg *capnp.Message) (Withdrawal, error) {
+	root, err := msg.Root()
+	return Withdrawal(root.Struct()), err
+}
+
+func (s Withdrawal) String() string {
+	str, _ := text.Marshal(0x8d9eb48bc8398d04, capnp.Struct(s))
+	return str
+}
+
+func (s Withdrawal) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Withdrawal) DecodeFromPtr(p capnp.Ptr) Withdrawal {
+	return Withdrawal(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Withdrawal) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Withdrawal) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Withdrawal) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Withdrawal) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Withdrawal) Service() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s Withdrawal) HasService() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Withdrawal) ServiceBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Withdrawal) SetService(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s Withdrawal) Seq() uint64 {
+	return capnp.Struct(s).Uint64(0)
+}
+
+func (s Withdrawal) SetSeq(v uint64) {
+	capnp.Struct(s).SetUint64(0, v)
+}
+
+// Withdrawal_List is a list of Withdrawal.
+type Withdrawal_List = capnp.StructList[Withdrawal]
+
+// NewWithdrawal creates a new list of Withdrawal.
+func NewWithdrawal_List(s *capnp.Segment, sz int32) (Withdrawal_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Withdrawal](l), err
+}
+
+// Withdrawal_Future is a wrapper for a Withdrawal promised by a client call.
+type Withdrawal_Future struct{ *capnp.Future }
+
+func (f Withdrawal_Future) Struct() (Withdrawal, error) {
+	p, err := f.Future.Ptr()
+	return Withdrawal(p.Struct()), err
+}
+
+const schema_fcba4f486a351ac3 = "x\xda\x8cU_\x88T\xe5\x1b~\x9e\xef;3gv" +
+	"9\xe3\xec\xf1\xec\xef\x97\x062%\x06&hjI*" +
+	"\xc8.k\x86\x8a\xd2|\xb3\x85$\x12\x9df\xcf\xbag" +
+	"\x9d\x993\x9esF[\x106!\xa8(\xa3\xa0\x0b\x83" +
+	"\x12\xa1n\x92\xca\x88\xe8\xc2.\"CL\x0aJK\xb0" +
+	"\x8b\xe8&\xfa#e\x17\x81u\xe1\xfa\xc5\xb7\xf3g\xff" +
+	")tw\xce\xfb=\xbc\xef\xf3<\xdf\xfb\xbe\xdf\xda\x97" +
+	"\xe4\xa0\xb5.\x7f>\x0f\xa1Nf\xb2\xda:\xb6\xe9\x8b" +
+	"\x17?:q\x0c\xaa@\xea\xcf\xef\xdc0\xbe\xfd\x913" +
+	"7\x90\xa1\x0dx\x17\xb2\x97\xbc\xcbY\xf3u1{\x18" +
+	"\xd4\xefm,>\xde|\xeb\xd4\xcb\xf3\xc0\xdb\xa4-\x00" +
+	"o\xb5\xfd\xa1\xb7\xc16\xe8u\xf6/\xa0\xbe\xfe\xe0\xd6" +
+	"\xb1\xef~<\xf1)\xdc%\x042\xd2\x06\xee_\x9a\xdb" +
+	"L\xd0\xbb;w\x1a\xd4\xc5\x0b\x8d\xea_WO_\x9a" +
+	"\x9f\x8e\xb6\x04\xbc\xcfrg\xbc\x0b9\x93\xee\xdc4\xfa" +
+	"\x89\x87\xc6\xb7D\xe7^\xb8\x0c\xb5\x84&\x9f0\xf9\x82" +
+	"\x9eq\x93\xef`\x8f)\xf8\xca\xf5\xf7WWN\x1d\xb8" +
+	"\xd2*h\x99\xf3foLX\xfa\xdb=\xee\xa2\xec\xe8" +
+	"\x9f\xdf\xcf:\xf1{\x87\xcc\xc9\xc7G\xbf^\x7fz\xf0" +
+	"\x7f?\x1b\x0eb\x8e$\x0b\xf0v\xf4\x9e\xf5T\xaf\x81" +
+	"\xef\xee=OP?\xfc\xe5D%\xb2\xafM\xc1-\xc8" +
+	"\x194\xe8mp\xcez[\x1c\xc3v\x93\xf3\x9cw\xcc" +
+	"\xb1\xa1\xf1\x98\x8e\x83\xfda\x92\xc6\x13bM\xc5o\xd4" +
+	"\x1b\x9b\xf7\x84\xe9\xd8H\xec\x1f\xf6Y-\x91*'-" +
+	"\xc0\"\xe0\xde;\x04\xa8\x15\x92j\xad \xd9O\x13[" +
+	"\xbd\x1cP+%\xd5\x03\x82\x93I\x10\x1f\x0a+\x01\x1d" +
+	"\x08:\xa0\x9d\x04\x07\xd9\x03\xc1\x1e\xb0[\x85\xed*[" +
+	"\x8b~\xa3\x1c\x8c\x9a\x0a}\xdd\x0a\xfe*@\xed\x93T" +
+	"c\x82n\xa7D\xb0\x19POJ\xaa#\x82\xcb\x84\xd6" +
+	"\xa2\x9f\x02p'\xca\x80zZR=+\xb8L\xde4" +
+	"a\x09\xb8GM\x8a#\x92\xeay\xc1\xc2X\x94\xa4\x1d" +
+	"6\x035\x7fd$N\xb8\x08,I2\x0fa>u" +
+	"\x926\xe3\x91\x89r\x00\x8e\xb2O\xbf\xfb\xea3\xe7\xc7" +
+	"\x7f\xf8[\x03d\x1fX\xa8\x04\xd5j'AW\x82l" +
+	"K(\xb7\xff\xd74\xe2\xe8P8\x12\xac\x18(\xf9\xb1" +
+	"_K\x94\xd3\x15\xb4m=\xa0\x06%\xd5\xaeY\x82v" +
+	"\xec\x04\xd4vI\xf5\xa8\xa0+\xdar\xd4^@\x95$" +
+	"\xd5>\xc1b\x1a5\xc2\x0a]\xfd\xc1\x81\xbe\xfbV\xbe" +
+	"S?\x0e\x90.\xa8\x83\xfa\xa1\xa0\x1a5\x02\x00\xd3\x02" +
+	"\xf2\xa0>\xdc\xbe.H\xbf\xda\x0d\xcew{\xf7@\x90" +
+	"$\xfe\xfe\xc0\xd8\xedH\xcb\xd1\xbaEoh\x86^\x9e" +
+	"7\xf5B~y1\xa5\xdb\x04w\xce\x10\x9c\x8c\x83\x83" +
+	"\xcd I\x91\xd5q\x904\xa2zrkJ\xc0\x02F" +
+	"\xd6|\xf3F\xc3\xfaH\xa9e`\x9c\xac(\xf9\x85i" +
+	"\x0bg\xf5\xc4\xfa[\xf5\xc4\xaavO\xbc!\xc8\xb6\x83" +
+	"\xaf\x1b\xe0k\x92\xea\xa4\xa0+\xd9j\x877\x8d\xc2\xe3" +
+	"\x92\xea\xed\xdb\xdbZ\xa8\x8c\xf9u\xba\xfa\xda\xff\xafn" +
+	"\xec\xff\xe3\x93_\x01\x0c\xd2eQY\x82\xb3\x83.\xef" +
+	"P\x16\xc9n\xff\xb8`\xb1\x1a\xd6\xc2\x949\x08\xe6\xc0" +
+	"\xc94\xac\x05Q\xb3\xfb\xff_U\x97\x83b\xd2\xac\xa6" +
+	"I\x17o\xdf\xae\xc5\xca\xc14\x10\x1d`\x1b\xb7+\xaa" +
+	"\xf8i\x18\xd5a.\xf8\xae\xaew\x17\x8d\xfa\xaf$\xd5" +
+	"\x95Y\xde]6\xde}#\xa9~\x9f3O\xbf\x991" +
+	"\xfbI\xb2\xcc9\xf34e\xc2\xffH\x0e[&nM" +
+	"\x99\xb8\xd9:\xa49\xb8!9\x9c\xa3\xa0\x9be?3" +
+	"\x80\x97\xe1r\xa0L\xc9a\xc7\x84m\xf63\x0bx=" +
+	"&<l\x99x\x9f\xc9\x93\xb9a\xf2\x98M\x94\xe7^" +
+	"`\xd81'K\xb8p\x87\x14jA\xeawf\xd6i" +
+	"\xcd\xec\xad'y\xc0\xafW\xc6\xa2\xb8;\xee\x95f\x92" +
+	"F5.\x86\xe0b\xd0N\xd3j\xe7V\xe6\xec\xa5\x8a" +
+	"\xdf\xf0\x9f\x0a\xab!d:\xc1\xbe\x99\xd7\xa3\xb5\x00\x16" +
+	",\xc7\xce\x85\xa0\xb5\x1a3@\xf7\x09ag\x81\xbb\xeb" +
+	"\x86 \xdc{l\xce\xbc\x07\xec\xac}wi\x0c\xe1\xba" +
+	"\xf6d\xfbB\x07\xa9;\xad\x80\xe2t3\x0c\xb2D\xfe" +
+	";\x00N\xb3\xb6\x0c"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
 		String: schema_fcba4f486a351ac3,
 		Nodes: []uint64{
+			0x8d9eb48bc8398d04,
+			0x8eaaa375591f38ad,
 			0xbf9edfd4684337f6,
 			0xd2afeaf36c70c91f,
 			0xd589c56f3d6a445e,
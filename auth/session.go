@@ -5,8 +5,12 @@ import (
 
 	"capnproto.org/go/capnp/v3"
 	api "github.com/wetware/pkg/api/core"
+	"github.com/wetware/pkg/cap/anchor"
 	"github.com/wetware/pkg/cap/capstore"
 	"github.com/wetware/pkg/cap/csp"
+	"github.com/wetware/pkg/cap/host"
+	"github.com/wetware/pkg/cap/pubsub"
+	service "github.com/wetware/pkg/cap/registry"
 	"github.com/wetware/pkg/cap/view"
 )
 
@@ -30,6 +34,11 @@ func (sess Session) AddRef() Session {
 	raw.SetView(api.Session(sess).View().AddRef())
 	raw.SetExec(api.Session(sess).Exec().AddRef())
 	raw.SetCapStore(api.Session(sess).CapStore().AddRef())
+	raw.SetPubSub(api.Session(sess).PubSub().AddRef())
+	raw.SetAnchor(api.Session(sess).Anchor().AddRef())
+	raw.SetRegistry(api.Session(sess).Registry().AddRef())
+	raw.SetHost(api.Session(sess).Host().AddRef())
+	raw.SetScheduler(api.Session(sess).Scheduler().AddRef())
 	extra, err := api.Session(sess).Extra()
 	if err == nil && extra.Len() > 0 {
 		err := api.Session(sess).SetExtra(extra)
@@ -73,6 +82,33 @@ func (sess Session) CapStore() capstore.CapStore {
 	return capstore.CapStore(client)
 }
 
+func (sess Session) PubSub() pubsub.Router {
+	client := api.Session(sess).PubSub()
+	return pubsub.Router(client)
+}
+
+func (sess Session) Anchor() anchor.Anchor {
+	client := api.Session(sess).Anchor()
+	return anchor.Anchor(client)
+}
+
+func (sess Session) Registry() service.Registry {
+	client := api.Session(sess).Registry()
+	return service.Registry(client)
+}
+
+// Host returns the host capability.  It is null unless the session
+// belongs to the host's own account.
+func (sess Session) Host() host.Host {
+	client := api.Session(sess).Host()
+	return host.Host(client)
+}
+
+func (sess Session) Scheduler() csp.Scheduler {
+	client := api.Session(sess).Scheduler()
+	return csp.Scheduler(client)
+}
+
 // func (sess Session) Imports() (map[string]capnp.Client, capnp.ReleaseFunc) {
 // 	extra, err := api.Session(sess).Extra()
 // 	if err != nil || extra.Len() == 0 {
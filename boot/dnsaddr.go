@@ -0,0 +1,75 @@
+package boot
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/discovery"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	ma "github.com/multiformats/go-multiaddr"
+	madns "github.com/multiformats/go-multiaddr-dns"
+)
+
+var _ Service = DNSAddr{}
+
+// DNSAddr is a set of bootstrap peers that is resolved from the TXT
+// records of a /dnsaddr multiaddr, e.g. /dnsaddr/bootstrap.example.com.
+// The records are resolved on each call to FindPeers, so changes to the
+// DNS zone are picked up without restarting the host.
+type DNSAddr struct {
+	// Addr is the /dnsaddr multiaddr.  If it ends with a /p2p
+	// component, only the records for that peer are returned.
+	Addr ma.Multiaddr
+
+	// Self is the local peer, which is excluded from the results.
+	Self peer.ID
+
+	// Resolver looks up TXT records.  If nil, madns.DefaultResolver
+	// is used.  Tests MAY supply a resolver backed by a stub, e.g.
+	// madns.MockResolver.
+	Resolver *madns.Resolver
+}
+
+// Advertise is a nop that defaults to PermanentAddrTTL.
+func (d DNSAddr) Advertise(ctx context.Context, ns string, opt ...discovery.Option) (time.Duration, error) {
+	return StaticAddrs(nil).Advertise(ctx, ns, opt...)
+}
+
+// FindPeers resolves the bootstrap peers from DNS.
+func (d DNSAddr) FindPeers(ctx context.Context, ns string, opt ...discovery.Option) (<-chan peer.AddrInfo, error) {
+	addrs, err := d.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return addrs.FindPeers(ctx, ns, opt...)
+}
+
+// Resolve the bootstrap peers from DNS.
+func (d DNSAddr) Resolve(ctx context.Context) (StaticAddrs, error) {
+	maddrs, err := d.resolver().Resolve(ctx, d.Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs, err := NewStaticAddrs(maddrs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return addrs.Filter(func(info peer.AddrInfo) bool {
+		return info.ID != d.Self
+	}), nil
+}
+
+// Close is a nop method.  It exists to satisfy boot.Service.
+func (DNSAddr) Close() error { return nil }
+
+func (d DNSAddr) resolver() *madns.Resolver {
+	if d.Resolver == nil {
+		return madns.DefaultResolver
+	}
+
+	return d.Resolver
+}
@@ -0,0 +1,56 @@
+package boot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	madns "github.com/multiformats/go-multiaddr-dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/pkg/boot"
+)
+
+func TestDNSAddr(t *testing.T) {
+	t.Parallel()
+
+	var (
+		self = newPeerID()
+		id0  = newPeerID()
+		id1  = newPeerID()
+		stub = &madns.MockResolver{TXT: map[string][]string{}}
+	)
+
+	set := func(ids ...peer.ID) {
+		var txt []string
+		for _, id := range ids {
+			txt = append(txt, fmt.Sprintf("dnsaddr=/ip4/10.0.0.1/tcp/2020/p2p/%s", id))
+		}
+		stub.TXT["_dnsaddr.bootstrap.example.com"] = txt
+	}
+
+	r, err := madns.NewResolver(madns.WithDefaultResolver(stub))
+	require.NoError(t, err, "must create resolver")
+
+	d := boot.DNSAddr{
+		Addr:     ma.StringCast("/dnsaddr/bootstrap.example.com"),
+		Self:     self,
+		Resolver: r,
+	}
+	assert.True(t, boot.IsDNSAddr(d.Addr), "should be a dnsaddr multiaddr")
+
+	set(self, id0)
+	assert.Equal(t, []peer.ID{id0}, ids(t, d), "should ignore local peer")
+
+	set(id0, id1)
+	assert.ElementsMatch(t, []peer.ID{id0, id1}, ids(t, d),
+		"should resolve records on each call")
+
+	d.Addr = ma.StringCast("/dnsaddr/bootstrap.example.com/p2p/" + id1.String())
+	assert.Equal(t, []peer.ID{id1}, ids(t, d), "should filter by peer")
+
+	d.Addr = ma.StringCast("/dnsaddr/missing.example.com")
+	assert.Empty(t, ids(t, d), "should not find peers for missing domain")
+}
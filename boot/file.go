@@ -0,0 +1,202 @@
+package boot
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/discovery"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+const P_FILE = 105
+
+func init() {
+	if err := ma.AddProtocol(ma.Protocol{
+		Name:       "file",
+		Code:       P_FILE,
+		VCode:      ma.CodeToVarint(P_FILE),
+		Size:       ma.LengthPrefixedVarSize,
+		Path:       true,
+		Transcoder: ma.TranscoderUnix,
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// DefaultPollInterval is the default interval at which a File checks
+// for changes.
+const DefaultPollInterval = time.Second
+
+var _ Service = (*File)(nil)
+
+// File is a set of bootstrap peers that is read from a file, and which
+// is re-read whenever the file changes.  The file contains one p2p
+// multiaddr per line.  Blank lines and lines starting with '#' are
+// ignored.
+type File struct {
+	path string
+	self peer.ID
+
+	mu    sync.RWMutex
+	addrs StaticAddrs
+	stat  os.FileInfo
+
+	done chan struct{}
+	once sync.Once
+}
+
+// NewFile reads the bootstrap peers from the file at path, and polls
+// it for changes at the specified interval.  If interval <= 0, the
+// DefaultPollInterval is used.  Addresses of the local peer are ignored,
+// so that the same file can be deployed to each host in the cluster.
+func NewFile(self peer.ID, path string, interval time.Duration) (*File, error) {
+	f := &File{
+		path: path,
+		self: self,
+		done: make(chan struct{}),
+	}
+
+	if err := f.reload(); err != nil {
+		return nil, err
+	}
+
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	go f.poll(interval)
+
+	return f, nil
+}
+
+// Close stops polling the file for changes.
+func (f *File) Close() error {
+	f.once.Do(func() { close(f.done) })
+	return nil
+}
+
+// Addrs returns the bootstrap peers that were last read from the file.
+func (f *File) Addrs() StaticAddrs {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.addrs
+}
+
+// Advertise is a nop that defaults to PermanentAddrTTL.
+func (f *File) Advertise(ctx context.Context, ns string, opt ...discovery.Option) (time.Duration, error) {
+	return f.Addrs().Advertise(ctx, ns, opt...)
+}
+
+// FindPeers returns the bootstrap peers that were last read from the
+// file.
+func (f *File) FindPeers(ctx context.Context, ns string, opt ...discovery.Option) (<-chan peer.AddrInfo, error) {
+	return f.Addrs().FindPeers(ctx, ns, opt...)
+}
+
+func (f *File) poll(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-f.done:
+			return
+		}
+
+		if !f.changed() {
+			continue
+		}
+
+		// Keep the last valid set of peers if the file is malformed,
+		// e.g. while it is being rewritten.
+		if err := f.reload(); err != nil {
+			slog.Warn("failed to reload bootstrap file",
+				"path", f.path,
+				"error", err)
+		}
+	}
+}
+
+func (f *File) changed() bool {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return false // keep the last set of peers until the file reappears
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.stat == nil ||
+		!info.ModTime().Equal(f.stat.ModTime()) ||
+		info.Size() != f.stat.Size() ||
+		!os.SameFile(info, f.stat)
+}
+
+func (f *File) reload() error {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return err
+	}
+
+	b, err := os.ReadFile(f.path)
+	if err != nil {
+		return err
+	}
+
+	addrs, err := ParseAddrs(b)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// Don't reload a malformed file until it changes again.
+	f.stat = info
+	if err != nil {
+		return err
+	}
+
+	f.addrs = addrs.Filter(func(info peer.AddrInfo) bool {
+		return info.ID != f.self
+	})
+
+	return nil
+}
+
+// ParseAddrs parses a list of p2p multiaddrs, one per line.  Blank
+// lines and lines starting with '#' are ignored.  Multiple addresses
+// for the same peer are merged into a single peer.AddrInfo.
+func ParseAddrs(b []byte) (StaticAddrs, error) {
+	var (
+		maddrs []ma.Multiaddr
+		s      = bufio.NewScanner(bytes.NewReader(b))
+	)
+
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		maddr, err := ma.NewMultiaddr(line)
+		if err != nil {
+			return nil, err
+		}
+
+		maddrs = append(maddrs, maddr)
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return NewStaticAddrs(maddrs...)
+}
@@ -0,0 +1,115 @@
+package boot_test
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/discovery"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/pkg/boot"
+)
+
+func TestParseAddrs(t *testing.T) {
+	t.Parallel()
+
+	id := newPeerID()
+	addrs, err := boot.ParseAddrs([]byte(fmt.Sprintf(`
+# bootstrap peers
+/ip4/10.0.0.1/tcp/2020/p2p/%[1]s
+/ip4/10.0.0.1/udp/2020/quic-v1/p2p/%[1]s
+`, id)))
+	require.NoError(t, err, "should parse addrs")
+	require.Len(t, addrs, 1, "should merge addrs for the same peer")
+	assert.Equal(t, id, addrs[0].ID)
+	assert.Len(t, addrs[0].Addrs, 2, "should report both addrs")
+
+	_, err = boot.ParseAddrs([]byte("/ip4/10.0.0.1/tcp/2020"))
+	assert.Error(t, err, "should reject addrs without peer ID")
+}
+
+func TestFile(t *testing.T) {
+	t.Parallel()
+
+	var (
+		self = newPeerID()
+		id0  = newPeerID()
+		id1  = newPeerID()
+		path = filepath.Join(t.TempDir(), "peers")
+	)
+
+	write := func(ids ...peer.ID) {
+		var b []byte
+		for _, id := range ids {
+			b = fmt.Appendf(b, "/ip4/10.0.0.1/tcp/2020/p2p/%s\n", id)
+		}
+
+		// Rename the file into place, as deployment tooling would.
+		tmp := path + ".tmp"
+		require.NoError(t, os.WriteFile(tmp, b, 0644), "must write file")
+		require.NoError(t, os.Rename(tmp, path), "must rename file")
+	}
+
+	write(self, id0)
+
+	maddr, err := ma.NewMultiaddr("/file" + path)
+	require.NoError(t, err, "should parse file multiaddr")
+	assert.True(t, boot.IsFile(maddr), "should be a file multiaddr")
+
+	f, err := boot.NewFile(self, path, time.Millisecond*10)
+	require.NoError(t, err, "should read file")
+	defer f.Close()
+
+	assert.Equal(t, []peer.ID{id0}, ids(t, f), "should ignore local peer")
+
+	write(id0, id1)
+	assert.Eventually(t, func() bool {
+		return len(ids(t, f)) == 2
+	}, time.Second, time.Millisecond*10, "should reload file on change")
+
+	require.NoError(t, os.WriteFile(path, []byte("garbage"), 0644))
+	time.Sleep(time.Millisecond * 50)
+	assert.ElementsMatch(t, []peer.ID{id0, id1}, ids(t, f),
+		"should keep last valid peers")
+
+	_, err = boot.NewFile(self, filepath.Join(t.TempDir(), "missing"), 0)
+	assert.Error(t, err, "should fail to open missing file")
+}
+
+func ids(t *testing.T, d interface {
+	FindPeers(context.Context, string, ...discovery.Option) (<-chan peer.AddrInfo, error)
+}) (ids []peer.ID) {
+	t.Helper()
+
+	peers, err := d.FindPeers(context.Background(), "test")
+	require.NoError(t, err, "should find peers")
+
+	for info := range peers {
+		ids = append(ids, info.ID)
+	}
+
+	return
+}
+
+func newPeerID() peer.ID {
+	sk, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	id, err := peer.IDFromPrivateKey(sk)
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
@@ -0,0 +1,236 @@
+// Package mdns discovers peers on the local network with multicast DNS.
+package mdns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/discovery"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+	p2p_mdns "github.com/libp2p/go-libp2p/p2p/discovery/mdns"
+	"github.com/libp2p/zeroconf/v2"
+
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+const P_MDNS = 104
+
+func init() {
+	if err := ma.AddProtocol(ma.Protocol{
+		Name:  "mdns",
+		Code:  P_MDNS,
+		VCode: ma.CodeToVarint(P_MDNS),
+	}); err != nil {
+		panic(err)
+	}
+}
+
+const (
+	domain        = "local"
+	dnsaddrPrefix = "dnsaddr="
+)
+
+// ErrClosed is returned when advertising on a closed service.
+var ErrClosed = errors.New("closed")
+
+// ServiceName returns the DNS-SD service name under which peers in the
+// namespace are advertised, e.g. "_ww._p2p._udp".  Scoping the service
+// name to the namespace prevents peers from discovering other clusters
+// on the same network.
+func ServiceName(ns string) string {
+	return fmt.Sprintf("_%s.%s", ns, p2p_mdns.ServiceName)
+}
+
+// Service discovers peers through libp2p's mDNS service.  Unlike the
+// multicast surveyor, it uses the standard mDNS group and port, which
+// are seldom filtered on local networks.
+type Service struct {
+	host host.Host
+
+	mu     sync.Mutex
+	ads    map[string]p2p_mdns.Service
+	closed bool
+}
+
+func New(h host.Host) *Service {
+	return &Service{
+		host: h,
+		ads:  make(map[string]p2p_mdns.Service),
+	}
+}
+
+// Close stops advertising the host in all namespaces.
+func (s *Service) Close() (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for ns, ad := range s.ads {
+		err = errors.Join(err, ad.Close())
+		delete(s.ads, ns)
+	}
+
+	s.closed = true
+	return
+}
+
+// Advertise the host in the namespace.  The host's addresses are
+// announced until the service is closed, so subsequent calls for the
+// same namespace are nops.  Peers discovered in the process are added
+// to the host's peerstore.
+func (s *Service) Advertise(ctx context.Context, ns string, opt ...discovery.Option) (time.Duration, error) {
+	if len(s.host.Addrs()) == 0 {
+		return 0, errors.New("no listen addrs")
+	}
+
+	var opts = discovery.Options{Ttl: peerstore.TempAddrTTL}
+	if err := opts.Apply(opt...); err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return 0, ErrClosed
+	}
+
+	if _, ok := s.ads[ns]; !ok {
+		ad := p2p_mdns.NewMdnsService(s.host, ServiceName(ns), notifee{s.host})
+		if err := ad.Start(); err != nil {
+			return 0, err
+		}
+
+		s.ads[ns] = ad
+	}
+
+	return opts.Ttl, nil
+}
+
+// FindPeers browses the local network for peers advertising in the
+// namespace.  The channel is closed when ctx expires, or when the limit
+// has been reached.  FindPeers does not require the host to advertise
+// itself, so it is suitable for clients without listen addresses.
+func (s *Service) FindPeers(ctx context.Context, ns string, opt ...discovery.Option) (<-chan peer.AddrInfo, error) {
+	var opts discovery.Options
+	if err := opts.Apply(opt...); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	var (
+		entries = make(chan *zeroconf.ServiceEntry, 8)
+		done    = make(chan struct{})
+	)
+
+	go func() {
+		defer close(done)
+		defer cancel()
+
+		if err := zeroconf.Browse(ctx, ServiceName(ns), domain, entries); err != nil {
+			slog.Debug("mdns browsing failed",
+				"ns", ns,
+				"error", err)
+		}
+	}()
+
+	out := make(chan peer.AddrInfo, 1)
+	go func() {
+		defer close(out)
+
+		// The resolver blocks on entries until it has shut down.
+		defer func() {
+			for cancel(); ; {
+				select {
+				case <-entries:
+				case <-done:
+					return
+				}
+			}
+		}()
+
+		seen := make(map[peer.ID]struct{})
+		for {
+			select {
+			case entry, ok := <-entries:
+				if !ok {
+					return
+				}
+
+				for _, info := range s.parse(entry) {
+					if _, ok := seen[info.ID]; ok {
+						continue
+					}
+					seen[info.ID] = struct{}{}
+
+					select {
+					case out <- info:
+					case <-ctx.Done():
+						return
+					}
+
+					if opts.Limit > 0 && len(seen) >= opts.Limit {
+						return
+					}
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// parse the peers from the entry's dnsaddr TXT records, as announced
+// by libp2p's mDNS service.
+func (s *Service) parse(entry *zeroconf.ServiceEntry) []peer.AddrInfo {
+	addrs := make([]ma.Multiaddr, 0, len(entry.Text))
+	for _, txt := range entry.Text {
+		if !strings.HasPrefix(txt, dnsaddrPrefix) {
+			continue
+		}
+
+		addr, err := ma.NewMultiaddr(strings.TrimPrefix(txt, dnsaddrPrefix))
+		if err != nil {
+			slog.Debug("invalid dnsaddr",
+				"txt", txt,
+				"error", err)
+			continue
+		}
+
+		addrs = append(addrs, addr)
+	}
+
+	infos, err := peer.AddrInfosFromP2pAddrs(addrs...)
+	if err != nil {
+		slog.Debug("invalid peer addrs",
+			"instance", entry.Instance,
+			"error", err)
+		return nil
+	}
+
+	filt := infos[:0]
+	for _, info := range infos {
+		if info.ID != s.host.ID() {
+			filt = append(filt, info)
+		}
+	}
+
+	return filt
+}
+
+// notifee adds the peers found while advertising to the peerstore.
+type notifee struct{ host host.Host }
+
+func (n notifee) HandlePeerFound(info peer.AddrInfo) {
+	n.host.Peerstore().AddAddrs(info.ID, info.Addrs, peerstore.TempAddrTTL)
+}
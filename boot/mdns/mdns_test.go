@@ -0,0 +1,80 @@
+package mdns_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/discovery"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/pkg/boot/mdns"
+)
+
+func TestMDNS(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ns = "mdns-test-" + t.Name()
+		h0 = newHost(t, "/ip4/127.0.0.1/tcp/0")
+		h1 = newHost(t, "/ip4/127.0.0.1/tcp/0")
+		h2 = newHost(t) // dial-only
+	)
+
+	s0 := mdns.New(h0)
+	defer s0.Close()
+
+	s1 := mdns.New(h1)
+	defer s1.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	_, err := s0.Advertise(ctx, ns)
+	require.NoError(t, err, "should advertise")
+
+	_, err = s1.Advertise(ctx, "other-"+ns)
+	require.NoError(t, err, "should advertise in other namespace")
+
+	_, err = mdns.New(h2).Advertise(ctx, ns)
+	require.Error(t, err, "should not advertise without listen addrs")
+
+	peers, err := mdns.New(h2).FindPeers(ctx, ns, discovery.Limit(1))
+	require.NoError(t, err, "should find peers")
+
+	var found []host.Host
+	for info := range peers {
+		switch info.ID {
+		case h0.ID():
+			found = append(found, h0)
+			assert.NotEmpty(t, info.Addrs, "should report addrs")
+		case h1.ID():
+			found = append(found, h1)
+		}
+	}
+
+	require.NoError(t, ctx.Err(), "should close channel when limit is reached")
+	assert.Equal(t, []host.Host{h0}, found, "should only find peers in namespace")
+
+	_, err = s0.Advertise(ctx, ns)
+	assert.NoError(t, err, "repeated advertisement should succeed")
+
+	require.NoError(t, s0.Close(), "should close")
+	_, err = s0.Advertise(ctx, ns)
+	assert.ErrorIs(t, err, mdns.ErrClosed, "should not advertise after close")
+}
+
+func newHost(t *testing.T, listen ...string) host.Host {
+	t.Helper()
+
+	h, err := libp2p.New(
+		libp2p.NoListenAddrs,
+		libp2p.ListenAddrStrings(listen...))
+	require.NoError(t, err, "must create test host")
+	t.Cleanup(func() { h.Close() })
+
+	return h
+}
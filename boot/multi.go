@@ -0,0 +1,231 @@
+package boot
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/discovery"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+var _ Service = Multi(nil)
+
+// Source is a discovery service in a Multi.
+type Source struct {
+	Service
+
+	// Priority determines the order in which sources are queried.
+	// Sources with a lower priority are only queried if no peers were
+	// found in those with a higher priority (i.e. with a smaller value).
+	Priority int
+
+	// Timeout bounds each call to the source's FindPeers and Advertise
+	// methods.  If zero, calls are bounded only by the caller's context.
+	Timeout time.Duration
+}
+
+func (s Source) context(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.Timeout > 0 {
+		return context.WithTimeout(ctx, s.Timeout)
+	}
+
+	return context.WithCancel(ctx)
+}
+
+// Multi is a composite discovery service.  FindPeers queries sources
+// with equal priority concurrently, and falls back on sources with lower
+// priority if none were found.  Peers are deduplicated by ID.  Advertise
+// is sent to all sources.
+type Multi []Source
+
+// Close each source.
+func (m Multi) Close() (err error) {
+	for _, s := range m {
+		err = errors.Join(err, s.Close())
+	}
+
+	return
+}
+
+// Advertise in each source, concurrently.  The shortest TTL is returned,
+// so that the caller re-advertises before any source expires the local
+// host.  Advertise fails only if it fails for every source.
+func (m Multi) Advertise(ctx context.Context, ns string, opt ...discovery.Option) (time.Duration, error) {
+	var (
+		wg   sync.WaitGroup
+		ttls = make([]time.Duration, len(m))
+		errs = make([]error, len(m))
+	)
+
+	for i, s := range m {
+		wg.Add(1)
+		go func(i int, s Source) {
+			defer wg.Done()
+
+			ctx, cancel := s.context(ctx)
+			defer cancel()
+
+			ttls[i], errs[i] = s.Advertise(ctx, ns, opt...)
+		}(i, s)
+	}
+
+	wg.Wait()
+
+	var (
+		ttl time.Duration
+		ok  bool
+	)
+
+	for i := range m {
+		if errs[i] != nil {
+			slog.Debug("failed to advertise",
+				"ns", ns,
+				"source", i,
+				"error", errs[i])
+			continue
+		}
+
+		if !ok || ttls[i] < ttl {
+			ttl, ok = ttls[i], true
+		}
+	}
+
+	if !ok && len(m) > 0 {
+		return 0, errors.Join(errs...)
+	}
+
+	return ttl, nil
+}
+
+// FindPeers in each source, in order of priority.  The channel is closed
+// when all sources have been exhausted, or when the limit is reached.
+func (m Multi) FindPeers(ctx context.Context, ns string, opt ...discovery.Option) (<-chan peer.AddrInfo, error) {
+	var opts discovery.Options
+	if err := opts.Apply(opt...); err != nil {
+		return nil, err
+	}
+
+	out := make(chan peer.AddrInfo, 1)
+	go func() {
+		defer close(out)
+
+		seen := make(map[peer.ID]struct{})
+		for _, group := range m.groups() {
+			if !group.findPeers(ctx, ns, opt, opts.Limit, seen, out) {
+				return
+			}
+
+			if len(seen) > 0 {
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// groups returns the sources grouped by priority, in descending order
+// of priority.
+func (m Multi) groups() (gs []Multi) {
+	sorted := make(Multi, len(m))
+	copy(sorted, m)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Priority < sorted[j].Priority
+	})
+
+	for i, s := range sorted {
+		if i == 0 || s.Priority != sorted[i-1].Priority {
+			gs = append(gs, nil)
+		}
+
+		gs[len(gs)-1] = append(gs[len(gs)-1], s)
+	}
+
+	return
+}
+
+// findPeers queries each source in the group concurrently, and sends
+// new peers to out.  It returns false if the caller should stop, i.e.
+// if ctx expired or the limit was reached.
+func (m Multi) findPeers(
+	ctx context.Context,
+	ns string,
+	opt []discovery.Option,
+	limit int,
+	seen map[peer.ID]struct{},
+	out chan<- peer.AddrInfo,
+) bool {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg     sync.WaitGroup
+		merged = make(chan peer.AddrInfo)
+	)
+
+	for i, s := range m {
+		wg.Add(1)
+		go func(i int, s Source) {
+			defer wg.Done()
+
+			ctx, cancel := s.context(ctx)
+			defer cancel()
+
+			peers, err := s.FindPeers(ctx, ns, opt...)
+			if err != nil {
+				slog.Debug("failed to find peers",
+					"ns", ns,
+					"source", i,
+					"priority", s.Priority,
+					"error", err)
+				return
+			}
+
+			for {
+				select {
+				case info, ok := <-peers:
+					if !ok {
+						return
+					}
+
+					select {
+					case merged <- info:
+					case <-ctx.Done():
+						return
+					}
+
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(i, s)
+	}
+
+	go func() {
+		defer close(merged)
+		wg.Wait()
+	}()
+
+	for info := range merged {
+		if _, ok := seen[info.ID]; ok {
+			continue
+		}
+		seen[info.ID] = struct{}{}
+
+		select {
+		case out <- info:
+		case <-ctx.Done():
+			return false
+		}
+
+		if limit > 0 && len(seen) >= limit {
+			return false
+		}
+	}
+
+	return ctx.Err() == nil
+}
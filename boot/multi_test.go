@@ -0,0 +1,143 @@
+package boot_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/discovery"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/pkg/boot"
+)
+
+func TestMulti(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id0 = newPeerID()
+		id1 = newPeerID()
+		id2 = newPeerID()
+	)
+
+	static := func(ids ...peer.ID) boot.StaticAddrs {
+		as := make(boot.StaticAddrs, len(ids))
+		for i, id := range ids {
+			as[i] = peer.AddrInfo{ID: id}
+		}
+		return as
+	}
+
+	t.Run("Dedup", func(t *testing.T) {
+		t.Parallel()
+
+		m := boot.Multi{
+			{Service: static(id0, id1)},
+			{Service: static(id1, id2)},
+		}
+
+		assert.ElementsMatch(t, []peer.ID{id0, id1, id2}, ids(t, m),
+			"should deduplicate peers across sources")
+	})
+
+	t.Run("Fallback", func(t *testing.T) {
+		t.Parallel()
+
+		m := boot.Multi{
+			{Service: static(id2), Priority: 1},
+			{Service: failing{}},
+			{Service: static()},
+		}
+
+		assert.Equal(t, []peer.ID{id2}, ids(t, m),
+			"should fall back on lower-priority sources")
+
+		m = append(m, boot.Source{Service: static(id0), Priority: -1})
+		assert.Equal(t, []peer.ID{id0}, ids(t, m),
+			"should not query lower-priority sources")
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		t.Parallel()
+
+		m := boot.Multi{
+			{Service: blocking{}, Timeout: time.Millisecond * 10},
+			{Service: static(id0), Priority: 1},
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		peers, err := m.FindPeers(ctx, "test")
+		require.NoError(t, err, "should find peers")
+
+		var got []peer.ID
+		for info := range peers {
+			got = append(got, info.ID)
+		}
+
+		require.NoError(t, ctx.Err(), "should time out blocking source")
+		assert.Equal(t, []peer.ID{id0}, got, "should fall back after timeout")
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		t.Parallel()
+
+		m := boot.Multi{{Service: static(id0, id1, id2)}}
+		peers, err := m.FindPeers(context.Background(), "test", discovery.Limit(2))
+		require.NoError(t, err, "should find peers")
+
+		var n int
+		for range peers {
+			n++
+		}
+		assert.Equal(t, 2, n, "should respect limit")
+	})
+
+	t.Run("Advertise", func(t *testing.T) {
+		t.Parallel()
+
+		m := boot.Multi{
+			{Service: static()},
+			{Service: failing{}},
+			{Service: blocking{}, Timeout: time.Millisecond * 10},
+		}
+
+		ttl, err := m.Advertise(context.Background(), "test", discovery.TTL(time.Minute))
+		require.NoError(t, err, "should advertise if any source succeeds")
+		assert.Equal(t, time.Minute, ttl)
+
+		_, err = boot.Multi{{Service: failing{}}}.Advertise(context.Background(), "test")
+		assert.Error(t, err, "should fail if all sources fail")
+	})
+}
+
+// failing discovery service
+type failing struct{}
+
+func (failing) Close() error { return nil }
+
+func (failing) Advertise(context.Context, string, ...discovery.Option) (time.Duration, error) {
+	return 0, errors.New("failed")
+}
+
+func (failing) FindPeers(context.Context, string, ...discovery.Option) (<-chan peer.AddrInfo, error) {
+	return nil, errors.New("failed")
+}
+
+// blocking discovery service, which never finds peers.
+type blocking struct{}
+
+func (blocking) Close() error { return nil }
+
+func (blocking) Advertise(ctx context.Context, _ string, _ ...discovery.Option) (time.Duration, error) {
+	<-ctx.Done()
+	return 0, ctx.Err()
+}
+
+func (blocking) FindPeers(context.Context, string, ...discovery.Option) (<-chan peer.AddrInfo, error) {
+	return make(chan peer.AddrInfo), nil
+}
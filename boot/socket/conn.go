@@ -2,6 +2,7 @@ package socket
 
 import (
 	"context"
+	"errors"
 	"net"
 
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -72,7 +73,10 @@ func (r *RateLimiter) Reserve(ctx context.Context, n int) (err error) {
 
 type recordConn struct {
 	lim      *RateLimiter
+	src      *sourceLimiter
 	validate RecordValidator
+	secret   *Secret
+	stats    *metrics
 	*multicast.Socket
 }
 
@@ -88,6 +92,10 @@ func (conn recordConn) Send(ctx context.Context, e *record.Envelope, addr net.Ad
 		return err
 	}
 
+	if b, err = conn.secret.Seal(b); err != nil {
+		return err
+	}
+
 	if err = conn.lim.Reserve(ctx, len(b)); err != nil {
 		return err
 	}
@@ -107,8 +115,24 @@ func (conn recordConn) Scan(p *Record) (net.Addr, error) {
 		return nil, err
 	}
 
-	e, err := record.ConsumeTypedEnvelope(buf[:n], p)
+	// Drop floods before doing any expensive work.
+	if !conn.src.Allow(addr) {
+		conn.stats.throttled.Add(1)
+		return nil, ErrIgnore
+	}
+
+	b, err := conn.secret.Open(buf[:n])
+	if err != nil {
+		conn.stats.invalid.Add(1)
+		return nil, ValidationError{
+			Cause: err,
+			From:  addr,
+		}
+	}
+
+	e, err := record.ConsumeTypedEnvelope(b, p)
 	if err != nil {
+		conn.stats.invalid.Add(1)
 		return nil, ValidationError{
 			Cause: err,
 			From:  addr,
@@ -116,6 +140,10 @@ func (conn recordConn) Scan(p *Record) (net.Addr, error) {
 	}
 
 	if err = conn.validate(e, p); err != nil {
+		if !errors.Is(err, ErrIgnore) {
+			conn.stats.invalid.Add(1)
+		}
+
 		return nil, ValidationError{
 			Cause: err,
 			From:  addr,
@@ -0,0 +1,225 @@
+package socket
+
+import (
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultSourceLimit is the default rate, in packets per second, at
+	// which a socket accepts packets from each source address.
+	DefaultSourceLimit rate.Limit = 16
+
+	// DefaultSourceBurst is the default number of packets that a socket
+	// accepts from each source address in a burst.
+	DefaultSourceBurst = 32
+
+	// DefaultResponseLimit is the default rate, in packets per second,
+	// at which a socket sends responses.
+	DefaultResponseLimit rate.Limit = 32
+
+	// DefaultResponseBurst is the default number of responses that a
+	// socket sends in a burst.
+	DefaultResponseBurst = 32
+
+	// DefaultQuorum is the default number of distinct peers whose survey
+	// responses must be observed within DefaultQuorumWindow before a
+	// socket suppresses its own responses.
+	DefaultQuorum = 16
+
+	// DefaultQuorumWindow is the default window over which survey
+	// responses are counted.
+	DefaultQuorumWindow = time.Second
+)
+
+// Metrics counts the packets that were dropped by a socket.
+type Metrics struct {
+	// Throttled is the number of incoming packets that were dropped
+	// because their source exceeded its rate limit.
+	Throttled uint64
+
+	// Invalid is the number of incoming packets that were dropped
+	// because they could not be authenticated or decoded.
+	Invalid uint64
+
+	// OverBudget is the number of responses that were dropped because
+	// the socket exceeded its response budget.
+	OverBudget uint64
+
+	// Suppressed is the number of survey responses that were dropped
+	// because enough peers had already responded.
+	Suppressed uint64
+}
+
+type metrics struct {
+	throttled, invalid, overBudget, suppressed atomic.Uint64
+}
+
+func (m *metrics) snapshot() Metrics {
+	return Metrics{
+		Throttled:  m.throttled.Load(),
+		Invalid:    m.invalid.Load(),
+		OverBudget: m.overBudget.Load(),
+		Suppressed: m.suppressed.Load(),
+	}
+}
+
+// sourceLimiter applies a token bucket to each source address.  Sources
+// are identified by IP address, so that spoofed floods cannot evade the
+// limit by varying the source port.
+type sourceLimiter struct {
+	limit rate.Limit
+	burst int
+
+	mu      sync.Mutex
+	buckets map[string]*rate.Limiter
+}
+
+func newSourceLimiter(r rate.Limit, burst int) *sourceLimiter {
+	if burst <= 0 {
+		return nil
+	}
+
+	return &sourceLimiter{
+		limit:   r,
+		burst:   burst,
+		buckets: make(map[string]*rate.Limiter),
+	}
+}
+
+// Allow returns true if a packet from addr is within the limit.
+func (l *sourceLimiter) Allow(addr net.Addr) bool {
+	if l == nil {
+		return true
+	}
+
+	key := source(addr)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	lim, ok := l.buckets[key]
+	if !ok {
+		lim = rate.NewLimiter(l.limit, l.burst)
+		l.buckets[key] = lim
+	}
+
+	return lim.Allow()
+}
+
+// gc removes the buckets that have refilled, since they are equivalent
+// to new buckets.
+func (l *sourceLimiter) gc(t time.Time) {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for key, lim := range l.buckets {
+		if lim.TokensAt(t) >= float64(l.burst) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+func source(addr net.Addr) string {
+	switch a := addr.(type) {
+	case nil:
+		return ""
+	case *net.UDPAddr:
+		return a.IP.String()
+	default:
+		return a.String()
+	}
+}
+
+// quorum counts the distinct peers whose survey responses were observed
+// in each namespace, over a fixed window.  Responses are counted by the
+// authenticated peer ID, so that a single peer, or a replayed response,
+// cannot suppress the responses of others.
+type quorum struct {
+	n      int
+	window time.Duration
+
+	mu     sync.Mutex
+	counts map[string]*tally
+}
+
+type tally struct {
+	start time.Time
+	peers map[peer.ID]struct{}
+}
+
+func newQuorum(n int, window time.Duration) *quorum {
+	if n <= 0 {
+		return nil
+	}
+
+	return &quorum{
+		n:      n,
+		window: window,
+		counts: make(map[string]*tally),
+	}
+}
+
+// Observe a response from the peer in the namespace.
+func (q *quorum) Observe(ns string, id peer.ID, t time.Time) {
+	if q == nil {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	c, ok := q.counts[ns]
+	if !ok || t.Sub(c.start) > q.window {
+		c = &tally{start: t, peers: make(map[peer.ID]struct{})}
+		q.counts[ns] = c
+	}
+
+	// Stop growing the set once the quorum is reached.
+	if len(c.peers) < q.n {
+		c.peers[id] = struct{}{}
+	}
+}
+
+// Reached returns true if the quorum was reached in the namespace
+// during the current window.
+func (q *quorum) Reached(ns string, t time.Time) bool {
+	if q == nil {
+		return false
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	c, ok := q.counts[ns]
+	return ok && t.Sub(c.start) <= q.window && len(c.peers) >= q.n
+}
+
+// gc removes the tallies whose window has expired.
+func (q *quorum) gc(t time.Time) {
+	if q == nil {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for ns, c := range q.counts {
+		if t.Sub(c.start) > q.window {
+			delete(q.counts, ns)
+		}
+	}
+}
+
+func allow(lim *rate.Limiter) bool {
+	return lim == nil || lim.Allow()
+}
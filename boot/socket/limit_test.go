@@ -0,0 +1,86 @@
+package socket
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceLimiter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		l     = newSourceLimiter(1, 2)
+		a     = &net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 8822}
+		spoof = &net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 9999}
+		b     = &net.UDPAddr{IP: net.IPv4(10, 0, 0, 2), Port: 8822}
+	)
+
+	assert.True(t, l.Allow(a), "should allow first packet")
+	assert.True(t, l.Allow(spoof), "should allow burst")
+	assert.False(t, l.Allow(a), "should throttle source after burst")
+	assert.True(t, l.Allow(b), "should not throttle other sources")
+
+	l.gc(time.Now().Add(time.Hour))
+	assert.Empty(t, l.buckets, "should collect refilled buckets")
+
+	assert.True(t, newSourceLimiter(1, 0).Allow(a),
+		"should not limit sources if burst is zero")
+}
+
+func TestQuorum(t *testing.T) {
+	t.Parallel()
+
+	var (
+		q   = newQuorum(2, time.Second)
+		now = time.Now()
+	)
+
+	q.Observe("test", "a", now)
+	assert.False(t, q.Reached("test", now), "should not reach quorum")
+
+	q.Observe("test", "a", now)
+	assert.False(t, q.Reached("test", now),
+		"should not count repeated responses from a peer")
+
+	q.Observe("test", "b", now)
+	assert.True(t, q.Reached("test", now), "should reach quorum")
+	assert.False(t, q.Reached("other", now), "should count namespaces separately")
+
+	later := now.Add(2 * time.Second)
+	assert.False(t, q.Reached("test", later), "should expire window")
+
+	q.gc(later)
+	assert.Empty(t, q.counts, "should collect expired tallies")
+
+	assert.False(t, newQuorum(0, time.Second).Reached("test", now),
+		"should never suppress responses if n is zero")
+}
+
+func TestSocketLimits(t *testing.T) {
+	t.Parallel()
+
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	require.NoError(t, err, "must listen")
+
+	sock := New(conn,
+		WithResponseLimit(0, 0),
+		WithQuorum(1, time.Minute))
+	defer sock.Close()
+
+	sock.quorum.Observe("test", "a", time.Now())
+	err = sock.SendSurveyResponse(nil, nil, "test")
+	assert.ErrorIs(t, err, ErrIgnore, "should suppress response after quorum")
+
+	sock = New(conn, WithResponseLimit(0, 1))
+	defer sock.Close()
+
+	sock.budget.Allow() // exhaust the budget
+	err = sock.SendResponse(nil, nil, nil, "test")
+	assert.ErrorIs(t, err, ErrIgnore, "should drop responses over budget")
+
+	assert.Equal(t, Metrics{OverBudget: 1}, sock.Metrics())
+}
@@ -3,8 +3,10 @@ package socket
 import (
 	"log/slog"
 	"net"
+	"time"
 
 	"github.com/wetware/pkg/util/log"
+	"golang.org/x/time/rate"
 )
 
 type Option func(*Socket)
@@ -33,6 +35,48 @@ func WithValidator(v RecordValidator) Option {
 	}
 }
 
+// WithSourceLimit limits the rate at which the socket accepts packets
+// from each source address, in packets per second.  Packets in excess
+// of the limit are dropped before they are authenticated.  If burst is
+// zero, packets are not limited.
+func WithSourceLimit(r rate.Limit, burst int) Option {
+	return func(s *Socket) {
+		s.conn.src = newSourceLimiter(r, burst)
+	}
+}
+
+// WithResponseLimit sets the socket's response budget, in responses per
+// second.  Unlike WithRateLimiter, which delays outgoing packets, the
+// budget drops responses in excess of the limit, bounding the traffic
+// that spoofed requests can elicit.  If burst is zero, responses are
+// not limited.
+func WithResponseLimit(r rate.Limit, burst int) Option {
+	return func(s *Socket) {
+		if s.budget = nil; burst > 0 {
+			s.budget = rate.NewLimiter(r, burst)
+		}
+	}
+}
+
+// WithQuorum suppresses the socket's survey responses once responses
+// from n distinct peers have been observed in the namespace within the
+// window.  If n is zero,
+// responses are never suppressed.
+func WithQuorum(n int, window time.Duration) Option {
+	return func(s *Socket) {
+		s.quorum = newQuorum(n, window)
+	}
+}
+
+// WithSecret causes the socket to seal outgoing packets with s, and
+// to drop incoming packets that were not sealed with s.  If s == nil,
+// packets are sent in the clear.
+func WithSecret(s *Secret) Option {
+	return func(sock *Socket) {
+		sock.conn.secret = s
+	}
+}
+
 // WithErrHandler sets the socket's error callback.  If h == nil,
 // a default error handler is used, which logs errors using the
 // socket's logger.
@@ -57,6 +101,11 @@ func WithErrHandler(h func(*Socket, error)) Option {
 				sock.Log().Debug(e.Message,
 					"cause", e.Cause)
 
+			case ValidationError:
+				sock.Log().Debug("invalid packet",
+					"from", e.From,
+					"cause", e.Cause)
+
 			default:
 				sock.Log().Error("socket error",
 					"error", e.Error())
@@ -95,5 +144,8 @@ func withDefault(opt []Option) []Option {
 		WithLogger(nil),
 		WithValidator(nil),
 		WithErrHandler(nil),
+		WithSourceLimit(DefaultSourceLimit, DefaultSourceBurst),
+		WithResponseLimit(DefaultResponseLimit, DefaultResponseBurst),
+		WithQuorum(DefaultQuorum, DefaultQuorumWindow),
 	}, opt...)
 }
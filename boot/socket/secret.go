@@ -0,0 +1,82 @@
+package socket
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+
+	"github.com/libp2p/go-libp2p/core/pnet"
+)
+
+const secretDomain = "ww/boot/"
+
+// ErrUnauthenticated is returned when a packet was not sealed with the
+// socket's secret, i.e. when it originates from outside the namespace.
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// Secret seals the packets exchanged by the members of a private
+// namespace.  Packets are encrypted and authenticated with a key that
+// is derived from the cluster's pre-shared key and the namespace, so
+// that non-members can neither read nor forge them.
+type Secret struct{ aead cipher.AEAD }
+
+// NewSecret derives the packet key for the namespace from the cluster's
+// pre-shared key.  It returns nil if psk is empty, in which case packets
+// are sent in the clear.
+func NewSecret(psk pnet.PSK, ns string) (*Secret, error) {
+	if len(psk) == 0 {
+		return nil, nil
+	}
+
+	mac := hmac.New(sha256.New, psk)
+	mac.Write([]byte(secretDomain + ns))
+
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Secret{aead: aead}, nil
+}
+
+// Seal the packet b.  If s == nil, b is returned unchanged.
+func (s *Secret) Seal(b []byte) ([]byte, error) {
+	if s == nil {
+		return b, nil
+	}
+
+	nonce := make([]byte, s.aead.NonceSize(), s.aead.NonceSize()+len(b)+s.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return s.aead.Seal(nonce, nonce, b, nil), nil
+}
+
+// Open a packet that was sealed by Seal.  If s == nil, b is returned
+// unchanged.
+func (s *Secret) Open(b []byte) ([]byte, error) {
+	if s == nil {
+		return b, nil
+	}
+
+	if len(b) < s.aead.NonceSize()+s.aead.Overhead() {
+		return nil, ErrUnauthenticated
+	}
+
+	nonce, sealed := b[:s.aead.NonceSize()], b[s.aead.NonceSize():]
+	b, err := s.aead.Open(sealed[:0], nonce, sealed, nil)
+	if err != nil {
+		return nil, ErrUnauthenticated
+	}
+
+	return b, nil
+}
@@ -0,0 +1,44 @@
+package socket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/pkg/boot/socket"
+)
+
+func TestSecret(t *testing.T) {
+	t.Parallel()
+
+	psk := []byte("secret")
+	s, err := socket.NewSecret(psk, "test")
+	require.NoError(t, err, "should derive secret")
+
+	sealed, err := s.Seal([]byte("packet"))
+	require.NoError(t, err, "should seal packet")
+	assert.NotContains(t, string(sealed), "packet", "should encrypt packet")
+
+	b, err := s.Open(sealed)
+	require.NoError(t, err, "should open packet")
+	assert.Equal(t, "packet", string(b))
+
+	other, err := socket.NewSecret(psk, "other")
+	require.NoError(t, err, "should derive secret")
+	_, err = other.Open(sealed)
+	assert.ErrorIs(t, err, socket.ErrUnauthenticated,
+		"should reject packet from other namespace")
+
+	_, err = s.Open([]byte("packet"))
+	assert.ErrorIs(t, err, socket.ErrUnauthenticated,
+		"should reject unsealed packet")
+
+	public, err := socket.NewSecret(nil, "test")
+	require.NoError(t, err, "should not fail without psk")
+	require.Nil(t, public, "should not derive secret without psk")
+
+	b, err = public.Seal([]byte("packet"))
+	require.NoError(t, err)
+	assert.Equal(t, "packet", string(b), "should send packets in the clear")
+}
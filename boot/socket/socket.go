@@ -15,6 +15,7 @@ import (
 	ctxutil "github.com/lthibault/util/ctx"
 	"github.com/wetware/pkg/util/log"
 	"github.com/wetware/pkg/util/multicast"
+	"golang.org/x/time/rate"
 )
 
 func init() { close(closedChan) }
@@ -33,6 +34,10 @@ type Socket struct {
 	tick  *time.Ticker
 	cache *RecordCache
 
+	budget *rate.Limiter
+	quorum *quorum
+	stats  metrics
+
 	handleError func(*Socket, error)
 
 	mu   sync.RWMutex
@@ -59,6 +64,8 @@ func New(conn net.PacketConn, opt ...Option) *Socket {
 		subs: make(map[string]subscriberSet),
 	}
 
+	sock.conn.stats = &sock.stats
+
 	for _, option := range withDefault(opt) {
 		option(sock)
 	}
@@ -106,6 +113,9 @@ func (s *Socket) Done() <-chan struct{} {
 
 func (s *Socket) Log() log.Logger { return s.log }
 
+// Metrics returns the number of packets that were dropped by the socket.
+func (s *Socket) Metrics() Metrics { return s.stats.snapshot() }
+
 func (s *Socket) Close() (err error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -159,6 +169,11 @@ func (s *Socket) SendSurveyRequest(ctx context.Context, seal Sealer, id peer.ID,
 }
 
 func (s *Socket) SendResponse(seal Sealer, h Host, to net.Addr, ns string) error {
+	if !allow(s.budget) {
+		s.stats.overBudget.Add(1)
+		return ErrIgnore
+	}
+
 	e, err := s.cache.LoadResponse(seal, h, ns)
 	if err != nil {
 		return err
@@ -167,7 +182,20 @@ func (s *Socket) SendResponse(seal Sealer, h Host, to net.Addr, ns string) error
 	return s.conn.Send(ctxutil.C(s.done), e, to)
 }
 
+// SendSurveyResponse multicasts a response to a survey.  The response
+// is suppressed if enough peers have already responded in the current
+// window, since the surveyor has likely received enough of them.
 func (s *Socket) SendSurveyResponse(seal Sealer, h Host, ns string) error {
+	if s.quorum.Reached(ns, time.Now()) {
+		s.stats.suppressed.Add(1)
+		return ErrIgnore
+	}
+
+	if !allow(s.budget) {
+		s.stats.overBudget.Add(1)
+		return ErrIgnore
+	}
+
 	e, err := s.cache.LoadResponse(seal, h, ns)
 	if err != nil {
 		return err
@@ -227,6 +255,9 @@ func (s *Socket) tickloop() {
 		}
 
 		s.mu.Unlock()
+
+		s.conn.src.gc(t)
+		s.quorum.gc(t)
 	}
 }
 
@@ -242,6 +273,10 @@ func (s *Socket) handle(h RequestHandler, r Record, addr net.Addr) error {
 	// Packet is already validated.  It's either a response, or some sort of request.
 	switch r.Type() {
 	case TypeResponse:
+		// The validator authenticates the record's peer.
+		if id, err := r.Peer(); err == nil {
+			s.quorum.Observe(ns, id, time.Now())
+		}
 		return s.dispatch(Response{
 			Record: r,
 			NS:     ns,
@@ -18,6 +18,11 @@ const (
 	P_SURVEY
 )
 
+// AnyInterface is the wildcard interface name.  Multicast addresses
+// bearing it are bound to the interface returned by DefaultInterface,
+// e.g. /ip4/228.8.8.8/udp/8822/multicast/*
+const AnyInterface = "*"
+
 func init() {
 	for _, p := range []ma.Protocol{
 		{
@@ -60,6 +65,10 @@ func ResolveMulticastInterface(maddr ma.Multiaddr) (*net.Interface, error) {
 		return nil, err
 	}
 
+	if name == AnyInterface {
+		return DefaultInterface()
+	}
+
 	ifi, err := net.InterfaceByName(name)
 	if err != nil {
 		return nil, err
@@ -80,6 +89,48 @@ func ResolveMulticastInterface(maddr ma.Multiaddr) (*net.Interface, error) {
 	return ifi, nil
 }
 
+// DefaultInterface returns the first interface that is up, supports
+// multicast and is not a loopback interface.  If no such interface
+// exists, as is typical of single-host deployments, it falls back to
+// the first loopback interface that is up.
+func DefaultInterface() (*net.Interface, error) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	return defaultInterface(ifaces)
+}
+
+func defaultInterface(ifaces []net.Interface) (*net.Interface, error) {
+	var loopback *net.Interface
+	for i, ifi := range ifaces {
+		if ifi.Flags&net.FlagUp == 0 {
+			continue
+		}
+
+		// Loopback interfaces seldom advertise multicast support (e.g.
+		// Linux's lo), but deliver multicast datagrams to local sockets.
+		if ifi.Flags&net.FlagLoopback != 0 {
+			if loopback == nil {
+				loopback = &ifaces[i]
+			}
+
+			continue
+		}
+
+		if ifi.Flags&net.FlagMulticast != 0 {
+			return &ifaces[i], nil
+		}
+	}
+
+	if loopback == nil {
+		return nil, errors.New("no multicast interface")
+	}
+
+	return loopback, nil
+}
+
 // JoinMulticastGroup joins the group address group on the provided
 // interface. By default all sources that can cast data to group are
 // accepted.
@@ -1,12 +1,12 @@
-package survey_test
+package survey
 
 import (
+	"net"
 	"testing"
 
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/stretchr/testify/assert"
-
-	_ "github.com/wetware/pkg/boot/survey"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMultiaddr(t *testing.T) {
@@ -18,6 +18,7 @@ func TestMultiaddr(t *testing.T) {
 	}{
 		{"/ip4/228.8.8.8/udp/8822/multicast/lo0", false},
 		{"/ip4/228.8.8.8/udp/8822/multicast/lo0/survey", false},
+		{"/ip4/228.8.8.8/udp/8822/multicast/*", false},
 	} {
 		_, err := ma.NewMultiaddr(tt.addr)
 		if tt.fail {
@@ -27,3 +28,64 @@ func TestMultiaddr(t *testing.T) {
 		}
 	}
 }
+
+func TestDefaultInterface(t *testing.T) {
+	t.Parallel()
+
+	ifi, err := DefaultInterface()
+	require.NoError(t, err, "should find interface")
+	assert.NotZero(t, ifi.Flags&net.FlagUp, "interface should be up")
+	if ifi.Flags&net.FlagLoopback == 0 {
+		assert.NotZero(t, ifi.Flags&net.FlagMulticast,
+			"non-loopback interface should support multicast")
+	}
+
+	got, err := ResolveMulticastInterface(
+		ma.StringCast("/ip4/228.8.8.8/udp/8822/multicast/*"))
+	require.NoError(t, err, "should resolve wildcard interface")
+	assert.Equal(t, ifi.Name, got.Name, "should resolve to default interface")
+}
+
+func TestDefaultInterface_select(t *testing.T) {
+	t.Parallel()
+
+	var (
+		down      = net.Interface{Name: "down", Flags: net.FlagMulticast}
+		lo        = net.Interface{Name: "lo", Flags: net.FlagUp | net.FlagLoopback}
+		noMcast   = net.Interface{Name: "wg0", Flags: net.FlagUp | net.FlagPointToPoint}
+		eth0      = net.Interface{Name: "eth0", Flags: net.FlagUp | net.FlagMulticast}
+		eth1      = net.Interface{Name: "eth1", Flags: net.FlagUp | net.FlagMulticast}
+		loMcast   = net.Interface{Name: "lo0", Flags: net.FlagUp | net.FlagLoopback | net.FlagMulticast}
+		downMcast = net.Interface{Name: "eth2", Flags: net.FlagMulticast | net.FlagBroadcast}
+	)
+
+	for _, tt := range []struct {
+		name   string
+		ifaces []net.Interface
+		want   string // empty if no interface should be found
+	}{
+		{"Empty", nil, ""},
+		{"Down", []net.Interface{down, downMcast}, ""},
+		{"LoopbackOnly", []net.Interface{lo}, "lo"},
+		{"NonMulticast", []net.Interface{noMcast}, ""},
+		{"NonMulticastWithLoopback", []net.Interface{noMcast, lo}, "lo"},
+		{"SkipDown", []net.Interface{downMcast, eth0}, "eth0"},
+		{"PreferNonLoopback", []net.Interface{lo, eth0}, "eth0"},
+		{"MulticastLoopback", []net.Interface{loMcast, eth0}, "eth0"},
+		{"FirstMulticastWins", []net.Interface{noMcast, eth0, eth1}, "eth0"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ifi, err := defaultInterface(tt.ifaces)
+			if tt.want == "" {
+				assert.Error(t, err, "should not find interface")
+				return
+			}
+
+			require.NoError(t, err, "should find interface")
+			assert.Equal(t, tt.want, ifi.Name, "should select %s", tt.want)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	ma "github.com/multiformats/go-multiaddr"
 	manet "github.com/multiformats/go-multiaddr/net"
 	"github.com/wetware/pkg/boot/crawl"
+	"github.com/wetware/pkg/boot/mdns"
 	"github.com/wetware/pkg/boot/socket"
 	"github.com/wetware/pkg/boot/survey"
 )
@@ -22,8 +23,20 @@ func DialString(h host.Host, s string, opt ...socket.Option) (Service, error) {
 	return Dial(h, maddr, opt...)
 }
 
+// DialStrings returns a Multi that queries each of the boot services
+// in ss concurrently.
+func DialStrings(h host.Host, ss []string, opt ...socket.Option) (Multi, error) {
+	return multi(h, ss, DialString, opt)
+}
+
 func Dial(h host.Host, maddr ma.Multiaddr, opt ...socket.Option) (Service, error) {
 	switch {
+	case IsDNSAddr(maddr):
+		return DNSAddr{Addr: maddr, Self: h.ID()}, nil
+
+	case IsFile(maddr):
+		return OpenFile(h, maddr)
+
 	case IsP2P(maddr):
 		return NewStaticAddrs(maddr)
 
@@ -44,6 +57,9 @@ func Dial(h host.Host, maddr ma.Multiaddr, opt ...socket.Option) (Service, error
 
 	case IsPortRange(maddr):
 		return DialPortRange(h, maddr, opt...)
+
+	case IsMDNS(maddr):
+		return mdns.New(h), nil
 	}
 
 	return nil, ErrUnknownBootProto
@@ -58,8 +74,20 @@ func ListenString(h host.Host, s string, opt ...socket.Option) (Service, error)
 	return Listen(h, maddr, opt...)
 }
 
+// ListenStrings returns a Multi that queries each of the boot services
+// in ss concurrently.
+func ListenStrings(h host.Host, ss []string, opt ...socket.Option) (Multi, error) {
+	return multi(h, ss, ListenString, opt)
+}
+
 func Listen(h host.Host, maddr ma.Multiaddr, opt ...socket.Option) (Service, error) {
 	switch {
+	case IsDNSAddr(maddr):
+		return DNSAddr{Addr: maddr, Self: h.ID()}, nil
+
+	case IsFile(maddr):
+		return OpenFile(h, maddr)
+
 	case IsCIDR(maddr):
 		return ListenCIDR(h, maddr, opt...)
 
@@ -74,11 +102,31 @@ func Listen(h host.Host, maddr ma.Multiaddr, opt ...socket.Option) (Service, err
 		}
 
 		return s, nil
+
+	case IsMDNS(maddr):
+		return mdns.New(h), nil
 	}
 
 	return nil, ErrUnknownBootProto
 }
 
+type serviceFunc func(host.Host, string, ...socket.Option) (Service, error)
+
+func multi(h host.Host, ss []string, newService serviceFunc, opt []socket.Option) (Multi, error) {
+	m := make(Multi, 0, len(ss))
+	for _, s := range ss {
+		d, err := newService(h, s, opt...)
+		if err != nil {
+			m.Close()
+			return nil, fmt.Errorf("%s: %w", s, err)
+		}
+
+		m = append(m, Source{Service: d})
+	}
+
+	return m, nil
+}
+
 func DialCIDR(h host.Host, maddr ma.Multiaddr, opt ...socket.Option) (*crawl.Crawler, error) {
 	return newCIDRCrawler(h, maddr, dial, opt)
 }
@@ -102,6 +150,17 @@ func DialMulticast(h host.Host, maddr ma.Multiaddr, opt ...socket.Option) (*surv
 	return survey.New(h, sock), nil
 }
 
+// OpenFile returns a File for the path in a /file multiaddr, e.g.
+// /file/etc/ww/peers.
+func OpenFile(h host.Host, maddr ma.Multiaddr) (*File, error) {
+	path, err := maddr.ValueForProtocol(P_FILE)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFile(h.ID(), path, DefaultPollInterval)
+}
+
 func DialPortRange(h host.Host, maddr ma.Multiaddr, opt ...socket.Option) (*crawl.Crawler, error) {
 	_, addr, err := manet.DialArgs(maddr)
 	if err != nil {
@@ -146,6 +205,18 @@ func IsSurvey(maddr ma.Multiaddr) bool {
 	return hasBootProto(maddr, survey.P_SURVEY)
 }
 
+func IsDNSAddr(maddr ma.Multiaddr) bool {
+	return hasBootProto(maddr, ma.P_DNSADDR)
+}
+
+func IsFile(maddr ma.Multiaddr) bool {
+	return hasBootProto(maddr, P_FILE)
+}
+
+func IsMDNS(maddr ma.Multiaddr) bool {
+	return hasBootProto(maddr, mdns.P_MDNS)
+}
+
 // IsPortRange returns true if maddr is a UDP address with no subprotocols.
 // This function MAY be extended to support port ranges ranges in the near
 // future.
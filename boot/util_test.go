@@ -40,6 +40,7 @@ func TestIsMulticast(t *testing.T) {
 		{name: "IPv6", addr: ma.StringCast("/ip6/2001:ff::/udp/8822/multicast/lo0"), want: true},
 		{name: "IPv4/Survey", addr: ma.StringCast("/ip4/10.0.1.0/udp/8822/multicast/lo0/survey"), want: true},
 		{name: "IPv6/Survey", addr: ma.StringCast("/ip6/2001:ff::/udp/8822/multicast/lo0/survey"), want: true},
+		{name: "Wildcard", addr: ma.StringCast("/ip4/228.8.8.8/udp/8822/multicast/*"), want: true},
 		{name: "Fail", addr: ma.StringCast("/ip6/2001:ff::/udp/8822"), want: false},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
@@ -86,3 +87,21 @@ func TestIsPortRange(t *testing.T) {
 		})
 	}
 }
+
+func TestIsMDNS(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	for _, tt := range []struct {
+		name string
+		addr ma.Multiaddr
+		want bool
+	}{
+		{name: "MDNS", addr: ma.StringCast("/mdns"), want: true},
+		{name: "Fail", addr: ma.StringCast("/ip4/228.8.8.8/udp/8822/multicast/lo0"), want: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, boot.IsMDNS(tt.addr))
+		})
+	}
+}
@@ -66,6 +66,17 @@ func (n *Node) Child(name string) *Node {
 	return n.children[name]
 }
 
+// Path returns the location of the node, relative to the root of
+// its tree.
+func (n *Node) Path() Path {
+	var parts []string
+	for ; n.parent != nil; n = n.parent {
+		parts = append([]string{n.name}, parts...)
+	}
+
+	return JoinPath(parts)
+}
+
 func (n *Node) Anchor() Anchor {
 	n.Lock()
 	defer n.Unlock()
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	api "github.com/wetware/pkg/api/anchor"
+	capstore_api "github.com/wetware/pkg/api/capstore"
 )
 
 type server struct{ *Node }
@@ -82,6 +83,12 @@ func (s server) Cell(ctx context.Context, call api.Anchor_cell) error {
 	return errors.New("NOT IMPLEMENTED") // TODO(soon): implement Anchor.Cell()
 }
 
+// SturdyRef saves the anchor as a path, relative to the root of its
+// tree.  It allows anchors to be persisted in a CapStore.
+func (s server) SturdyRef(ref capstore_api.SturdyRef) error {
+	return ref.SetAnchor(s.Path().String())
+}
+
 func anchor(n interface{ Anchor() Anchor }) api.Anchor {
 	return api.Anchor(n.Anchor())
 }
@@ -2,9 +2,12 @@ package capstore
 
 import (
 	"context"
+	"time"
 
 	"capnproto.org/go/capnp/v3"
+
 	api "github.com/wetware/pkg/api/capstore"
+	"github.com/wetware/pkg/util/casm"
 )
 
 type CapStore api.CapStore
@@ -17,18 +20,27 @@ func (c CapStore) Release() {
 	api.CapStore(c).Release()
 }
 
-func (c CapStore) Set(ctx context.Context, id string, cap capnp.Client) error {
+// Set stores cap under id.  If ttl is nonzero, the entry expires after
+// ttl unless the returned lease is renewed.  Entries holding a live
+// capability are released along with the lease, so callers MUST hold
+// onto the lease for as long as the entry is needed.
+func (c CapStore) Set(ctx context.Context, id string, cap capnp.Client, ttl time.Duration) (Lease, error) {
 	f, release := api.CapStore(c).Set(ctx, func(cs api.CapStore_set_Params) error {
 		if err := cs.SetId(id); err != nil {
 			return err
 		}
+		cs.SetTtl(milliseconds(ttl))
 		return cs.SetCap(cap.AddRef())
 	})
 	defer release()
 
 	<-f.Done()
-	_, err := f.Struct()
-	return err
+	res, err := f.Struct()
+	if err != nil {
+		return Lease{}, err
+	}
+
+	return Lease(res.Lease().AddRef()), nil
 }
 
 func (c CapStore) Get(ctx context.Context, id string) (capnp.Client, error) {
@@ -45,3 +57,160 @@ func (c CapStore) Get(ctx context.Context, id string) (capnp.Client, error) {
 
 	return res.Cap().AddRef(), nil
 }
+
+func (c CapStore) Delete(ctx context.Context, id string) error {
+	f, release := api.CapStore(c).Delete(ctx, func(cs api.CapStore_delete_Params) error {
+		return cs.SetId(id)
+	})
+	defer release()
+
+	<-f.Done()
+	_, err := f.Struct()
+	return err
+}
+
+func (c CapStore) List(ctx context.Context) ([]string, error) {
+	f, release := api.CapStore(c).List(ctx, nil)
+	defer release()
+
+	<-f.Done()
+	res, err := f.Struct()
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := res.Ids()
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]string, ids.Len())
+	for i := range out {
+		if out[i], err = ids.At(i); err != nil {
+			break
+		}
+	}
+
+	return out, err
+}
+
+// Watch the entry stored under id.  Callers MUST call the provided
+// ReleaseFunc when finished with the watcher.
+func (c CapStore) Watch(ctx context.Context, id string) (Watcher, capnp.ReleaseFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	var (
+		h          = make(handler, 16)
+		f, release = api.CapStore(c).Watch(ctx, h.Params(id))
+	)
+
+	return Watcher{
+		Future: casm.Future(f),
+		Seq:    h,
+	}, func() {
+		cancel()
+		release()
+	}
+}
+
+// Lease controls the lifetime of a CapStore entry.
+type Lease api.CapStore_Lease
+
+func (l Lease) AddRef() Lease {
+	return Lease(api.CapStore_Lease(l).AddRef())
+}
+
+func (l Lease) Release() {
+	api.CapStore_Lease(l).Release()
+}
+
+// Renew resets the lease's time-to-live.  A zero ttl disables expiry.
+func (l Lease) Renew(ctx context.Context, ttl time.Duration) error {
+	f, release := api.CapStore_Lease(l).Renew(ctx, func(ps api.CapStore_Lease_renew_Params) error {
+		ps.SetTtl(milliseconds(ttl))
+		return nil
+	})
+	defer release()
+
+	_, err := f.Struct()
+	return err
+}
+
+// Cancel the lease, releasing the entry immediately.
+func (l Lease) Cancel(ctx context.Context) error {
+	f, release := api.CapStore_Lease(l).Cancel(ctx, nil)
+	defer release()
+
+	_, err := f.Struct()
+	return err
+}
+
+// Event reports a change to a CapStore entry.
+type Event struct {
+	ID   string
+	Type api.CapStore_Event_Which
+}
+
+// Watcher is a stateful iterator over a stream of events.
+type Watcher casm.Iterator[Event]
+
+// Next blocks until the next event is received, and returns it.  The
+// second return value is false when the watcher is exhausted.
+func (w Watcher) Next() (Event, bool) {
+	return casm.Iterator[Event](w).Next()
+}
+
+// Err returns the first non-nil error encountered by the watcher.
+func (w Watcher) Err() error {
+	return casm.Iterator[Event](w).Err()
+}
+
+type handler chan Event
+
+func (ch handler) Params(id string) func(api.CapStore_watch_Params) error {
+	return func(ps api.CapStore_watch_Params) error {
+		if err := ps.SetId(id); err != nil {
+			return err
+		}
+
+		return ps.SetHandler(api.CapStore_Handler_ServerToClient(ch))
+	}
+}
+
+func (ch handler) Shutdown() { close(ch) }
+
+func (ch handler) Next() (ev Event, ok bool) {
+	ev, ok = <-ch
+	return
+}
+
+func (ch handler) Recv(ctx context.Context, call api.CapStore_Handler_recv) error {
+	e, err := call.Args().Event()
+	if err != nil {
+		return err
+	}
+
+	id, err := e.Id()
+	if err != nil {
+		return err
+	}
+
+	select {
+	case ch <- Event{ID: id, Type: e.Which()}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func milliseconds(d time.Duration) uint32 {
+	return uint32(d / time.Millisecond)
+}
+
+// Persistent is implemented by host-local capability servers that can
+// be saved to a CapStore as a sturdy reference.  Sturdy references are
+// re-resolved by the host on each call to Get, allowing them to survive
+// a restart.
+type Persistent interface {
+	SturdyRef(api.SturdyRef) error
+}
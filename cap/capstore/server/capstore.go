@@ -2,59 +2,839 @@ package capstore_server
 
 import (
 	"context"
+	"encoding/base32"
+	"errors"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/server"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/libp2p/go-libp2p/core/peer"
+
 	api "github.com/wetware/pkg/api/capstore"
 	"github.com/wetware/pkg/cap/capstore"
 	"github.com/wetware/pkg/util/log"
 )
 
+const (
+	// DefaultLimit is the maximum number of entries an account can
+	// hold when CapStore.Limit is zero.
+	DefaultLimit = 1024
+
+	// DefaultSweepInterval is the period at which broken capabilities
+	// are evicted when CapStore.SweepInterval is zero.
+	DefaultSweepInterval = time.Second * 10
+)
+
+var (
+	ErrNotFound = errors.New("not found")
+	ErrFull     = errors.New("namespace full")
+)
+
+// Restorer resolves sturdy references into live capabilities.
+type Restorer interface {
+	Restore(context.Context, api.SturdyRef) (capnp.Client, error)
+}
+
+// CapStore maps strings to capabilities.  Each account is given its
+// own namespace, so that entries set by one account can be neither
+// read nor overwritten by another.
+//
+// Host-local capabilities that implement capstore.Persistent are saved
+// to the Datastore as sturdy references, and are resolved on each call
+// to get using the Restorer.  All other capabilities are held in memory,
+// and are lost when the host restarts.
+//
+// Each call to set returns a lease.  Entries are released when their
+// lease expires or is canceled.  Entries holding a live capability are
+// also released when the lease capability is released.  The lease is
+// exported over the same connection as the capability it guards, so a
+// remote capability is evicted as soon as that connection shuts down.
+// Capabilities that break for any other reason, such as a promise that
+// resolves to an error, are evicted by a periodic sweep.  The expiry of
+// a sturdy reference is persisted alongside it, so that it is enforced
+// after a restart.
 type CapStore struct {
-	// TODO limit map size
-	*sync.Map
 	log.Logger
+
+	// Datastore persists sturdy references.  If nil, an in-memory
+	// datastore is used.
+	Datastore ds.Datastore
+
+	// Restorer resolves sturdy references.  If nil, get fails for
+	// entries that were stored as sturdy references.
+	Restorer Restorer
+
+	// Limit is the maximum number of entries per account.  If zero,
+	// DefaultLimit is used.
+	Limit int
+
+	// SweepInterval is the period at which broken capabilities are
+	// evicted.  If zero, DefaultSweepInterval is used.
+	SweepInterval time.Duration
+
+	once, closing sync.Once
+	stop          chan struct{}
+
+	mu       sync.Mutex
+	entries  map[ds.Key]*entry
+	watchers map[ds.Key]map[watcher]struct{}
 }
 
-func (c *CapStore) CapStore() capstore.CapStore {
-	return capstore.CapStore(api.CapStore_ServerToClient(c))
+func (c *CapStore) setup() {
+	c.once.Do(func() {
+		if c.Datastore == nil {
+			c.Datastore = ds_sync.MutexWrap(ds.NewMapDatastore())
+		}
+
+		c.entries = make(map[ds.Key]*entry)
+		c.watchers = make(map[ds.Key]map[watcher]struct{})
+
+		c.stop = make(chan struct{})
+		go c.sweep(c.stop)
+	})
 }
 
-func (c *CapStore) Set(ctx context.Context, call api.CapStore_set) error {
-	id, err := call.Args().Id()
+// CapStore returns a capability granting access to the namespace of
+// the specified account.
+func (c *CapStore) CapStore(owner peer.ID) capstore.CapStore {
+	c.setup()
+
+	return capstore.CapStore(api.CapStore_ServerToClient(namespace{
+		CapStore: c,
+		prefix:   ds.NewKey(owner.String()),
+	}))
+}
+
+// Close releases all capabilities held in memory.  Sturdy references
+// are left in the datastore.
+func (c *CapStore) Close() error {
+	c.setup()
+	c.closing.Do(func() { close(c.stop) })
+
+	c.mu.Lock()
+	entries := c.entries
+	c.entries = make(map[ds.Key]*entry)
+	c.mu.Unlock()
+
+	for _, e := range entries {
+		e.stop()
+		e.client.Release()
+	}
+
+	return nil
+}
+
+func (c *CapStore) limit() int {
+	if c.Limit > 0 {
+		return c.Limit
+	}
+
+	return DefaultLimit
+}
+
+func (c *CapStore) sweepInterval() time.Duration {
+	if c.SweepInterval > 0 {
+		return c.SweepInterval
+	}
+
+	return DefaultSweepInterval
+}
+
+// sweep evicts broken capabilities until stop is closed.
+func (c *CapStore) sweep(stop <-chan struct{}) {
+	ticker := time.NewTicker(c.sweepInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.evictBroken()
+		case <-stop:
+			return
+		}
+	}
+}
+
+// evictBroken removes every live entry whose capability is broken.
+func (c *CapStore) evictBroken() {
+	var releases []capnp.ReleaseFunc
+	defer func() {
+		for _, release := range releases {
+			release() // MUST NOT hold the lock
+		}
+	}()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, e := range c.entries {
+		if err := broken(e.client); err != nil {
+			c.Logger.Info("evicted broken capability",
+				"key", key,
+				"reason", err)
+			releases = append(releases, c.remove(e, api.CapStore_Event_Which_expire))
+		}
+	}
+}
+
+// namespace is a view of the CapStore that is limited to the entries
+// of a single account.
+type namespace struct {
+	*CapStore
+	prefix ds.Key
+}
+
+func (ns namespace) Set(ctx context.Context, call api.CapStore_set) error {
+	key, err := ns.key(call.Args())
 	if err != nil {
 		return err
 	}
 
 	cap := call.Args().Cap()
-	c.Logger.Info("set capability", "id", id)
+	if err := cap.Resolve(ctx); err != nil {
+		return err
+	}
+
+	ns.Logger.Info("set capability", "key", key)
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	e, err := ns.set(ctx, key, cap, ttl(call.Args().Ttl()))
+	if err != nil {
+		return err
+	}
+
+	return res.SetLease(api.CapStore_Lease_ServerToClient(lease{
+		CapStore: ns.CapStore,
+		entry:    e,
+	}))
+}
+
+func (ns namespace) set(ctx context.Context, key ds.Key, cap capnp.Client, d time.Duration) (*entry, error) {
+	var release capnp.ReleaseFunc
+	defer func() {
+		if release != nil {
+			release() // MUST NOT hold the lock
+		}
+	}()
+
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	if err := ns.reserve(ctx, key); err != nil {
+		return nil, err
+	}
+
+	// Host-local capabilities are saved as a sturdy reference, which
+	// replaces any live capability previously stored under the key.
+	e := &entry{key: key}
+	if rec, ok, err := sturdyRecord(cap, deadline(d)); err != nil {
+		return nil, err
+	} else if ok {
+		err = ns.Datastore.Put(ctx, key, rec)
+		if err != nil {
+			return nil, err
+		}
+	} else if err = ns.Datastore.Delete(ctx, key); err != nil {
+		return nil, err
+	} else {
+		e.client = cap.AddRef()
+	}
+
+	if old, ok := ns.entries[key]; ok {
+		old.stop()
+		release = old.client.Release
+	}
+
+	ns.entries[key] = e
+	ns.setTTL(e, d)
+	ns.notify(key, api.CapStore_Event_Which_set)
+	return e, nil
+}
+
+func (ns namespace) Get(ctx context.Context, call api.CapStore_get) error {
+	key, err := ns.key(call.Args())
+	if err != nil {
+		return err
+	}
+
+	ns.Logger.Info("get capability", "key", key)
 
-	c.Map.Store(id, cap.AddRef())
+	cap, err := ns.load(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer cap.Release()
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	return res.SetCap(cap.AddRef())
+}
+
+func (ns namespace) Delete(ctx context.Context, call api.CapStore_delete) error {
+	key, err := ns.key(call.Args())
+	if err != nil {
+		return err
+	}
+
+	ns.Logger.Info("delete capability", "key", key)
+
+	// Fast path; the entry is leased.
+	ns.mu.Lock()
+	if e, ok := ns.entries[key]; ok {
+		release := ns.remove(e, api.CapStore_Event_Which_delete)
+		ns.mu.Unlock()
+		release()
+		return nil
+	}
+	defer ns.mu.Unlock()
+
+	// Slow path; the entry is a sturdy ref from a previous run.
+	if _, err := ns.record(ctx, key); err != nil {
+		return err
+	}
+
+	if err := ns.Datastore.Delete(ctx, key); err != nil {
+		return err
+	}
+
+	ns.notify(key, api.CapStore_Event_Which_delete)
 	return nil
 }
 
-func (c *CapStore) Get(ctx context.Context, call api.CapStore_get) error {
+func (ns namespace) List(ctx context.Context, call api.CapStore_list) error {
+	ns.mu.Lock()
+	keys, err := ns.keys(ctx)
+	ns.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		id, err := decode(key.Name())
+		if err != nil {
+			return err
+		}
+
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	list, err := res.NewIds(int32(len(ids)))
+	if err != nil {
+		return err
+	}
+
+	for i, id := range ids {
+		if err = list.Set(i, id); err != nil {
+			break
+		}
+	}
+
+	return err
+}
+
+func (ns namespace) Watch(ctx context.Context, call api.CapStore_watch) error {
+	key, err := ns.key(call.Args())
+	if err != nil {
+		return err
+	}
+
 	id, err := call.Args().Id()
 	if err != nil {
 		return err
 	}
 
-	c.Logger.Info("get capability", "id", id)
-	v, ok := c.Map.Load(id)
+	var (
+		w       = make(watcher, 32)
+		handler = call.Args().Handler()
+	)
+
+	ns.mu.Lock()
+	if ns.watchers[key] == nil {
+		ns.watchers[key] = make(map[watcher]struct{})
+	}
+	ns.watchers[key][w] = struct{}{}
+	ns.mu.Unlock()
+
+	defer func() {
+		ns.mu.Lock()
+		defer ns.mu.Unlock()
+
+		if delete(ns.watchers[key], w); len(ns.watchers[key]) == 0 {
+			delete(ns.watchers, key)
+		}
+	}()
+
+	for call.Go(); ctx.Err() == nil; {
+		select {
+		case which := <-w:
+			err = handler.Recv(ctx, event(id, which))
+		case <-ctx.Done():
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return handler.WaitStreaming()
+}
+
+type idParam interface {
+	Id() (string, error)
+}
+
+func (ns namespace) key(args idParam) (ds.Key, error) {
+	id, err := args.Id()
+	if err != nil {
+		return ds.Key{}, err
+	}
+
+	if id == "" {
+		return ds.Key{}, errors.New("empty id")
+	}
+
+	// Ids are encoded so that they cannot escape the namespace, e.g.
+	// by containing path separators.
+	return ns.prefix.ChildString(encode(id)), nil
+}
+
+// load returns the capability stored under key.  The caller MUST
+// release the returned capability.
+func (ns namespace) load(ctx context.Context, key ds.Key) (capnp.Client, error) {
+	ns.mu.Lock()
+	if e, ok := ns.entries[key]; ok && e.client.IsValid() {
+		// Don't hand out a capability that the sweep has yet to evict.
+		if err := broken(e.client); err != nil {
+			ns.mu.Unlock()
+			ns.Logger.Info("evicted broken capability",
+				"key", key,
+				"reason", err)
+			ns.expire(e, api.CapStore_Event_Which_expire)
+			return capnp.Client{}, fmt.Errorf("%s: %w", key, ErrNotFound)
+		}
+
+		client := e.client.AddRef()
+		ns.mu.Unlock()
+		return client, nil
+	}
+
+	rec, err := ns.record(ctx, key)
+	ns.mu.Unlock()
+	if err != nil {
+		return capnp.Client{}, err
+	}
+
+	if ns.Restorer == nil {
+		return capnp.Client{}, errors.New("sturdy refs not supported")
+	}
+
+	ref, err := rec.Ref()
+	if err != nil {
+		return capnp.Client{}, err
+	}
+
+	return ns.Restorer.Restore(ctx, ref)
+}
+
+// record returns the persistent record stored under key.  Expired
+// records are purged, and reported as not found.  Callers MUST hold
+// the lock.
+func (ns namespace) record(ctx context.Context, key ds.Key) (api.Record, error) {
+	b, err := ns.Datastore.Get(ctx, key)
+	if errors.Is(err, ds.ErrNotFound) {
+		return api.Record{}, fmt.Errorf("%s: %w", key, ErrNotFound)
+	} else if err != nil {
+		return api.Record{}, err
+	}
+
+	rec, err := readRecord(b)
+	if err != nil {
+		return api.Record{}, err
+	}
+
+	if expired(rec, time.Now()) {
+		ns.purge(ctx, key)
+		return api.Record{}, fmt.Errorf("%s: %w", key, ErrNotFound)
+	}
+
+	return rec, nil
+}
+
+// purge an expired sturdy ref from the store.  Callers MUST hold the
+// lock.
+func (c *CapStore) purge(ctx context.Context, key ds.Key) {
+	if e, ok := c.entries[key]; ok {
+		c.remove(e, api.CapStore_Event_Which_expire)() // no client to release
+		return
+	}
+
+	if err := c.Datastore.Delete(ctx, key); err != nil {
+		c.Logger.Error("failed to delete sturdy ref",
+			"key", key,
+			"error", err)
+	}
+
+	c.notify(key, api.CapStore_Event_Which_expire)
+}
+
+// reserve ensures there is room in the namespace for key.  Callers
+// MUST hold the lock.
+func (ns namespace) reserve(ctx context.Context, key ds.Key) error {
+	keys, err := ns.keys(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		if k == key {
+			return nil // overwrite
+		}
+	}
+
+	if len(keys) >= ns.limit() {
+		return fmt.Errorf("%s: %w", ns.prefix, ErrFull)
+	}
+
+	return nil
+}
+
+// keys returns the keys in the namespace.  Callers MUST hold the lock.
+func (ns namespace) keys(ctx context.Context) ([]ds.Key, error) {
+	rs, err := ns.Datastore.Query(ctx, query.Query{
+		Prefix: ns.prefix.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var keys, stale []ds.Key
+	for r := range rs.Next() {
+		if r.Error != nil {
+			rs.Close()
+			return nil, r.Error
+		}
+
+		rec, err := readRecord(r.Value)
+		if err != nil {
+			rs.Close()
+			return nil, err
+		}
+
+		if expired(rec, time.Now()) {
+			stale = append(stale, ds.RawKey(r.Key))
+		} else {
+			keys = append(keys, ds.RawKey(r.Key))
+		}
+	}
+	rs.Close()
+
+	for _, key := range stale {
+		ns.purge(ctx, key)
+	}
+
+	// Live entries are not in the datastore.
+	for key, e := range ns.entries {
+		if e.client.IsValid() && ns.prefix.IsAncestorOf(key) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys, nil
+}
+
+// entry is a leased value in the CapStore.  Live entries hold a client
+// in memory.  Sturdy entries have a null client, and are stored in the
+// datastore.
+type entry struct {
+	key    ds.Key
+	client capnp.Client
+	timer  *time.Timer
+	gen    uint64 // incremented each time the timer is reset
+}
+
+func (e *entry) stop() {
+	if e.timer != nil {
+		e.timer.Stop()
+	}
+}
+
+// setTTL arms the entry's expiry timer.  A zero ttl disables expiry.
+// Callers MUST hold the lock.
+func (c *CapStore) setTTL(e *entry, d time.Duration) {
+	e.stop()
+	e.gen++
+
+	// Stopping the timer does not prevent a callback that has already
+	// fired from running, so the callback checks that the entry has not
+	// been renewed in the meantime.
+	if gen := e.gen; d > 0 {
+		e.timer = time.AfterFunc(d, func() {
+			c.timeout(e, gen)
+		})
+	}
+}
+
+// timeout expires e, unless it was renewed after the timer was armed.
+func (c *CapStore) timeout(e *entry, gen uint64) {
+	c.mu.Lock()
+	if c.entries[e.key] != e || e.gen != gen {
+		c.mu.Unlock()
+		return
+	}
+
+	release := c.remove(e, api.CapStore_Event_Which_expire)
+	c.mu.Unlock()
+
+	release()
+}
+
+// expire removes e from the store, if it is still current.  It reports
+// false if e has already been replaced or removed.
+func (c *CapStore) expire(e *entry, which api.CapStore_Event_Which) bool {
+	c.mu.Lock()
+	if c.entries[e.key] != e {
+		c.mu.Unlock()
+		return false
+	}
+
+	release := c.remove(e, which)
+	c.mu.Unlock()
+
+	release()
+	return true
+}
+
+// remove e from the store and notify watchers.  Callers MUST hold the
+// lock, and MUST call the returned ReleaseFunc after releasing it.
+func (c *CapStore) remove(e *entry, which api.CapStore_Event_Which) capnp.ReleaseFunc {
+	e.stop()
+	delete(c.entries, e.key)
+
+	if !e.client.IsValid() {
+		if err := c.Datastore.Delete(context.TODO(), e.key); err != nil {
+			c.Logger.Error("failed to delete sturdy ref",
+				"key", e.key,
+				"error", err)
+		}
+	}
+
+	c.notify(e.key, which)
+	return e.client.Release
+}
+
+// notify watchers that the entry stored under key has changed.  Events
+// are dropped if a watcher's buffer is full.  Callers MUST hold the lock.
+func (c *CapStore) notify(key ds.Key, which api.CapStore_Event_Which) {
+	for w := range c.watchers[key] {
+		select {
+		case w <- which:
+		default:
+			c.Logger.Warn("dropped capstore event",
+				"key", key,
+				"event", which)
+		}
+	}
+}
+
+type watcher chan api.CapStore_Event_Which
+
+func event(id string, which api.CapStore_Event_Which) func(api.CapStore_Handler_recv_Params) error {
+	return func(ps api.CapStore_Handler_recv_Params) error {
+		ev, err := ps.NewEvent()
+		if err != nil {
+			return err
+		}
+
+		switch which {
+		case api.CapStore_Event_Which_set:
+			ev.SetSet()
+		case api.CapStore_Event_Which_delete:
+			ev.SetDelete()
+		default:
+			ev.SetExpire()
+		}
+
+		return ev.SetId(id)
+	}
+}
+
+// lease controls the lifetime of an entry.
+type lease struct {
+	*CapStore
+	*entry
+}
+
+// Shutdown is called when the last reference to the lease is released.
+// Live entries are released along with their lease.  Sturdy entries
+// remain until they expire or are deleted.
+func (l lease) Shutdown() {
+	if l.entry.client.IsValid() {
+		l.expire(l.entry, api.CapStore_Event_Which_expire)
+	}
+}
+
+func (l lease) Renew(ctx context.Context, call api.CapStore_Lease_renew) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.entries[l.key] != l.entry {
+		return errors.New("lease expired")
+	}
+
+	d := ttl(call.Args().Ttl())
+	if !l.entry.client.IsValid() {
+		if err := l.setDeadline(ctx, l.key, deadline(d)); err != nil {
+			return err
+		}
+	}
+
+	l.setTTL(l.entry, d)
+	return nil
+}
+
+// setDeadline updates the persisted expiry of the sturdy ref stored
+// under key.  Callers MUST hold the lock.
+func (c *CapStore) setDeadline(ctx context.Context, key ds.Key, t time.Time) error {
+	b, err := c.Datastore.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	rec, err := readRecord(b)
+	if err != nil {
+		return err
+	}
+
+	rec.SetExpires(unixNano(t))
+
+	if b, err = rec.Message().Marshal(); err == nil {
+		err = c.Datastore.Put(ctx, key, b)
+	}
+
+	return err
+}
+
+func (l lease) Cancel(ctx context.Context, call api.CapStore_Lease_cancel) error {
+	if !l.expire(l.entry, api.CapStore_Event_Which_delete) {
+		return errors.New("lease expired")
+	}
+
+	return nil
+}
+
+func ttl(ms uint32) time.Duration {
+	return time.Duration(ms) * time.Millisecond
+}
+
+// sturdyRecord returns the encoded record for cap, if cap is served by
+// a host-local capstore.Persistent implementation.  The record expires
+// at the supplied time.  The zero time means the record never expires.
+func sturdyRecord(cap capnp.Client, expires time.Time) ([]byte, bool, error) {
+	impl, ok := server.IsServer(cap.State().Brand)
 	if !ok {
-		return fmt.Errorf("capability with id '%s' not found", id)
+		return nil, false, nil
 	}
 
-	cap, ok := v.(capnp.Client)
+	p, ok := impl.(capstore.Persistent)
 	if !ok {
-		return fmt.Errorf("capability with id '%s' not found", id)
+		return nil, false, nil
 	}
 
-	res, err := call.AllocResults()
+	msg, seg := capnp.NewSingleSegmentMessage(nil)
+	defer msg.Release()
+
+	rec, err := api.NewRootRecord(seg)
 	if err != nil {
-		return err
+		return nil, false, err
 	}
+	rec.SetExpires(unixNano(expires))
 
-	return res.SetCap(cap.AddRef())
+	ref, err := rec.NewRef()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err = p.SturdyRef(ref); err != nil {
+		return nil, false, err
+	}
+
+	b, err := msg.Marshal()
+	return b, err == nil, err
+}
+
+func readRecord(b []byte) (api.Record, error) {
+	msg, err := capnp.Unmarshal(b)
+	if err != nil {
+		return api.Record{}, err
+	}
+
+	return api.ReadRootRecord(msg)
+}
+
+func expired(rec api.Record, now time.Time) bool {
+	return rec.Expires() != 0 && rec.Expires() <= now.UnixNano()
+}
+
+// deadline returns the time at which a ttl of d expires.  A zero ttl
+// never expires, which is represented by the zero time.
+func deadline(d time.Duration) time.Time {
+	if d > 0 {
+		return time.Now().Add(d)
+	}
+
+	return time.Time{}
+}
+
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixNano()
+}
+
+// broken returns the error to which a live capability has resolved,
+// or nil if it is unbroken.  Null clients are sturdy entries, and are
+// never broken.
+func broken(c capnp.Client) error {
+	if !c.IsValid() {
+		return nil
+	}
+
+	if err, ok := c.State().Brand.Value.(error); ok {
+		return err // capnp.ErrorClient, or a promise that failed
+	}
+
+	return nil
+}
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func encode(id string) string {
+	return encoding.EncodeToString([]byte(id))
+}
+
+func decode(name string) (string, error) {
+	b, err := encoding.DecodeString(name)
+	return string(b), err
 }
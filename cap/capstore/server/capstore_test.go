@@ -0,0 +1,445 @@
+package capstore_server_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/wetware/pkg/api/capstore"
+	"github.com/wetware/pkg/cap/anchor"
+	"github.com/wetware/pkg/cap/capstore"
+	capstore_server "github.com/wetware/pkg/cap/capstore/server"
+)
+
+const (
+	alice = peer.ID("alice")
+	bob   = peer.ID("bob")
+)
+
+func TestNamespace(t *testing.T) {
+	t.Parallel()
+
+	store := &capstore_server.CapStore{Logger: slog.Default()}
+	defer store.Close()
+
+	a := store.CapStore(alice)
+	defer a.Release()
+
+	b := store.CapStore(bob)
+	defer b.Release()
+
+	client := newClient()
+	defer client.Release()
+
+	lease, err := a.Set(context.Background(), "foo", client, 0)
+	require.NoError(t, err, "should set capability")
+	defer lease.Release()
+
+	_, err = b.Get(context.Background(), "foo")
+	require.Error(t, err, "should not see other account's entries")
+
+	lease, err = b.Set(context.Background(), "foo", client, 0)
+	require.NoError(t, err, "should set capability in own namespace")
+	defer lease.Release()
+
+	err = b.Delete(context.Background(), "foo")
+	require.NoError(t, err, "should delete capability")
+
+	got, err := a.Get(context.Background(), "foo")
+	require.NoError(t, err, "other account's delete should not affect entry")
+	defer got.Release()
+	assert.True(t, got.IsSame(client), "should return stored capability")
+
+	ids, err := a.List(context.Background())
+	require.NoError(t, err, "should list ids")
+	assert.Equal(t, []string{"foo"}, ids)
+
+	ids, err = b.List(context.Background())
+	require.NoError(t, err, "should list ids")
+	assert.Empty(t, ids)
+}
+
+func TestLimit(t *testing.T) {
+	t.Parallel()
+
+	store := &capstore_server.CapStore{
+		Logger: slog.Default(),
+		Limit:  2,
+	}
+	defer store.Close()
+
+	a := store.CapStore(alice)
+	defer a.Release()
+
+	client := newClient()
+	defer client.Release()
+
+	set := func(id string) error {
+		lease, err := a.Set(context.Background(), id, client, 0)
+		t.Cleanup(lease.Release)
+		return err
+	}
+
+	require.NoError(t, set("foo"))
+	require.NoError(t, set("bar"))
+	require.NoError(t, set("bar"),
+		"should allow overwrite when namespace is full")
+
+	err := set("baz")
+	require.Error(t, err, "should fail when namespace is full")
+
+	require.NoError(t, a.Delete(context.Background(), "foo"))
+	require.NoError(t, set("baz"),
+		"should succeed after delete")
+
+	err = a.Delete(context.Background(), "foo")
+	assert.Error(t, err, "should fail to delete missing entry")
+}
+
+func TestSturdyRef(t *testing.T) {
+	t.Parallel()
+
+	var (
+		root = new(anchor.Node)
+		data = ds_sync.MutexWrap(ds.NewMapDatastore())
+	)
+
+	// Store an anchor, then drop all live state to simulate a restart.
+	store := &capstore_server.CapStore{
+		Logger:    slog.Default(),
+		Datastore: data,
+	}
+
+	a := store.CapStore(alice)
+	foo := root.Child("foo").Child("bar").Anchor()
+	lease, err := a.Set(context.Background(), "anchor", capnp.Client(foo), 0)
+	require.NoError(t, err, "should set anchor")
+	lease.Release() // sturdy refs outlive the lease
+	foo.Release()
+	a.Release()
+	store.Close()
+
+	restored := &capstore_server.CapStore{
+		Logger:    slog.Default(),
+		Datastore: data,
+		Restorer:  restorer{root},
+	}
+	defer restored.Close()
+
+	a = restored.CapStore(alice)
+	defer a.Release()
+
+	ids, err := a.List(context.Background())
+	require.NoError(t, err, "should list ids")
+	assert.Equal(t, []string{"anchor"}, ids)
+
+	got, err := a.Get(context.Background(), "anchor")
+	require.NoError(t, err, "should restore anchor")
+	defer got.Release()
+
+	it, release := anchor.Anchor(got).Ls(context.Background())
+	defer release()
+	assert.Empty(t, it.Next(), "restored anchor should have no children")
+	assert.NoError(t, it.Err())
+}
+
+func TestSturdyRef_expire(t *testing.T) {
+	t.Parallel()
+
+	var (
+		root = new(anchor.Node)
+		data = ds_sync.MutexWrap(ds.NewMapDatastore())
+	)
+
+	store := &capstore_server.CapStore{
+		Logger:    slog.Default(),
+		Datastore: data,
+	}
+
+	a := store.CapStore(alice)
+	foo := root.Child("foo").Anchor()
+	lease, err := a.Set(context.Background(), "anchor", capnp.Client(foo), time.Millisecond*10)
+	require.NoError(t, err, "should set anchor")
+	lease.Release()
+	foo.Release()
+	a.Release()
+	store.Close() // stops the in-memory timer
+
+	time.Sleep(time.Millisecond * 20)
+
+	restored := &capstore_server.CapStore{
+		Logger:    slog.Default(),
+		Datastore: data,
+		Restorer:  restorer{root},
+	}
+	defer restored.Close()
+
+	a = restored.CapStore(alice)
+	defer a.Release()
+
+	ids, err := a.List(context.Background())
+	require.NoError(t, err, "should list ids")
+	assert.Empty(t, ids, "expired sturdy ref should not be listed")
+
+	_, err = a.Get(context.Background(), "anchor")
+	assert.ErrorIs(t, err, capstore_server.ErrNotFound,
+		"expired sturdy ref should not be restored")
+
+	rs, err := data.Query(context.Background(), query.Query{KeysOnly: true})
+	require.NoError(t, err)
+	es, err := rs.Rest()
+	require.NoError(t, err)
+	assert.Empty(t, es, "expired sturdy ref should be purged")
+}
+
+func TestLease(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Expire", func(t *testing.T) {
+		t.Parallel()
+
+		store := &capstore_server.CapStore{Logger: slog.Default()}
+		defer store.Close()
+
+		a := store.CapStore(alice)
+		defer a.Release()
+
+		client := newClient()
+		defer client.Release()
+
+		lease, err := a.Set(context.Background(), "foo", client, time.Millisecond*10)
+		require.NoError(t, err, "should set capability")
+		defer lease.Release()
+
+		assert.Eventually(t, func() bool {
+			_, err := a.Get(context.Background(), "foo")
+			return err != nil
+		}, time.Second, time.Millisecond*10, "entry should expire")
+
+		err = lease.Renew(context.Background(), time.Second)
+		assert.Error(t, err, "should not renew expired lease")
+	})
+
+	t.Run("Renew", func(t *testing.T) {
+		t.Parallel()
+
+		store := &capstore_server.CapStore{Logger: slog.Default()}
+		defer store.Close()
+
+		a := store.CapStore(alice)
+		defer a.Release()
+
+		client := newClient()
+		defer client.Release()
+
+		lease, err := a.Set(context.Background(), "foo", client, time.Millisecond*50)
+		require.NoError(t, err, "should set capability")
+		defer lease.Release()
+
+		err = lease.Renew(context.Background(), 0)
+		require.NoError(t, err, "should disable expiry")
+
+		time.Sleep(time.Millisecond * 100)
+		got, err := a.Get(context.Background(), "foo")
+		require.NoError(t, err, "renewed entry should not expire")
+		got.Release()
+
+		err = lease.Cancel(context.Background())
+		require.NoError(t, err, "should cancel lease")
+
+		_, err = a.Get(context.Background(), "foo")
+		assert.Error(t, err, "canceled entry should be removed")
+	})
+
+	t.Run("Release", func(t *testing.T) {
+		t.Parallel()
+
+		store := &capstore_server.CapStore{Logger: slog.Default()}
+		defer store.Close()
+
+		a := store.CapStore(alice)
+		defer a.Release()
+
+		client := newClient()
+		defer client.Release()
+
+		lease, err := a.Set(context.Background(), "foo", client, 0)
+		require.NoError(t, err, "should set capability")
+		lease.Release()
+
+		assert.Eventually(t, func() bool {
+			_, err := a.Get(context.Background(), "foo")
+			return err != nil
+		}, time.Second, time.Millisecond*10,
+			"live entry should be released with its lease")
+	})
+
+	t.Run("Broken", func(t *testing.T) {
+		t.Parallel()
+
+		store := &capstore_server.CapStore{
+			Logger:        slog.Default(),
+			SweepInterval: time.Millisecond * 10,
+		}
+		defer store.Close()
+
+		a := store.CapStore(alice)
+		defer a.Release()
+
+		client := capnp.ErrorClient(errors.New("test"))
+		defer client.Release()
+
+		lease, err := a.Set(context.Background(), "foo", client, 0)
+		require.NoError(t, err, "should set capability")
+		defer lease.Release()
+
+		assert.Eventually(t, func() bool {
+			ids, err := a.List(context.Background())
+			return err == nil && len(ids) == 0
+		}, time.Second, time.Millisecond*10,
+			"broken capability should be swept")
+
+		_, err = a.Get(context.Background(), "foo")
+		require.ErrorIs(t, err, capstore_server.ErrNotFound,
+			"should not return broken capability")
+	})
+
+	t.Run("Disconnect", func(t *testing.T) {
+		t.Parallel()
+
+		store := &capstore_server.CapStore{Logger: slog.Default()}
+		defer store.Close()
+
+		a := store.CapStore(alice)
+		defer a.Release()
+
+		// Serve the namespace to a remote peer, which stores one of
+		// its own capabilities before going away.
+		p0, p1 := net.Pipe()
+		c0 := rpc.NewConn(rpc.NewStreamTransport(p0), &rpc.Options{
+			BootstrapClient: capnp.Client(a.AddRef()),
+		})
+		defer c0.Close()
+		c1 := rpc.NewConn(rpc.NewStreamTransport(p1), nil)
+
+		remote := capstore.CapStore(c1.Bootstrap(context.Background()))
+		defer remote.Release()
+
+		client := newClient()
+		defer client.Release()
+
+		lease, err := remote.Set(context.Background(), "foo", client, 0)
+		require.NoError(t, err, "should set capability")
+		defer lease.Release()
+
+		ids, err := a.List(context.Background())
+		require.NoError(t, err, "should list ids")
+		require.Equal(t, []string{"foo"}, ids, "should store capability")
+
+		require.NoError(t, c1.Close(), "should close connection")
+
+		assert.Eventually(t, func() bool {
+			ids, err := a.List(context.Background())
+			return err == nil && len(ids) == 0
+		}, time.Second, time.Millisecond*10,
+			"should evict capability when its connection shuts down")
+	})
+}
+
+func TestWatch(t *testing.T) {
+	t.Parallel()
+
+	store := &capstore_server.CapStore{Logger: slog.Default()}
+	defer store.Close()
+
+	a := store.CapStore(alice)
+	defer a.Release()
+
+	client := newClient()
+	defer client.Release()
+
+	w, release := a.Watch(context.Background(), "foo")
+	defer release()
+
+	// Watch is asynchronous; keep setting the entry until the watcher
+	// is registered and reports the first event.
+	var leases []capstore.Lease
+	defer func() {
+		for _, l := range leases {
+			l.Release()
+		}
+	}()
+
+	events := make(chan capstore.Event, 1)
+	go func() {
+		ev, _ := w.Next()
+		events <- ev
+	}()
+
+	var ev capstore.Event
+	for timeout := time.After(time.Second); ev.ID == ""; {
+		lease, err := a.Set(context.Background(), "foo", client, 0)
+		require.NoError(t, err, "should set capability")
+		leases = append(leases, lease)
+
+		select {
+		case ev = <-events:
+		case <-time.After(time.Millisecond * 10):
+		case <-timeout:
+			t.Fatal("should receive event")
+		}
+	}
+
+	assert.Equal(t, "foo", ev.ID)
+	assert.Equal(t, api.CapStore_Event_Which_set, ev.Type)
+
+	require.NoError(t, a.Delete(context.Background(), "foo"))
+
+	ev, ok := w.Next()
+	require.True(t, ok, "watcher should not be exhausted")
+	for ev.Type == api.CapStore_Event_Which_set {
+		ev, ok = w.Next() // drain events from redundant sets
+		require.True(t, ok, "watcher should not be exhausted")
+	}
+	assert.Equal(t, api.CapStore_Event_Which_delete, ev.Type)
+}
+
+// newClient returns a live, host-local capability to store.
+func newClient() capnp.Client {
+	return capnp.Client(api.CapStore_Handler_ServerToClient(nopHandler{}))
+}
+
+type nopHandler struct{}
+
+func (nopHandler) Recv(context.Context, api.CapStore_Handler_recv) error {
+	return nil
+}
+
+type restorer struct{ root *anchor.Node }
+
+func (r restorer) Restore(ctx context.Context, ref api.SturdyRef) (capnp.Client, error) {
+	path, err := ref.Anchor()
+	if err != nil {
+		return capnp.Client{}, err
+	}
+
+	root := r.root.Anchor()
+	defer root.Release()
+
+	a, release := root.Walk(ctx, path)
+	defer release()
+
+	return capnp.Client(a).AddRef(), nil
+}
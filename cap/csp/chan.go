@@ -0,0 +1,30 @@
+package csp
+
+import (
+	capnp "capnproto.org/go/capnp/v3"
+
+	channel_api "github.com/wetware/pkg/api/channel"
+)
+
+// Chan is a channel created by Executor.NewChan.
+type Chan channel_api.Chan
+
+func (ch Chan) AddRef() Chan {
+	return Chan(capnp.Client(ch).AddRef())
+}
+
+func (ch Chan) Release() {
+	capnp.Client(ch).Release()
+}
+
+// Selector performs select statements over the channels created by an
+// executor.  It is obtained from Executor.Selector.
+type Selector channel_api.Selector
+
+func (s Selector) AddRef() Selector {
+	return Selector(capnp.Client(s).AddRef())
+}
+
+func (s Selector) Release() {
+	capnp.Client(s).Release()
+}
@@ -2,19 +2,24 @@ package events
 
 import (
 	"context"
+	"sync"
 
 	api "github.com/wetware/pkg/api/process"
 )
 
 type EventHandler struct {
-	pause  chan struct{}
-	resume chan struct{}
+	pause     chan struct{}
+	resume    chan struct{}
+	terminate chan struct{}
+	once      *sync.Once
 }
 
 func New() EventHandler {
 	return EventHandler{
-		pause:  make(chan struct{}),
-		resume: make(chan struct{}),
+		pause:     make(chan struct{}),
+		resume:    make(chan struct{}),
+		terminate: make(chan struct{}),
+		once:      new(sync.Once),
 	}
 }
 
@@ -26,6 +31,13 @@ func (e EventHandler) Resume(ctx context.Context, call api.Events_resume) error
 	return chanOrCtx(ctx, e.resume)
 }
 
+// Terminate does not block, since the host is waiting for the process
+// to exit.  Subsequent calls are nops.
+func (e EventHandler) Terminate(ctx context.Context, call api.Events_terminate) error {
+	e.once.Do(func() { close(e.terminate) })
+	return nil
+}
+
 func chanOrCtx(ctx context.Context, c chan struct{}) error {
 	select {
 	case <-ctx.Done():
@@ -42,3 +54,8 @@ func (e EventHandler) OnPause() <-chan struct{} {
 func (e EventHandler) OnResume() <-chan struct{} {
 	return e.pause
 }
+
+// OnTerminate is closed when the host asks the process to exit.
+func (e EventHandler) OnTerminate() <-chan struct{} {
+	return e.terminate
+}
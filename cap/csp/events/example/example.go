@@ -36,6 +36,8 @@ func main() {
 			select {
 			case <-eventHandler.OnResume():
 			}
+		case <-eventHandler.OnTerminate():
+			return
 		case <-crawl(ctx, <-urls):
 		}
 	}
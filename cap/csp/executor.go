@@ -113,6 +113,24 @@ func (ex Executor) Ps(ctx context.Context) ([]proc_api.Info, capnp.ReleaseFunc,
 	return procs, release, nil
 }
 
+// NewChan creates a channel that processes can use to exchange values
+// and capabilities.  The channel is synchronous if size is zero, and
+// buffered if size is positive.  The executor bounds the size.
+func (ex Executor) NewChan(ctx context.Context, size int32) (Chan, capnp.ReleaseFunc) {
+	f, release := core_api.Executor(ex).NewChan(ctx, func(ps core_api.Executor_newChan_Params) error {
+		ps.SetSize(size)
+		return nil
+	})
+	return Chan(f.Chan()), release
+}
+
+// Selector returns a capability that performs select statements over the
+// channels created by NewChan.
+func (ex Executor) Selector(ctx context.Context) (Selector, capnp.ReleaseFunc) {
+	f, release := core_api.Executor(ex).Selector(ctx, nil)
+	return Selector(f.Selector()), release
+}
+
 // DecodeTextList creates a string slice from a capnp.TextList.
 func DecodeTextList(l capnp.TextList) ([]string, error) {
 	var err error
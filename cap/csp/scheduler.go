@@ -0,0 +1,167 @@
+package csp
+
+import (
+	"context"
+
+	capnp "capnproto.org/go/capnp/v3"
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	core_api "github.com/wetware/pkg/api/core"
+	"github.com/wetware/pkg/cap/view"
+	"github.com/wetware/pkg/cluster/routing"
+)
+
+// Placement constrains the hosts on which a process is scheduled.
+type Placement struct {
+	// Constraints select the candidate hosts from the scheduler's
+	// view of the cluster, e.g. view.Where(view.Meta("zone", "us")).
+	Constraints []view.Constraint
+
+	// Avoid lists the servers on which the process MUST NOT be placed,
+	// e.g. those running other replicas of the process.
+	Avoid []routing.ID
+
+	// Spread tries candidates in ascending order of load.  Otherwise,
+	// candidates are tried in random order.
+	Spread bool
+
+	// Attempts is the maximum number of hosts to try.  If zero, all
+	// candidates are tried.
+	Attempts uint32
+}
+
+func (p Placement) bind(target core_api.Scheduler_Placement) error {
+	cs, err := target.NewConstraints(int32(len(p.Constraints)))
+	if err != nil {
+		return err
+	}
+
+	for i, bind := range p.Constraints {
+		if err = bind(cs.At(i)); err != nil {
+			return err
+		}
+	}
+
+	avoid, err := target.NewAvoid(int32(len(p.Avoid)))
+	if err != nil {
+		return err
+	}
+
+	for i, id := range p.Avoid {
+		avoid.Set(i, uint64(id))
+	}
+
+	target.SetSpread(p.Spread)
+	target.SetAttempts(p.Attempts)
+	return nil
+}
+
+// Scheduler is a capability that runs processes on hosts in the
+// cluster.
+type Scheduler core_api.Scheduler
+
+func (s Scheduler) AddRef() Scheduler {
+	return Scheduler(capnp.Client(s).AddRef())
+}
+
+func (s Scheduler) Release() {
+	capnp.Client(s).Release()
+}
+
+// Schedule spawns a process from WASM bytecode bc, on a host that
+// satisfies the placement.  If sess is null, processes placed on remote
+// hosts are passed a session for the host on which they run.
+func (s Scheduler) Schedule(
+	ctx context.Context,
+	sess core_api.Session,
+	bc []byte,
+	p Placement,
+	argv ...string,
+) (Scheduled, capnp.ReleaseFunc) {
+	return s.schedule(ctx, sess, p, argv, func(code core_api.Scheduler_Code) error {
+		return code.SetBytecode(bc)
+	})
+}
+
+// ScheduleCached behaves the same way as Schedule, but expects the
+// bytecode to be cached by the executors.
+func (s Scheduler) ScheduleCached(
+	ctx context.Context,
+	sess core_api.Session,
+	cid cid.Cid,
+	p Placement,
+	argv ...string,
+) (Scheduled, capnp.ReleaseFunc) {
+	return s.schedule(ctx, sess, p, argv, func(code core_api.Scheduler_Code) error {
+		return code.SetCid(cid.Bytes())
+	})
+}
+
+func (s Scheduler) schedule(
+	ctx context.Context,
+	sess core_api.Session,
+	p Placement,
+	argv []string,
+	bindCode func(core_api.Scheduler_Code) error,
+) (Scheduled, capnp.ReleaseFunc) {
+	f, release := core_api.Scheduler(s).Schedule(ctx,
+		func(ps core_api.Scheduler_schedule_Params) error {
+			args, err := EncodeTextList(argv)
+			if err != nil {
+				return err
+			}
+			ps.SetArgs(args)
+
+			code, err := ps.NewCode()
+			if err != nil {
+				return err
+			}
+			if err = bindCode(code); err != nil {
+				return err
+			}
+
+			placement, err := ps.NewPlacement()
+			if err != nil {
+				return err
+			}
+			if err = p.bind(placement); err != nil {
+				return err
+			}
+
+			return ps.SetSession(sess)
+		})
+	return Scheduled(f), release
+}
+
+// Scheduled is a process that was submitted to a Scheduler.
+type Scheduled core_api.Scheduler_schedule_Results_Future
+
+// Proc returns the scheduled process.  Calls to the process are
+// pipelined until it has been placed on a host.
+func (f Scheduled) Proc() Proc {
+	return Proc(core_api.Scheduler_schedule_Results_Future(f).Process())
+}
+
+// Host blocks until the process has been placed, and returns the peer
+// and server of the host on which it runs.
+func (f Scheduled) Host(ctx context.Context) (peer.ID, routing.ID, error) {
+	select {
+	case <-f.Done():
+	case <-ctx.Done():
+		return "", 0, ctx.Err()
+	}
+
+	res, err := core_api.Scheduler_schedule_Results_Future(f).Struct()
+	if err != nil {
+		return "", 0, err
+	}
+
+	id, err := res.Peer()
+	if err != nil {
+		return "", 0, err
+	}
+
+	pid, err := peer.Decode(id)
+	return pid, routing.ID(res.Server()), err
+}
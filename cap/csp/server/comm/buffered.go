@@ -0,0 +1,314 @@
+package comm
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"capnproto.org/go/capnp/v3"
+	api "github.com/wetware/pkg/api/channel"
+)
+
+var (
+	_ ChanServer = (*BufferedChan)(nil)
+	_ ChanServer = (*UnboundedChan)(nil)
+)
+
+// BufferedChan is a channel server with a fixed-size buffer.  Senders
+// block only while the buffer is full, and receivers block only while
+// it is empty.  Once the channel is closed, receivers drain the buffer
+// before failing with ErrClosed.
+//
+// BufferedChan MUST be created with NewBufferedChan.  Use SyncChan for
+// an unbuffered channel.
+type BufferedChan struct{ queue }
+
+// NewBufferedChan returns a channel server that buffers up to n values.
+// It panics if n is not positive.
+func NewBufferedChan(n int) *BufferedChan {
+	if n <= 0 {
+		panic("buffer size must be positive")
+	}
+
+	return &BufferedChan{queue{limit: n}}
+}
+
+// UnboundedChan is a channel server with an unbounded buffer.  Senders
+// never block.  Once the channel is closed, receivers drain the buffer
+// before failing with ErrClosed.
+//
+// The zero-value UnboundedChan is ready to use.
+type UnboundedChan struct{ queue }
+
+// queue of values.  A zero limit means the queue is unbounded.
+type queue struct {
+	limit int
+
+	mu       sync.Mutex
+	values   []*capnp.Message
+	closed   bool
+	shared   int           // number of servers created by NewXXX methods
+	signal   chan struct{} // closed when the queue's state changes
+	watchers watchers      // pending selects
+}
+
+// Shutdown is called when a server for the queue is shut down.  Once
+// the last server has been shut down, Shutdown releases the values that
+// remain in the queue, along with any capabilities they hold.
+//
+// The queue MUST be shared with capnp exclusively through a single
+// server created by the caller (e.g. with NewChan), and through the
+// servers returned by its NewXXX methods.
+func (q *queue) Shutdown() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.shared > 0 {
+		q.shared--
+		return
+	}
+
+	for i, msg := range q.values {
+		q.values[i] = nil
+		msg.Release()
+	}
+
+	q.values = nil
+	q.closed = true
+	q.notify()
+}
+
+// share returns q, after accounting for a new server that shares it.
+func (q *queue) share() *queue {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.shared++
+	return q
+}
+
+func (q *queue) Close(ctx context.Context, call MethodClose) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return errors.New("already closed")
+	}
+
+	q.closed = true
+	q.notify()
+	return nil
+}
+
+func (q *queue) Send(ctx context.Context, call MethodSend) error {
+	// Copy the value out of the call's message, which is released when
+	// Send returns.  Capabilities are copied into the new message's cap
+	// table, and are released when the value is received.
+	val, err := call.Args().Value()
+	if err != nil {
+		return err
+	}
+
+	msg, err := copyValue(val)
+	if err != nil {
+		return err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for !q.closed && q.full() {
+		call.Go()
+
+		// wait for a receiver; temporarily unlocks mu
+		if err = q.wait(ctx); err != nil {
+			msg.Release()
+			return err
+		}
+	}
+
+	if q.closed {
+		msg.Release()
+		return ErrClosed
+	}
+
+	q.values = append(q.values, msg)
+	q.notify()
+	return nil
+}
+
+func (q *queue) Recv(ctx context.Context, call MethodRecv) error {
+	// Do this first.  If something goes wrong, we can still back out
+	// without affecting the queue's state.
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for len(q.values) == 0 {
+		if q.closed {
+			return ErrClosed
+		}
+
+		call.Go()
+
+		// wait for a sender; temporarily unlocks mu
+		if err = q.wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	return q.pop(res)
+}
+
+// pop the value at the head of the queue into res.  Caller MUST hold mu,
+// and the queue MUST NOT be empty.
+func (q *queue) pop(res ValueSetter) error {
+	// If we fail to bind the value to the results struct, then we do
+	// *not* want to dequeue it, so that another receiver can try its
+	// luck.
+	msg := q.values[0]
+	err := res.SetValue(value(msg))
+	if err == nil {
+		q.values[0] = nil
+		q.values = q.values[1:]
+		q.notify()
+		msg.Release()
+	}
+
+	return err
+}
+
+// full reports whether the queue is at capacity.  Caller MUST hold mu.
+func (q *queue) full() bool {
+	return q.limit > 0 && len(q.values) >= q.limit
+}
+
+// notify goroutines waiting for a state change.  Caller MUST hold mu.
+func (q *queue) notify() {
+	if q.signal != nil {
+		close(q.signal)
+		q.signal = nil
+	}
+
+	q.watchers.notify(opRecv | opSend)
+}
+
+// wait for the queue's state to change.  Caller MUST hold mu.
+func (q *queue) wait(ctx context.Context) error {
+	if q.signal == nil {
+		q.signal = make(chan struct{})
+	}
+	signal := q.signal
+
+	q.mu.Unlock()
+	defer q.mu.Lock()
+
+	select {
+	case <-signal:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (q *queue) mutex() *sync.Mutex {
+	return &q.mu
+}
+
+func (q *queue) tryRecv(res ValueSetter) (bool, error) {
+	if len(q.values) > 0 {
+		err := q.pop(res)
+		return err == nil, err
+	}
+
+	if q.closed {
+		return true, ErrClosed
+	}
+
+	return false, nil
+}
+
+func (q *queue) trySend(val capnp.Ptr) (bool, error) {
+	if q.closed {
+		return true, ErrClosed
+	}
+
+	if q.full() {
+		return false, nil
+	}
+
+	msg, err := copyValue(val)
+	if err != nil {
+		return false, err
+	}
+
+	q.values = append(q.values, msg)
+	q.notify()
+	return true, nil
+}
+
+func (q *queue) park(sel *selection, i int, c Case) func() {
+	q.watchers.add(sel, opOf(c))
+
+	return func() {
+		q.watchers.remove(sel)
+	}
+}
+
+func (q *queue) NewSender(ctx context.Context, call MethodNewSender) error {
+	res, err := call.AllocResults()
+	if err == nil {
+		err = res.SetSender(api.Sender_ServerToClient(q.share()))
+	}
+	return err
+}
+
+func (q *queue) NewRecver(ctx context.Context, call MethodNewRecver) error {
+	res, err := call.AllocResults()
+	if err == nil {
+		err = res.SetRecver(api.Recver_ServerToClient(q.share()))
+	}
+	return err
+}
+
+func (q *queue) NewCloser(ctx context.Context, call MethodNewCloser) error {
+	res, err := call.AllocResults()
+	if err == nil {
+		err = res.SetCloser(api.Closer_ServerToClient(q.share()))
+	}
+	return err
+}
+
+func (q *queue) NewSendCloser(ctx context.Context, call MethodNewSendCloser) error {
+	res, err := call.AllocResults()
+	if err == nil {
+		err = res.SetSendCloser(api.SendCloser_ServerToClient(q.share()))
+	}
+	return err
+}
+
+// copyValue deep-copies ptr into a new message.
+func copyValue(ptr capnp.Ptr) (*capnp.Message, error) {
+	msg, seg := capnp.NewSingleSegmentMessage(nil)
+	root, err := capnp.NewRootStruct(seg, capnp.ObjectSize{PointerCount: 1})
+	if err == nil {
+		err = root.SetPtr(0, ptr)
+	}
+
+	if err != nil {
+		msg.Release()
+	}
+
+	return msg, err
+}
+
+// value returns the pointer held by a message created by copyValue.
+func value(msg *capnp.Message) capnp.Ptr {
+	root, _ := msg.Root() // already validated by copyValue
+	ptr, _ := root.Struct().Ptr(0)
+	return ptr
+}
@@ -0,0 +1,174 @@
+package comm_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/pkg/cap/csp/server/comm"
+)
+
+func TestBufferedChan(t *testing.T) {
+	t.Parallel()
+
+	ch := comm.NewChan(comm.NewBufferedChan(2))
+	defer ch.Release()
+
+	require.NoError(t, ch.Send(context.Background(), comm.Text("alpha")))
+	require.NoError(t, ch.Send(context.Background(), comm.Text("bravo")))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+
+	err := ch.Send(ctx, comm.Text("charlie"))
+	require.ErrorIs(t, err, context.DeadlineExceeded,
+		"should block when buffer is full")
+
+	assert.Equal(t, "alpha", recvText(t, ch))
+
+	require.NoError(t, ch.Send(context.Background(), comm.Text("charlie")),
+		"should send after buffer is drained")
+
+	require.NoError(t, ch.Close(context.Background()))
+	require.Error(t, ch.Close(context.Background()),
+		"should not close twice")
+	require.Error(t, ch.Send(context.Background(), comm.Text("delta")),
+		"should not send on closed channel")
+
+	// Close-after-drain
+	assert.Equal(t, "bravo", recvText(t, ch))
+	assert.Equal(t, "charlie", recvText(t, ch))
+
+	f, release := ch.Recv(context.Background())
+	defer release()
+	require.ErrorContains(t, f.Await(context.Background()), comm.ErrClosed.Error(),
+		"should fail after drain")
+}
+
+func TestUnboundedChan(t *testing.T) {
+	t.Parallel()
+
+	ch := comm.NewChan(new(comm.UnboundedChan))
+	defer ch.Release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	const n = 100
+	for i := 0; i < n; i++ {
+		require.NoError(t, ch.Send(ctx, comm.Data([]byte{byte(i)})),
+			"should never block")
+	}
+	require.NoError(t, ch.Close(ctx))
+
+	for i := 0; i < n; i++ {
+		f, release := ch.Recv(ctx)
+		ptr, err := f.Ptr()
+		require.NoError(t, err)
+		assert.Equal(t, []byte{byte(i)}, ptr.Data(), "should preserve order")
+		release()
+	}
+
+	f, release := ch.Recv(ctx)
+	defer release()
+	require.ErrorContains(t, f.Await(ctx), comm.ErrClosed.Error(),
+		"should fail after drain")
+}
+
+func TestUnboundedChan_recvFirst(t *testing.T) {
+	t.Parallel()
+
+	ch := comm.NewChan(new(comm.UnboundedChan))
+	defer ch.Release()
+
+	got := make(chan string, 1)
+	go func() {
+		got <- recvText(t, ch)
+	}()
+
+	time.Sleep(time.Millisecond) // let the receiver block
+	require.NoError(t, ch.Send(context.Background(), comm.Text("hello")))
+	assert.Equal(t, "hello", <-got)
+}
+
+func TestUnboundedChan_capability(t *testing.T) {
+	t.Parallel()
+
+	ch := comm.NewChan(new(comm.UnboundedChan))
+	defer ch.Release()
+
+	want := capnp.ErrorClient(errors.New("test"))
+	defer want.Release()
+
+	require.NoError(t, ch.Send(context.Background(), comm.Client(want.AddRef())))
+
+	f, release := ch.Recv(context.Background())
+	defer release()
+
+	ptr, err := f.Ptr()
+	require.NoError(t, err)
+
+	got := ptr.Interface().Client()
+	assert.True(t, got.IsSame(want), "should receive sent capability")
+}
+
+// Values remaining in the buffer are released once all capabilities
+// for the channel have been released.
+func TestBufferedChan_shutdown(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	released := make(chan struct{})
+	c := comm.NewCloser(shutdownFunc(func() {
+		close(released)
+	}))
+
+	ch := comm.NewChan(comm.NewBufferedChan(1))
+	s, release := ch.NewSender(ctx)
+	defer release()
+
+	require.NoError(t, s.Send(ctx, comm.Client(c)))
+
+	ch.Release()
+	select {
+	case <-released:
+		t.Fatal("should not release value while sender is held")
+	case <-time.After(time.Millisecond * 10):
+	}
+
+	release()
+	select {
+	case <-released:
+	case <-ctx.Done():
+		t.Fatal("should release value after last server shuts down")
+	}
+}
+
+// shutdownFunc is a CloseServer that calls the function when shut down.
+type shutdownFunc func()
+
+func (shutdownFunc) Close(context.Context, comm.MethodClose) error {
+	return nil
+}
+
+func (f shutdownFunc) Shutdown() {
+	f()
+}
+
+func recvText(t *testing.T, ch comm.Chan) string {
+	t.Helper()
+
+	f, release := ch.Recv(context.Background())
+	defer release()
+
+	ptr, err := f.Ptr()
+	require.NoError(t, err, "should receive value")
+	return ptr.Text()
+}
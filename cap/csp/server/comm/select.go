@@ -0,0 +1,415 @@
+package comm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/server"
+	api "github.com/wetware/pkg/api/channel"
+)
+
+// ErrNotLocal is returned by Select when a channel is not hosted by the
+// local vat.
+var ErrNotLocal = errors.New("channel is not local")
+
+// ValueSetter is the results struct of a receive operation.
+type ValueSetter interface {
+	SetValue(capnp.Ptr) error
+}
+
+// Case is an operation in a select statement.  If Send is true, Chan
+// MUST be a Sender capability and Value is sent to it.  Otherwise, Chan
+// MUST be a Recver capability.
+type Case struct {
+	Chan  capnp.Client
+	Send  bool
+	Value capnp.Ptr
+}
+
+// Select blocks until one of the cases can proceed, then performs it and
+// returns its index.  Exactly one operation is completed, atomically with
+// respect to all other operations on the channels.  If the completed case
+// is a receive, the value is written to res.  If the case's channel was
+// closed, Select returns the case's index along with ErrClosed.
+//
+// If block is false and no case can proceed, Select returns -1 (the
+// default case) without blocking.  The channels MUST be SyncChan or
+// buffered channels hosted by the local vat; Select returns ErrNotLocal
+// otherwise.
+//
+// Note that a send to a SyncChan can only proceed while a receiver is
+// blocked on the channel.  Such cases are therefore never selected when
+// block is false, unless the channel is closed.
+func Select(ctx context.Context, res ValueSetter, block bool, cases ...Case) (int, error) {
+	chans := make([]selectable, len(cases))
+	for i, c := range cases {
+		if err := c.Chan.Resolve(ctx); err != nil {
+			return -1, err
+		}
+
+		impl, ok := server.IsServer(c.Chan.State().Brand)
+		if !ok {
+			return -1, fmt.Errorf("case %d: %w", i, ErrNotLocal)
+		}
+
+		if chans[i], ok = impl.(selectable); !ok {
+			return -1, fmt.Errorf("case %d: %w", i, ErrNotLocal)
+		}
+	}
+
+	var (
+		locks  = lockOrder(chans)
+		sel    = &selection{wake: make(chan struct{}, 1)}
+		order  = rand.Perm(len(cases))
+		unpark []func()
+	)
+
+	for {
+		locks.Lock()
+
+		// Withdraw from the channels.  If one of our pending sends was
+		// received in the meantime, the select is complete.
+		for _, f := range unpark {
+			f()
+		}
+		unpark = unpark[:0]
+
+		if sel.completed() {
+			locks.Unlock()
+			return sel.index(), nil
+		}
+
+		if err := ctx.Err(); err != nil {
+			locks.Unlock()
+			return -1, err
+		}
+
+		for _, i := range order {
+			var ok bool
+			var err error
+			if cases[i].Send {
+				ok, err = chans[i].trySend(cases[i].Value)
+			} else {
+				ok, err = chans[i].tryRecv(res)
+			}
+
+			if ok {
+				sel.claim(i)
+				locks.Unlock()
+				return i, err
+			}
+
+			if err != nil {
+				locks.Unlock()
+				return -1, err
+			}
+		}
+
+		if !block {
+			locks.Unlock()
+			return -1, nil
+		}
+
+		// Slow path; wait for one of the channels to change state.
+		for i, ch := range chans {
+			unpark = append(unpark, ch.park(sel, i, cases[i]))
+		}
+		locks.Unlock()
+
+		select {
+		case <-sel.wake:
+		case <-ctx.Done():
+		}
+	}
+}
+
+// selectable is a channel server that supports Select.  All methods
+// except mutex MUST be called while holding the mutex.
+type selectable interface {
+	// mutex guarding the channel's state.  It also serves to identify
+	// the channel, since several servers may share a single channel.
+	mutex() *sync.Mutex
+
+	// tryRecv receives a value into res, if one is available.
+	tryRecv(res ValueSetter) (bool, error)
+
+	// trySend sends val, if the channel can accept it.
+	trySend(val capnp.Ptr) (bool, error)
+
+	// park the i-th case of sel on the channel, such that sel is woken
+	// when the case may be able to proceed.  The returned function
+	// withdraws the case.
+	park(sel *selection, i int, c Case) (unpark func())
+}
+
+// selection is the state of a pending select statement.
+type selection struct {
+	state atomic.Int32  // one plus the index of the completed case
+	wake  chan struct{} // buffered; signals a state change
+}
+
+// claim the select statement for the i-th case.  It returns false if
+// another case was completed first.
+func (sel *selection) claim(i int) bool {
+	return sel.state.CompareAndSwap(0, int32(i)+1)
+}
+
+func (sel *selection) completed() bool {
+	return sel.state.Load() != 0
+}
+
+func (sel *selection) index() int {
+	return int(sel.state.Load()) - 1
+}
+
+func (sel *selection) signal() {
+	select {
+	case sel.wake <- struct{}{}:
+	default:
+	}
+}
+
+// op is a bitmask of channel operations.
+type op uint8
+
+const (
+	opRecv op = 1 << iota
+	opSend
+)
+
+func opOf(c Case) op {
+	if c.Send {
+		return opSend
+	}
+	return opRecv
+}
+
+// watchers is the set of select statements that are parked on a channel,
+// along with the operations they are waiting on.
+type watchers map[*selection]op
+
+func (ws *watchers) add(sel *selection, o op) {
+	if *ws == nil {
+		*ws = make(watchers)
+	}
+	(*ws)[sel] |= o
+}
+
+func (ws watchers) remove(sel *selection) {
+	delete(ws, sel)
+}
+
+// notify select statements waiting on any of the operations in o.
+func (ws watchers) notify(o op) {
+	for sel, want := range ws {
+		if want&o != 0 {
+			sel.signal()
+		}
+	}
+}
+
+// locks is a set of mutexes, which are acquired in a consistent order to
+// prevent deadlocks between concurrent select statements.
+type locks []*sync.Mutex
+
+func lockOrder(chans []selectable) locks {
+	seen := make(map[*sync.Mutex]struct{}, len(chans))
+	ls := make(locks, 0, len(chans))
+	for _, ch := range chans {
+		if mu := ch.mutex(); !has(seen, mu) {
+			seen[mu] = struct{}{}
+			ls = append(ls, mu)
+		}
+	}
+
+	sort.Slice(ls, func(i, j int) bool {
+		return reflect.ValueOf(ls[i]).Pointer() < reflect.ValueOf(ls[j]).Pointer()
+	})
+
+	return ls
+}
+
+func has(seen map[*sync.Mutex]struct{}, mu *sync.Mutex) bool {
+	_, ok := seen[mu]
+	return ok
+}
+
+func (ls locks) Lock() {
+	for _, mu := range ls {
+		mu.Lock()
+	}
+}
+
+func (ls locks) Unlock() {
+	for i := len(ls) - 1; i >= 0; i-- {
+		ls[i].Unlock()
+	}
+}
+
+/*
+	RPC
+*/
+
+// NewSelector returns a Selector capability for channels hosted by the
+// local vat.
+func NewSelector() Selector {
+	return Selector(api.Selector_ServerToClient(selectServer{}))
+}
+
+type selectServer struct{}
+
+func (selectServer) Select(ctx context.Context, call api.Selector_select) error {
+	cs, err := call.Args().Cases()
+	if err != nil {
+		return err
+	}
+
+	cases := make([]Case, cs.Len())
+	for i := range cases {
+		if cases[i], err = readCase(cs.At(i)); err != nil {
+			return fmt.Errorf("case %d: %w", i, err)
+		}
+	}
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	call.Go()
+
+	i, err := Select(ctx, res, !call.Args().Nonblocking(), cases...)
+	if errors.Is(err, ErrClosed) {
+		res.SetClosed(true)
+		err = nil
+	}
+
+	res.SetIndex(int32(i))
+	return err
+}
+
+func readCase(c api.Selector_Case) (Case, error) {
+	switch c.Which() {
+	case api.Selector_Case_Which_recv:
+		return Case{
+			Chan: capnp.Client(c.Recv().Recver()),
+		}, nil
+
+	case api.Selector_Case_Which_send:
+		val, err := c.Send().Value()
+		return Case{
+			Chan:  capnp.Client(c.Send().Sender()),
+			Send:  true,
+			Value: val,
+		}, err
+	}
+
+	return Case{}, fmt.Errorf("unknown case type: %d", c.Which())
+}
+
+// Selector is a capability that performs select statements over the
+// channels hosted by a vat.
+type Selector api.Selector
+
+func (s Selector) Client() capnp.Client {
+	return capnp.Client(s)
+}
+
+func (s Selector) AddRef() Selector {
+	return Selector(s.Client().AddRef())
+}
+
+func (s Selector) Release() {
+	s.Client().Release()
+}
+
+// Selected is the result of a select statement.
+type Selected struct {
+	Index  int       // index of the completed case; -1 for the default case
+	Value  capnp.Ptr // value received, if the case was a receive
+	Closed bool      // true if the case's channel was closed
+}
+
+// Select blocks until one of the cases can proceed, then performs it.
+// If nonblocking is true and no case can proceed, Select returns the
+// default case immediately.  The Selected value is valid until the
+// returned release function is called.
+func (s Selector) Select(ctx context.Context, nonblocking bool, cases ...SelectCase) (Selected, capnp.ReleaseFunc, error) {
+	f, release := api.Selector(s).Select(ctx, func(ps api.Selector_select_Params) error {
+		cs, err := ps.NewCases(int32(len(cases)))
+		if err != nil {
+			return err
+		}
+
+		for i, c := range cases {
+			if err = c(cs.At(i)); err != nil {
+				return err
+			}
+		}
+
+		ps.SetNonblocking(nonblocking)
+		return nil
+	})
+
+	res, err := f.Struct()
+	if err != nil {
+		return Selected{Index: -1}, release, err
+	}
+
+	val, err := res.Value()
+	return Selected{
+		Index:  int(res.Index()),
+		Value:  val,
+		Closed: res.Closed(),
+	}, release, err
+}
+
+// SelectCase is a case in a call to Selector.Select.
+type SelectCase func(api.Selector_Case) error
+
+// RecvCase receives a value from r.
+func RecvCase(r Recver) SelectCase {
+	return func(c api.Selector_Case) error {
+		c.SetRecv()
+		return c.Recv().SetRecver(api.Recver(r).AddRef())
+	}
+}
+
+// SendCase sends the value v to s.  It steals any capability held by v,
+// in the same way as Sender.Send.
+func SendCase(s Sender, v Value) SelectCase {
+	return func(c api.Selector_Case) error {
+		// Values populate the parameters of a send call, so we build the
+		// value in a scratch message and copy it into the case.
+		msg, seg := capnp.NewSingleSegmentMessage(nil)
+		defer msg.Release()
+
+		ps, err := api.NewRootSender_send_Params(seg)
+		if err != nil {
+			return err
+		}
+
+		if err = v(ps); err != nil {
+			return err
+		}
+
+		val, err := ps.Value()
+		if err != nil {
+			return err
+		}
+
+		c.SetSend()
+		if err = c.Send().SetSender(api.Sender(s).AddRef()); err != nil {
+			return err
+		}
+
+		return c.Send().SetValue(val)
+	}
+}
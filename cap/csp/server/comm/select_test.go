@@ -0,0 +1,241 @@
+package comm_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/pkg/cap/csp/server/comm"
+)
+
+func TestSelect(t *testing.T) {
+	t.Parallel()
+
+	sel := comm.NewSelector()
+	t.Cleanup(sel.Release) // subtests run after TestSelect returns
+
+	t.Run("Default", func(t *testing.T) {
+		t.Parallel()
+
+		a := comm.NewChan(comm.NewBufferedChan(1))
+		defer a.Release()
+		b := comm.NewChan(new(comm.SyncChan))
+		defer b.Release()
+
+		got, release, err := sel.Select(context.Background(), true,
+			comm.RecvCase(comm.Recver(a)),
+			comm.SendCase(comm.Sender(b), comm.Text("alpha")))
+		defer release()
+		require.NoError(t, err)
+		assert.Equal(t, -1, got.Index, "should select default case")
+	})
+
+	t.Run("Ready", func(t *testing.T) {
+		t.Parallel()
+
+		a := comm.NewChan(comm.NewBufferedChan(1))
+		defer a.Release()
+		b := comm.NewChan(comm.NewBufferedChan(1))
+		defer b.Release()
+
+		require.NoError(t, b.Send(context.Background(), comm.Text("alpha")))
+
+		got, release, err := sel.Select(context.Background(), false,
+			comm.RecvCase(comm.Recver(a)),
+			comm.RecvCase(comm.Recver(b)))
+		defer release()
+		require.NoError(t, err)
+		assert.Equal(t, 1, got.Index, "should select ready case")
+		assert.Equal(t, "alpha", got.Value.Text())
+		assert.False(t, got.Closed)
+	})
+
+	t.Run("Block", func(t *testing.T) {
+		t.Parallel()
+
+		a := comm.NewChan(new(comm.SyncChan))
+		defer a.Release()
+		b := comm.NewChan(comm.NewBufferedChan(1))
+		defer b.Release()
+
+		go func() {
+			time.Sleep(time.Millisecond * 10)
+			a.Send(context.Background(), comm.Text("alpha"))
+		}()
+
+		got, release, err := sel.Select(context.Background(), false,
+			comm.RecvCase(comm.Recver(a)),
+			comm.RecvCase(comm.Recver(b)))
+		defer release()
+		require.NoError(t, err)
+		assert.Equal(t, 0, got.Index, "should select case that became ready")
+		assert.Equal(t, "alpha", got.Value.Text())
+	})
+
+	t.Run("SyncSend", func(t *testing.T) {
+		t.Parallel()
+
+		a := comm.NewChan(new(comm.SyncChan))
+		defer a.Release()
+
+		recved := make(chan string, 1)
+		go func() {
+			defer close(recved)
+			recved <- recvText(t, a)
+		}()
+
+		got, release, err := sel.Select(context.Background(), false,
+			comm.SendCase(comm.Sender(a), comm.Text("alpha")))
+		defer release()
+		require.NoError(t, err)
+		assert.Equal(t, 0, got.Index)
+		assert.Equal(t, "alpha", <-recved, "should deliver value to receiver")
+	})
+
+	t.Run("Closed", func(t *testing.T) {
+		t.Parallel()
+
+		a := comm.NewChan(new(comm.SyncChan))
+		defer a.Release()
+		b := comm.NewChan(new(comm.UnboundedChan))
+		defer b.Release()
+
+		go func() {
+			time.Sleep(time.Millisecond * 10)
+			b.Close(context.Background())
+		}()
+
+		got, release, err := sel.Select(context.Background(), false,
+			comm.RecvCase(comm.Recver(a)),
+			comm.RecvCase(comm.Recver(b)))
+		defer release()
+		require.NoError(t, err)
+		assert.Equal(t, 1, got.Index)
+		assert.True(t, got.Closed, "should report closed channel")
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		t.Parallel()
+
+		a := comm.NewChan(new(comm.SyncChan))
+		defer a.Release()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+		defer cancel()
+
+		_, release, err := sel.Select(ctx, false,
+			comm.SendCase(comm.Sender(a), comm.Text("alpha")))
+		defer release()
+		require.ErrorContains(t, err, context.DeadlineExceeded.Error())
+
+		// The parked send must have been withdrawn.
+		ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond*10)
+		defer cancel()
+
+		f, release := a.Recv(ctx)
+		defer release()
+		require.ErrorContains(t, f.Await(ctx), context.DeadlineExceeded.Error(),
+			"should not receive value from canceled select")
+	})
+
+	t.Run("NotLocal", func(t *testing.T) {
+		t.Parallel()
+
+		r := comm.NewRecver(recvFunc(func(context.Context, comm.MethodRecv) error {
+			return nil
+		}))
+		defer r.Release()
+
+		_, release, err := sel.Select(context.Background(), true,
+			comm.RecvCase(r))
+		defer release()
+		require.ErrorContains(t, err, comm.ErrNotLocal.Error())
+	})
+}
+
+// Each select statement must complete exactly one of its cases, even when
+// several cases become ready concurrently.
+func TestSelect_atomic(t *testing.T) {
+	t.Parallel()
+
+	const n = 100
+
+	sel := comm.NewSelector()
+	defer sel.Release()
+
+	a := comm.NewChan(new(comm.SyncChan))
+	defer a.Release()
+	b := comm.NewChan(comm.NewBufferedChan(n / 2))
+	defer b.Release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, release, err := sel.Select(ctx, false,
+				comm.SendCase(comm.Sender(a), comm.Text("a")),
+				comm.SendCase(comm.Sender(b), comm.Text("b")))
+			defer release()
+			assert.NoError(t, err)
+		}()
+	}
+
+	// Drain the synchronous channel until all selects have completed.
+	// Every value that was not sent to a was sent to b.
+	var fromA int
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	for {
+		rctx, rcancel := context.WithTimeout(ctx, time.Millisecond*10)
+		f, release := a.Recv(rctx)
+		err := f.Await(rctx)
+		release()
+		rcancel()
+
+		if err == nil {
+			fromA++
+			continue
+		}
+
+		select {
+		case <-done:
+		default:
+			continue
+		}
+		break
+	}
+
+	require.NoError(t, b.Close(ctx))
+
+	var fromB int
+	for {
+		f, release := b.Recv(ctx)
+		err := f.Await(ctx)
+		release()
+		if err != nil {
+			break
+		}
+		fromB++
+	}
+
+	assert.Equal(t, n, fromA+fromB, "each select should send exactly once")
+}
+
+type recvFunc func(context.Context, comm.MethodRecv) error
+
+func (f recvFunc) Recv(ctx context.Context, call comm.MethodRecv) error {
+	return f(ctx, call)
+}
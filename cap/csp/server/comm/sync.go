@@ -20,6 +20,7 @@ type SyncChan struct {
 	mu           sync.Mutex
 	senders      list.List
 	signal, done chan struct{}
+	watchers     watchers // pending selects
 }
 
 func (ch *SyncChan) Close(ctx context.Context, call MethodClose) error {
@@ -34,6 +35,7 @@ func (ch *SyncChan) Close(ctx context.Context, call MethodClose) error {
 
 	default:
 		close(ch.done)
+		ch.watchers.notify(opRecv | opSend)
 		return nil
 	}
 }
@@ -117,11 +119,7 @@ func (ch *SyncChan) pushSend(ctx context.Context, call MethodSend) (pendingSend,
 		recved: recved,
 	})
 
-	// signal that a sender is ready.
-	select {
-	case ch.signal <- struct{}{}:
-	default:
-	}
+	ch.notify()
 
 	return pendingSend{Sender: elem, Done: recved}, nil
 }
@@ -137,26 +135,69 @@ func (ch *SyncChan) Recv(ctx context.Context, call MethodRecv) error {
 	ch.mu.Lock()
 	defer ch.mu.Unlock()
 
-	var next *list.Element
-	for next = ch.senders.Front(); next == nil; next = ch.senders.Front() {
-		// slow path; we're going to have to wait
-		call.Go()
+	for {
+		var next *list.Element
+		for next = ch.senders.Front(); next == nil; next = ch.senders.Front() {
+			// slow path; we're going to have to wait
+			call.Go()
 
-		// wait for sender; temporarily unlocks mu
-		if err := ch.wait(ctx); err != nil {
-			return err // always a context error
+			// wait for sender; temporarily unlocks mu
+			if err := ch.wait(ctx); err != nil {
+				return err // always a context error
+			}
+		}
+
+		if ok, err := ch.recv(next, res); ok || err != nil {
+			return err
 		}
 	}
+}
+
+// recv the value held by the sender in elem.  It returns false if the
+// sender belongs to a select statement that completed another case, in
+// which case the sender is discarded.
+//
+// Callers MUST hold mu.
+func (ch *SyncChan) recv(elem *list.Element, res ValueSetter) (bool, error) {
+	s := elem.Value.(sender)
 
 	// If we fail to bind the sender's value to the results struct,
 	// then we do *not* want to dequeue the sender, so that another
 	// receiver can try its luck.  In such cases, Recv's failure is
 	// transparent to Send().
-	if err = next.Value.(sender).Bind(res); err == nil {
-		ch.senders.Remove(next) // commit
+	if s.sel == nil || !s.sel.completed() {
+		if err := s.Bind(res); err != nil {
+			return false, err
+		}
+
+		if s.claim() {
+			ch.senders.Remove(elem) // commit
+			s.done()
+			return true, nil
+		}
+
+		// The select statement completed another case after we bound
+		// its value.  Clear the value, so that it is not mistaken for
+		// the result of a later receive.
+		if err := res.SetValue(capnp.Ptr{}); err != nil {
+			return false, err
+		}
 	}
 
-	return err
+	ch.senders.Remove(elem) // stale
+	return false, nil
+}
+
+// notify receivers and pending selects that a sender is ready.
+//
+// Callers MUST hold mu.
+func (ch *SyncChan) notify() {
+	select {
+	case ch.signal <- struct{}{}:
+	default:
+	}
+
+	ch.watchers.notify(opRecv)
 }
 
 // wait for a sender to signal that it has added itself to the queue.
@@ -186,14 +227,30 @@ type pendingSend struct {
 type sender struct {
 	val    capnp.Ptr
 	recved chan<- struct{}
+
+	// If the sender is a case in a select statement, sel is non-nil
+	// and index is the position of the case.
+	sel   *selection
+	index int
+}
+
+func (s sender) Bind(res ValueSetter) error {
+	return res.SetValue(s.val)
 }
 
-func (s sender) Bind(res api.Recver_recv_Results) (err error) {
-	if err = res.SetValue(s.val); err == nil {
+// claim the sender's value.  It returns false if the sender belongs to
+// a select statement that has already completed another case.
+func (s sender) claim() bool {
+	return s.sel == nil || s.sel.claim(s.index)
+}
+
+// done signals that the sender's value was received.
+func (s sender) done() {
+	if s.sel != nil {
+		s.sel.signal()
+	} else {
 		close(s.recved)
 	}
-
-	return
 }
 
 func (ch *SyncChan) NewSender(ctx context.Context, call MethodNewSender) error {
@@ -227,3 +284,69 @@ func (ch *SyncChan) NewSendCloser(ctx context.Context, call MethodNewSendCloser)
 	}
 	return err
 }
+
+func (ch *SyncChan) mutex() *sync.Mutex {
+	return &ch.mu
+}
+
+func (ch *SyncChan) closed() bool {
+	ch.initChannels()
+
+	select {
+	case <-ch.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (ch *SyncChan) tryRecv(res ValueSetter) (bool, error) {
+	for elem := ch.senders.Front(); elem != nil; elem = ch.senders.Front() {
+		if ok, err := ch.recv(elem, res); ok || err != nil {
+			return ok, err
+		}
+	}
+
+	if ch.closed() {
+		return true, ErrClosed
+	}
+
+	return false, nil
+}
+
+// trySend fails unless the channel is closed.  A synchronous send can
+// only proceed once a receiver picks up the parked case.
+func (ch *SyncChan) trySend(capnp.Ptr) (bool, error) {
+	if ch.closed() {
+		return true, ErrClosed
+	}
+
+	return false, nil
+}
+
+func (ch *SyncChan) park(sel *selection, i int, c Case) func() {
+	ch.initChannels()
+
+	// Parked sends are added to the send-queue, where they can be picked
+	// up by receivers.  Receiving a parked value completes the select.
+	var elem *list.Element
+	if c.Send {
+		elem = ch.senders.PushBack(sender{
+			val:   c.Value,
+			sel:   sel,
+			index: i,
+		})
+
+		ch.notify()
+	}
+
+	ch.watchers.add(sel, opOf(c))
+
+	return func() {
+		if elem != nil {
+			ch.senders.Remove(elem)
+		}
+
+		ch.watchers.remove(sel)
+	}
+}
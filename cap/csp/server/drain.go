@@ -0,0 +1,59 @@
+package csp_server
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrDraining is returned by Exec when the executor is shutting down.
+var ErrDraining = errors.New("executor is draining")
+
+// drainInterval is the interval at which Drain checks for processes.
+const drainInterval = time.Millisecond * 50
+
+// Drain the executor.  New processes are refused with ErrDraining, and
+// running processes are sent a terminate event.  Drain waits for them
+// to exit, and kills the remaining processes when ctx expires, in which
+// case ctx.Err() is returned.
+func (r Runtime) Drain(ctx context.Context) error {
+	if r.Tree.Draining != nil {
+		r.Tree.Draining.Store(true)
+	}
+
+	// Signal processes concurrently, so that a process that does not
+	// acknowledge the event cannot delay the others.
+	for pid, p := range r.Tree.MapSnapshot() {
+		if proc, ok := p.(*process); ok {
+			go func(pid uint32) {
+				if err := proc.terminate(ctx); err != nil {
+					r.Log.Debug("failed to signal process",
+						"pid", pid,
+						"error", err)
+				}
+			}(pid)
+		}
+	}
+
+	ticker := time.NewTicker(drainInterval)
+	defer ticker.Stop()
+
+	for len(r.Tree.MapSnapshot()) > 0 {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			for pid := range r.Tree.MapSnapshot() {
+				r.Log.Warn("killing process", "pid", pid)
+				r.Tree.Kill(pid)
+			}
+
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+func (r Runtime) draining() bool {
+	return r.Tree.Draining != nil && r.Tree.Draining.Load()
+}
@@ -0,0 +1,96 @@
+package csp_server_test
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	core_api "github.com/wetware/pkg/api/core"
+	proc_api "github.com/wetware/pkg/api/process"
+	csp_server "github.com/wetware/pkg/cap/csp/server"
+)
+
+func TestDrain(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Exit", func(t *testing.T) {
+		t.Parallel()
+
+		r := newDrainRuntime(t)
+		spawnFake(t, r, 2)
+
+		// The process exits shortly after it is signalled.
+		go func() {
+			time.Sleep(time.Millisecond * 10)
+			r.Tree.Kill(2)
+		}()
+
+		err := r.Drain(context.Background())
+		require.NoError(t, err, "should drain executor")
+		assert.Zero(t, r.Procs(), "should wait for process to exit")
+
+		exec := r.Executor()
+		defer exec.Release()
+
+		proc, release := exec.Exec(context.Background(), core_api.Session{}, []byte("bytecode"), 0)
+		defer release()
+
+		err = proc.Wait(context.Background())
+		require.Error(t, err, "should refuse process")
+		assert.Contains(t, err.Error(), csp_server.ErrDraining.Error())
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		t.Parallel()
+
+		r := newDrainRuntime(t)
+		p := spawnFake(t, r, 2)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+		defer cancel()
+
+		err := r.Drain(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded,
+			"should report that processes were killed")
+		assert.True(t, p.killed.Load(), "should kill process")
+		assert.Zero(t, r.Procs(), "should remove killed process")
+	})
+}
+
+func newDrainRuntime(t *testing.T) csp_server.Runtime {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	return csp_server.Runtime{
+		Cache: make(csp_server.BytecodeCache),
+		Tree:  csp_server.NewProcTree(ctx),
+		Log:   slog.Default(),
+	}
+}
+
+func spawnFake(t *testing.T, r csp_server.Runtime, pid uint32) *fakeProc {
+	t.Helper()
+
+	p := new(fakeProc)
+	require.NoError(t, r.Tree.Insert(pid, csp_server.INIT_PID))
+	r.Tree.AddToMap(pid, p)
+	return p
+}
+
+// fakeProc is a process that runs until it is killed.
+type fakeProc struct {
+	proc_api.Process_Server
+	killed atomic.Bool
+}
+
+func (p *fakeProc) Kill(context.Context, proc_api.Process_kill) error {
+	p.killed.Store(true)
+	return nil
+}
@@ -22,10 +22,13 @@ import (
 	wasm "github.com/tetratelabs/wazero/api"
 	"github.com/tetratelabs/wazero/experimental/sock"
 
+	capstore_api "github.com/wetware/pkg/api/capstore"
+	channel_api "github.com/wetware/pkg/api/channel"
 	core_api "github.com/wetware/pkg/api/core"
 	proc_api "github.com/wetware/pkg/api/process"
 	"github.com/wetware/pkg/auth"
 	"github.com/wetware/pkg/cap/csp"
+	"github.com/wetware/pkg/cap/csp/server/comm"
 	"github.com/wetware/pkg/rom"
 	"github.com/wetware/pkg/system"
 	"github.com/wetware/pkg/util/log"
@@ -33,6 +36,13 @@ import (
 
 var nilCid, _ = cid.V1Builder{}.Sum([]byte{})
 
+// MaxChanSize is the largest buffer that NewChan allocates for a channel.
+const MaxChanSize = 1024
+
+// ErrCapacity is returned by Exec when the executor is already running
+// its maximum number of processes.
+var ErrCapacity = errors.New("executor at capacity")
+
 // components the Runtime requires to build a process.
 type components struct {
 	args     csp.Args
@@ -57,6 +67,21 @@ type Runtime struct {
 	Tree     ProcTree
 	Log      log.Logger
 	PeerDial func(context.Context, core_api.Executor_dialPeer) error
+
+	// MaxProcs is the maximum number of processes that the executor
+	// runs concurrently.  If zero, the number is unlimited.
+	MaxProcs uint32
+}
+
+// Procs returns the number of running processes.
+func (r Runtime) Procs() uint32 {
+	return r.Tree.TPC.Get() - 1 // exclude init
+}
+
+// Capacity returns the maximum number of processes that the executor
+// runs concurrently, or zero if it is unlimited.
+func (r Runtime) Capacity() uint32 {
+	return r.MaxProcs
 }
 
 // Executor provides the Executor capability.
@@ -64,6 +89,13 @@ func (r Runtime) Executor() csp.Executor {
 	return csp.Executor(core_api.Executor_ServerToClient(r))
 }
 
+// SturdyRef saves the executor as a reference to the host executor.
+// It allows executors to be persisted in a CapStore.
+func (r Runtime) SturdyRef(ref capstore_api.SturdyRef) error {
+	ref.SetExecutor()
+	return nil
+}
+
 func (r Runtime) Exec(ctx context.Context, call core_api.Executor_exec) error {
 	res, err := call.AllocResults()
 	if err != nil {
@@ -119,6 +151,13 @@ func (r Runtime) ExposedExec(ctx context.Context, id cid.Cid, bc []byte, ea exec
 }
 
 func (r Runtime) exec(ctx context.Context, id cid.Cid, bc []byte, ea execArgs) (proc_api.Process, error) {
+	if r.draining() {
+		return proc_api.Process{}, ErrDraining
+	}
+
+	if r.MaxProcs != 0 && r.Procs() >= r.MaxProcs {
+		return proc_api.Process{}, ErrCapacity
+	}
 
 	if id == nilCid {
 		ro := rom.ROM{Bytecode: bc}
@@ -379,6 +418,40 @@ func (r Runtime) fetchLocalProc(pid uint32) (*process, bool) {
 	return p.(*process), ok
 }
 
+// NewChan creates a channel server.  The channel is synchronous if size
+// is zero, and buffered if size is positive.  Guests cannot create
+// unbounded channels, so size MUST NOT be negative or exceed MaxChanSize.
+func (r Runtime) NewChan(ctx context.Context, call core_api.Executor_newChan) error {
+	size := call.Args().Size()
+	if size < 0 || size > MaxChanSize {
+		return fmt.Errorf("invalid channel size %d: must be between 0 and %d",
+			size, MaxChanSize)
+	}
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	var ch comm.ChanServer = new(comm.SyncChan)
+	if size > 0 {
+		ch = comm.NewBufferedChan(int(size))
+	}
+
+	return res.SetChan(channel_api.Chan(comm.NewChan(ch)))
+}
+
+// Selector returns a capability that selects over the channels created
+// by NewChan.
+func (r Runtime) Selector(ctx context.Context, call core_api.Executor_selector) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	return res.SetSelector(channel_api.Selector(comm.NewSelector()))
+}
+
 func (r Runtime) DialPeer(ctx context.Context, call core_api.Executor_dialPeer) error {
 	call.Go()
 	return r.PeerDial(ctx, call)
@@ -0,0 +1,99 @@
+package csp_server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	core_api "github.com/wetware/pkg/api/core"
+	csp_server "github.com/wetware/pkg/cap/csp/server"
+	"github.com/wetware/pkg/cap/csp/server/comm"
+)
+
+func TestNewChan(t *testing.T) {
+	t.Parallel()
+
+	exec := csp_server.Runtime{}.Executor()
+	defer exec.Release()
+
+	for _, tt := range []struct {
+		name     string
+		size     int32
+		blocking bool
+	}{
+		{name: "Sync", size: 0, blocking: true},
+		{name: "Buffered", size: 1},
+	} {
+		c, release := exec.NewChan(context.Background(), tt.size)
+		defer release()
+		ch := comm.Chan(c)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+		defer cancel()
+
+		err := ch.Send(ctx, comm.Text(tt.name))
+		if tt.blocking {
+			assert.ErrorIs(t, err, context.DeadlineExceeded,
+				"%s: send should block without receiver", tt.name)
+			continue
+		}
+		require.NoError(t, err, "%s: send should not block", tt.name)
+
+		f, release := ch.Recv(context.Background())
+		defer release()
+
+		ptr, err := f.Ptr()
+		require.NoError(t, err, tt.name)
+		assert.Equal(t, tt.name, ptr.Text())
+	}
+}
+
+func TestNewChan_size(t *testing.T) {
+	t.Parallel()
+
+	exec := csp_server.Runtime{}.Executor()
+	defer exec.Release()
+
+	for _, size := range []int32{-1, csp_server.MaxChanSize + 1} {
+		f, release := core_api.Executor(exec).NewChan(context.Background(),
+			func(ps core_api.Executor_newChan_Params) error {
+				ps.SetSize(size)
+				return nil
+			})
+		defer release()
+
+		_, err := f.Struct()
+		assert.ErrorContains(t, err, "invalid channel size",
+			"should refuse channel of size %d", size)
+	}
+}
+
+func TestExec_capacity(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r := csp_server.Runtime{
+		Cache:    make(csp_server.BytecodeCache),
+		Tree:     csp_server.NewProcTree(ctx),
+		MaxProcs: 1,
+	}
+	assert.Zero(t, r.Procs(), "should not count init")
+
+	require.NoError(t, r.Tree.Insert(2, csp_server.INIT_PID))
+	assert.Equal(t, uint32(1), r.Procs(), "should count running process")
+
+	exec := r.Executor()
+	defer exec.Release()
+
+	proc, release := exec.Exec(ctx, core_api.Session{}, []byte("bytecode"), 0)
+	defer release()
+
+	err := proc.Wait(ctx)
+	require.Error(t, err, "should refuse process")
+	assert.Contains(t, err.Error(), csp_server.ErrCapacity.Error())
+}
@@ -207,6 +207,20 @@ func (p *process) Resume(ctx context.Context, call api.Process_resume) error {
 	return nil
 }
 
+// terminate asks the process to exit.  Processes that did not register
+// an event handler are not notified.
+func (p *process) terminate(ctx context.Context) error {
+	if p.events == nilEvents {
+		return nil
+	}
+
+	f, release := p.events.Terminate(ctx, nil)
+	defer release()
+
+	_, err := f.Struct()
+	return err
+}
+
 // Create an info struct from the process meta.
 func (p *process) info() (api.Info, error) {
 	_, seg := capnp.NewSingleSegmentMessage(nil)
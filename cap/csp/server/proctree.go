@@ -29,18 +29,22 @@ type ProcTree struct {
 	Map *sync.Map
 	// Mutex to ensure thread safety.
 	Mut *sync.RWMutex
+	// Draining is set when the executor stops accepting processes.
+	// It MAY be nil, in which case the executor cannot be drained.
+	Draining *atomic.Bool
 }
 
 // NewProcTree is the default constuctor for ProcTree, but it may
 // also be maually constructed.
 func NewProcTree(ctx context.Context) ProcTree {
 	return ProcTree{
-		Ctx:  ctx,
-		PIDC: NewAtomicCounter(INIT_PID),
-		TPC:  NewAtomicCounter(1),
-		Root: &ProcNode{Pid: INIT_PID},
-		Map:  &sync.Map{},
-		Mut:  &sync.RWMutex{},
+		Ctx:      ctx,
+		PIDC:     NewAtomicCounter(INIT_PID),
+		TPC:      NewAtomicCounter(1),
+		Root:     &ProcNode{Pid: INIT_PID},
+		Map:      &sync.Map{},
+		Mut:      &sync.RWMutex{},
+		Draining: &atomic.Bool{},
 	}
 }
 
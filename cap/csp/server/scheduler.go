@@ -0,0 +1,356 @@
+package csp_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	mrand "math/rand"
+	"sort"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+
+	cluster_api "github.com/wetware/pkg/api/cluster"
+	core_api "github.com/wetware/pkg/api/core"
+	proc_api "github.com/wetware/pkg/api/process"
+	"github.com/wetware/pkg/auth"
+	"github.com/wetware/pkg/cap/csp"
+	"github.com/wetware/pkg/cap/view"
+	"github.com/wetware/pkg/cluster/routing"
+	"github.com/wetware/pkg/util/log"
+	"github.com/wetware/pkg/util/proto"
+)
+
+// ErrNoHosts is returned by Schedule when no host satisfies the
+// placement.
+var ErrNoHosts = errors.New("no hosts satisfy placement")
+
+// ViewProvider provides the scheduler's view of the cluster.  It is
+// satisfied by *cluster.Router.
+type ViewProvider interface {
+	ID() routing.ID
+	View() view.View
+}
+
+// Dialer logs into remote hosts.  It is satisfied by vat.Dialer.
+type Dialer interface {
+	Dial(context.Context, peer.AddrInfo, ...protocol.ID) (auth.Session, error)
+}
+
+// Scheduler places processes on the hosts in the cluster.  Processes
+// that are placed on the local host are passed to Executor, and others
+// are passed to the remote host's executor, through Dialer.
+type Scheduler struct {
+	Log log.Logger
+
+	// NS is the cluster namespace, which selects the protocol used to
+	// dial remote hosts.  It defaults to "ww".
+	NS string
+
+	ViewProvider ViewProvider
+	Executor     csp.Executor
+	Dialer       Dialer
+}
+
+// Scheduler provides the Scheduler capability.
+func (s Scheduler) Scheduler() csp.Scheduler {
+	return csp.Scheduler(core_api.Scheduler_ServerToClient(s))
+}
+
+func (s Scheduler) Schedule(ctx context.Context, call core_api.Scheduler_schedule) error {
+	call.Go() // dialing hosts may take some time
+
+	placement, err := call.Args().Placement()
+	if err != nil {
+		return err
+	}
+
+	cs, err := s.candidates(ctx, placement)
+	if err != nil {
+		return err
+	}
+
+	if n := int(placement.Attempts()); n != 0 && n < len(cs) {
+		cs = cs[:n]
+	}
+
+	err = ErrNoHosts
+	for _, c := range cs {
+		var p proc_api.Process
+		if p, err = s.exec(ctx, c, call.Args()); err != nil {
+			s.logger().Debug("host refused process",
+				"peer", c.peer,
+				"server", c.server,
+				"error", err)
+			continue
+		}
+
+		return place(call, c, p)
+	}
+
+	return fmt.Errorf("schedule: %w", err)
+}
+
+// candidates returns the hosts that satisfy the placement, in the
+// order in which they should be tried.
+func (s Scheduler) candidates(ctx context.Context, p core_api.Scheduler_Placement) ([]candidate, error) {
+	constraints, err := p.Constraints()
+	if err != nil {
+		return nil, err
+	}
+
+	avoid, err := p.Avoid()
+	if err != nil {
+		return nil, err
+	}
+
+	v := s.ViewProvider.View()
+	defer v.Release()
+
+	it, release := v.Iter(ctx, forward(constraints))
+	defer release()
+
+	var cs []candidate
+	for r := it.Next(); r != nil; r = it.Next() {
+		// r is only valid until the next call to it.Next().
+		c := newCandidate(r)
+		if c.saturated() || c.avoided(avoid) {
+			continue
+		}
+
+		cs = append(cs, c)
+	}
+
+	if err = it.Err(); err != nil {
+		return nil, err
+	}
+
+	if p.Spread() {
+		sort.SliceStable(cs, func(i, j int) bool {
+			return cs[i].load() < cs[j].load()
+		})
+	} else {
+		mrand.Shuffle(len(cs), func(i, j int) {
+			cs[i], cs[j] = cs[j], cs[i]
+		})
+	}
+
+	return cs, nil
+}
+
+// exec runs the process on the candidate's executor.
+func (s Scheduler) exec(ctx context.Context, c candidate, args core_api.Scheduler_schedule_Params) (proc_api.Process, error) {
+	var (
+		exec core_api.Executor
+		sess core_api.Session
+	)
+
+	if c.server == s.ViewProvider.ID() {
+		if !capnp.Client(s.Executor).IsValid() {
+			return proc_api.Process{}, errors.New("no local executor")
+		}
+
+		exec = core_api.Executor(s.Executor).AddRef()
+	} else {
+		remote, err := s.Dialer.Dial(ctx,
+			peer.AddrInfo{ID: c.peer},
+			proto.Namespace(s.ns())...)
+		if err != nil {
+			return proc_api.Process{}, err
+		}
+		defer remote.Release()
+
+		exec = core_api.Session(remote).Exec().AddRef()
+	}
+	defer exec.Release()
+
+	// The process runs with the caller's session, if any.  The remote
+	// session belongs to this host's account, and MUST NOT be passed
+	// to the process, or guests could act as the host.
+	if args.HasSession() {
+		var err error
+		if sess, err = args.Session(); err != nil {
+			return proc_api.Process{}, err
+		}
+	}
+
+	argv, err := args.Args()
+	if err != nil {
+		return proc_api.Process{}, err
+	}
+
+	code, err := args.Code()
+	if err != nil {
+		return proc_api.Process{}, err
+	}
+
+	switch code.Which() {
+	case core_api.Scheduler_Code_Which_bytecode:
+		bc, err := code.Bytecode()
+		if err != nil {
+			return proc_api.Process{}, err
+		}
+
+		f, release := exec.Exec(ctx, func(ps core_api.Executor_exec_Params) error {
+			if err := ps.SetBytecode(bc); err != nil {
+				return err
+			}
+
+			if err := ps.SetArgs(argv); err != nil {
+				return err
+			}
+
+			return ps.SetSession(sess)
+		})
+		defer release()
+
+		res, err := f.Struct()
+		if err != nil {
+			return proc_api.Process{}, err
+		}
+
+		return res.Process().AddRef(), nil
+
+	case core_api.Scheduler_Code_Which_cid:
+		id, err := code.Cid()
+		if err != nil {
+			return proc_api.Process{}, err
+		}
+
+		f, release := exec.ExecCached(ctx, func(ps core_api.Executor_execCached_Params) error {
+			if err := ps.SetCid(id); err != nil {
+				return err
+			}
+
+			if err := ps.SetArgs(argv); err != nil {
+				return err
+			}
+
+			return ps.SetSession(sess)
+		})
+		defer release()
+
+		res, err := f.Struct()
+		if err != nil {
+			return proc_api.Process{}, err
+		}
+
+		return res.Process().AddRef(), nil
+	}
+
+	return proc_api.Process{}, fmt.Errorf("invalid code: %s", code.Which())
+}
+
+func (s Scheduler) ns() string {
+	if s.NS == "" {
+		return "ww"
+	}
+
+	return s.NS
+}
+
+func (s Scheduler) logger() log.Logger {
+	if s.Log == nil {
+		return slog.Default()
+	}
+
+	return s.Log
+}
+
+// place the process in the call's results.  Place steals p.
+func place(call core_api.Scheduler_schedule, c candidate, p proc_api.Process) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		p.Release()
+		return err
+	}
+
+	res.SetServer(uint64(c.server))
+	if err = res.SetPeer(c.peer.String()); err != nil {
+		p.Release()
+		return err
+	}
+
+	return res.SetProcess(p)
+}
+
+// forward the placement's constraints to the view.
+func forward(cs cluster_api.View_Constraint_List) view.Query {
+	return func(ps view.QueryParams) error {
+		sel, err := ps.NewSelector()
+		if err != nil {
+			return err
+		}
+		sel.SetAll()
+
+		list, err := ps.NewConstraints(int32(cs.Len()))
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < cs.Len(); i++ {
+			if err = list.Set(i, cs.At(i)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// candidate is a host on which a process may be placed.
+type candidate struct {
+	peer      peer.ID
+	server    routing.ID
+	resources routing.Resources
+	reported  bool
+}
+
+func newCandidate(r routing.Record) candidate {
+	c := candidate{
+		peer:   r.Peer(),
+		server: r.Server(),
+	}
+
+	if rr, ok := r.(routing.ResourceRecord); ok {
+		c.resources, c.reported = rr.Resources()
+	}
+
+	return c
+}
+
+// saturated returns true if the host is already running its maximum
+// number of processes.
+func (c candidate) saturated() bool {
+	rs := c.resources
+	return rs.Capacity != 0 && rs.Procs >= rs.Capacity
+}
+
+func (c candidate) avoided(avoid capnp.UInt64List) bool {
+	for i := 0; i < avoid.Len(); i++ {
+		if routing.ID(avoid.At(i)) == c.server {
+			return true
+		}
+	}
+
+	return false
+}
+
+// load returns the utilization of the host's most saturated resource,
+// out of its CPUs and process slots.  Hosts that do not report their
+// resources are considered fully loaded.
+func (c candidate) load() float64 {
+	if !c.reported {
+		return math.Inf(1)
+	}
+
+	load := c.resources.CPULoad
+	if rs := c.resources; rs.Capacity != 0 {
+		load = math.Max(load, float64(rs.Procs)/float64(rs.Capacity))
+	}
+
+	return load
+}
@@ -0,0 +1,276 @@
+package csp_server_test
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	core_api "github.com/wetware/pkg/api/core"
+	"github.com/wetware/pkg/auth"
+	"github.com/wetware/pkg/cap/csp"
+	csp_server "github.com/wetware/pkg/cap/csp/server"
+	"github.com/wetware/pkg/cap/view"
+	"github.com/wetware/pkg/cluster/routing"
+)
+
+func TestScheduler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		local  = &host{server: 1, meta: "zone=eu", res: routing.Resources{CPULoad: 0.5}}
+		full   = &host{server: 2, meta: "zone=us", res: routing.Resources{Procs: 4, Capacity: 4}}
+		busy   = &host{server: 3, meta: "zone=us", res: routing.Resources{CPULoad: 0.9}}
+		idle   = &host{server: 4, meta: "zone=us", res: routing.Resources{CPULoad: 0.1}}
+		refuse = &host{server: 5, meta: "zone=ap", res: routing.Resources{}, refuse: true}
+	)
+
+	table := routing.New(time.Now())
+	hosts := hostMap{}
+	for _, h := range []*host{local, full, busy, idle, refuse} {
+		h.id = newPeerID()
+		require.True(t, table.Upsert(h), "must upsert host")
+		hosts[h.id] = h
+	}
+
+	sched := csp_server.Scheduler{
+		ViewProvider: viewProvider{id: local.server, table: table},
+		Executor:     csp.Executor(core_api.Executor_ServerToClient(executor{})),
+		Dialer:       hosts,
+	}.Scheduler()
+	defer sched.Release()
+
+	zone := func(z string) []view.Constraint {
+		return []view.Constraint{view.Where(view.Meta("zone", z))}
+	}
+
+	for _, tt := range []struct {
+		name      string
+		placement csp.Placement
+		want      routing.ID
+		err       string
+	}{
+		{
+			name:      "Spread",
+			placement: csp.Placement{Constraints: zone("us"), Spread: true},
+			want:      idle.server,
+		},
+		{
+			name: "AntiAffinity",
+			placement: csp.Placement{
+				Constraints: zone("us"),
+				Avoid:       []routing.ID{idle.server},
+				Spread:      true,
+			},
+			want: busy.server,
+		},
+		{
+			name:      "Local",
+			placement: csp.Placement{Constraints: zone("eu")},
+			want:      local.server,
+		},
+		{
+			name: "Retry",
+			placement: csp.Placement{
+				Constraints: []view.Constraint{view.Where(view.Or(
+					view.Meta("zone", "ap"),
+					view.Meta("zone", "eu")))},
+				Spread: true, // refuse is the least loaded
+			},
+			want: local.server,
+		},
+		{
+			name: "Saturated",
+			placement: csp.Placement{
+				Constraints: zone("us"),
+				Avoid:       []routing.ID{idle.server, busy.server},
+			},
+			err: csp_server.ErrNoHosts.Error(),
+		},
+		{
+			name: "Attempts",
+			placement: csp.Placement{
+				Constraints: zone("ap"),
+				Attempts:    1,
+			},
+			err: "refused",
+		},
+		{
+			name:      "NoHosts",
+			placement: csp.Placement{Constraints: zone("af")},
+			err:       csp_server.ErrNoHosts.Error(),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+			defer cancel()
+
+			f, release := sched.Schedule(ctx, core_api.Session{}, []byte("bytecode"), tt.placement)
+			defer release()
+
+			id, server, err := f.Host(ctx)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err, "should schedule process")
+			assert.Equal(t, tt.want, server, "should place process on %s", tt.want)
+			assert.Equal(t, hosts.server(tt.want), id, "should report peer")
+		})
+	}
+}
+
+func TestScheduler_guestSession(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	granted := make(chan bool, 1)
+	remote := &host{id: newPeerID(), server: 2, meta: "zone=us", granted: granted}
+
+	table := routing.New(time.Now())
+	require.True(t, table.Upsert(remote), "must upsert host")
+
+	sched := csp_server.Scheduler{
+		ViewProvider: viewProvider{id: 1, table: table},
+		Dialer:       hostMap{remote.id: remote},
+	}.Scheduler()
+	defer sched.Release()
+
+	// The guest does not pass a session.
+	f, release := sched.Schedule(ctx, core_api.Session{}, []byte("bytecode"), csp.Placement{})
+	defer release()
+
+	_, server, err := f.Host(ctx)
+	require.NoError(t, err, "should schedule process")
+	require.Equal(t, remote.server, server, "should place process on remote host")
+
+	select {
+	case ok := <-granted:
+		assert.False(t, ok, "should not pass the host's session to the process")
+	case <-ctx.Done():
+		t.Fatal("remote host did not execute process")
+	}
+}
+
+type viewProvider struct {
+	id    routing.ID
+	table routing.Table
+}
+
+func (vp viewProvider) ID() routing.ID { return vp.id }
+
+func (vp viewProvider) View() view.View {
+	return view.Server{RoutingTable: vp.table}.View()
+}
+
+// hostMap dials the hosts in the map.
+type hostMap map[peer.ID]*host
+
+func (hs hostMap) Dial(ctx context.Context, info peer.AddrInfo, _ ...protocol.ID) (auth.Session, error) {
+	h, ok := hs[info.ID]
+	if !ok {
+		return auth.Session{}, errors.New("host not found")
+	}
+
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	sess, err := core_api.NewRootSession(seg)
+	if err != nil {
+		return auth.Session{}, err
+	}
+
+	exec := core_api.Executor_ServerToClient(executor{
+		refuse:  h.refuse,
+		granted: h.granted,
+	})
+	return auth.Session(sess), sess.SetExec(exec)
+}
+
+func (hs hostMap) server(id routing.ID) peer.ID {
+	for _, h := range hs {
+		if h.server == id {
+			return h.id
+		}
+	}
+
+	return ""
+}
+
+// executor refuses processes, or accepts them without running them.
+// If granted is set, it reports whether each process was passed a
+// session holding capabilities.
+type executor struct {
+	csp_server.Runtime
+	refuse  bool
+	granted chan<- bool
+}
+
+func (e executor) Exec(ctx context.Context, call core_api.Executor_exec) error {
+	if e.refuse {
+		return errors.New("refused")
+	}
+
+	if e.granted != nil {
+		sess, err := call.Args().Session()
+		if err != nil {
+			return err
+		}
+
+		e.granted <- capnp.Client(sess.Exec()).IsValid()
+	}
+
+	return nil
+}
+
+type host struct {
+	id      peer.ID
+	server  routing.ID
+	meta    string
+	res     routing.Resources
+	refuse  bool
+	granted chan<- bool
+}
+
+func (h *host) Peer() peer.ID              { return h.id }
+func (h *host) PeerBytes() ([]byte, error) { return []byte(h.id), nil }
+func (h *host) Server() routing.ID         { return h.server }
+func (h *host) Seq() uint64                { return 0 }
+func (h *host) TTL() time.Duration         { return time.Minute }
+func (h *host) Host() (string, error)      { return h.server.String(), nil }
+func (h *host) HostBytes() ([]byte, error) { return []byte(h.server.String()), nil }
+
+func (h *host) Meta() (routing.Meta, error) {
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	meta, err := capnp.NewTextList(seg, 1)
+	if err == nil {
+		err = meta.Set(0, h.meta)
+	}
+
+	return routing.Meta(meta), err
+}
+
+func (h *host) Resources() (routing.Resources, bool) { return h.res, true }
+
+func newPeerID() peer.ID {
+	sk, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	id, err := peer.IDFromPrivateKey(sk)
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
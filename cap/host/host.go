@@ -0,0 +1,132 @@
+// Package host provides a capability that administers the local host's
+// membership in the cluster.
+package host
+
+import (
+	"context"
+	"errors"
+
+	"capnproto.org/go/capnp/v3"
+
+	api "github.com/wetware/pkg/api/cluster"
+	"github.com/wetware/pkg/cluster/pulse"
+	"github.com/wetware/pkg/cluster/routing"
+	"github.com/wetware/pkg/util/casm"
+)
+
+var (
+	errNullPreparer     = errors.New("null preparer")
+	errTooManyPreparers = errors.New("too many preparers")
+)
+
+type Host api.Host
+
+func (h Host) AddRef() Host {
+	return Host(capnp.Client(h).AddRef())
+}
+
+func (h Host) Release() {
+	capnp.Client(h).Release()
+}
+
+// SetMeta sets the fields in the host's heartbeats, replacing any
+// fields with the same keys.
+func (h Host) SetMeta(ctx context.Context, fields ...routing.MetaField) error {
+	f, release := api.Host(h).SetMeta(ctx, func(ps api.Host_setMeta_Params) error {
+		list, err := ps.NewFields(int32(len(fields)))
+		if err != nil {
+			return err
+		}
+
+		for i, f := range fields {
+			if err = list.Set(i, f.String()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	defer release()
+
+	_, err := f.Struct()
+	return err
+}
+
+// UnsetMeta removes the fields for the keys from the host's heartbeats.
+func (h Host) UnsetMeta(ctx context.Context, keys ...string) error {
+	f, release := api.Host(h).UnsetMeta(ctx, func(ps api.Host_unsetMeta_Params) error {
+		list, err := ps.NewKeys(int32(len(keys)))
+		if err != nil {
+			return err
+		}
+
+		for i, key := range keys {
+			if err = list.Set(i, key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	defer release()
+
+	_, err := f.Struct()
+	return err
+}
+
+// Meta returns the fields that were set through SetMeta.
+func (h Host) Meta(ctx context.Context) ([]routing.MetaField, error) {
+	f, release := api.Host(h).Meta(ctx, nil)
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := res.Fields()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseFields(list)
+}
+
+// AddPreparer adds p to the host's preparer chain, until the returned
+// release function is called.  Each time a heartbeat is prepared, p is
+// called with a fresh heartbeat, and the metadata fields it sets are
+// added to the host's heartbeat.
+func (h Host) AddPreparer(ctx context.Context, p pulse.Preparer) (casm.Future, capnp.ReleaseFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	f, release := api.Host(h).AddPreparer(ctx, func(ps api.Host_addPreparer_Params) error {
+		return ps.SetPreparer(api.Host_Preparer_ServerToClient(preparer{p}))
+	})
+
+	return casm.Future(f), func() {
+		cancel()
+		release()
+	}
+}
+
+// preparer serves a pulse.Preparer as a Host_Preparer.
+type preparer struct{ pulse.Preparer }
+
+func (p preparer) Prepare(ctx context.Context, call api.Host_Preparer_prepare) error {
+	h := pulse.NewHeartbeat()
+	if err := p.Preparer.Prepare(h); err != nil {
+		return err
+	}
+
+	meta, err := h.Heartbeat.Meta()
+	if err != nil {
+		return err
+	}
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	return res.SetFields(meta)
+}
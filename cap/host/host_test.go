@@ -0,0 +1,172 @@
+package host_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/pkg/cap/host"
+	"github.com/wetware/pkg/cluster/pulse"
+	"github.com/wetware/pkg/cluster/routing"
+)
+
+func TestHost_Meta(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var a announcer
+	server := &host.Server{Announcer: &a}
+
+	h := server.Host()
+	defer h.Release()
+
+	err := h.SetMeta(ctx,
+		routing.MetaField{Key: "region", Value: "us-east-1"},
+		routing.MetaField{Key: "load", Value: "0.5"})
+	require.NoError(t, err, "should set meta")
+	assert.Equal(t, int32(1), a.Load(), "should announce change")
+
+	meta, err := h.Meta(ctx)
+	require.NoError(t, err, "should return meta")
+	assert.Equal(t, []routing.MetaField{
+		{Key: "load", Value: "0.5"},
+		{Key: "region", Value: "us-east-1"},
+	}, meta, "should return fields sorted by key")
+
+	err = h.UnsetMeta(ctx, "load")
+	require.NoError(t, err, "should unset meta")
+	assert.Equal(t, int32(2), a.Load(), "should announce change")
+
+	hb := pulse.NewHeartbeat()
+	err = server.Prepare(hb)
+	require.NoError(t, err, "should prepare heartbeat")
+	assert.Equal(t, []routing.MetaField{
+		{Key: "region", Value: "us-east-1"},
+	}, fields(t, hb), "should only include fields that are set")
+}
+
+func TestHost_AddPreparer(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var a announcer
+	server := &host.Server{
+		Announcer:      &a,
+		PrepareTimeout: time.Millisecond * 10,
+	}
+	server.SetMeta(routing.MetaField{Key: "load", Value: "0"})
+
+	h := server.Host()
+	defer h.Release()
+
+	// The slow preparer times out, and is skipped.
+	_, release := h.AddPreparer(ctx, pulse.PreparerFunc(func(pulse.Heartbeat) error {
+		time.Sleep(time.Millisecond * 100)
+		return nil
+	}))
+	defer release()
+
+	_, release = h.AddPreparer(ctx, pulse.PreparerFunc(func(hb pulse.Heartbeat) error {
+		return hb.AddMeta(routing.MetaField{Key: "load", Value: "0.75"})
+	}))
+
+	var got []routing.MetaField
+	require.Eventually(t, func() bool {
+		hb := pulse.NewHeartbeat()
+		if err := server.Prepare(hb); err != nil {
+			return false
+		}
+
+		got = fields(t, hb)
+		return len(got) == 1 && got[0].Value == "0.75"
+	}, time.Second, time.Millisecond*10, "should add preparer's fields")
+	assert.NotZero(t, a.Load(), "should announce preparer")
+
+	// Releasing the preparer removes it from the chain.
+	release()
+
+	require.Eventually(t, func() bool {
+		hb := pulse.NewHeartbeat()
+		if err := server.Prepare(hb); err != nil {
+			return false
+		}
+
+		got = fields(t, hb)
+		return len(got) == 1 && got[0].Value == "0"
+	}, time.Second, time.Millisecond*10, "should remove preparer's fields")
+}
+
+func TestHost_AddPreparer_concurrent(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := &host.Server{
+		PrepareTimeout: time.Millisecond * 500,
+		MaxPreparers:   3,
+	}
+
+	h := server.Host()
+	defer h.Release()
+
+	// Each preparer takes most of the deadline, so the heartbeat is
+	// only complete if they are called concurrently.
+	for _, key := range []string{"a", "b", "c"} {
+		key := key
+		_, release := h.AddPreparer(ctx, pulse.PreparerFunc(func(hb pulse.Heartbeat) error {
+			time.Sleep(time.Millisecond * 300)
+			return hb.AddMeta(routing.MetaField{Key: key, Value: "1"})
+		}))
+		defer release()
+	}
+
+	// The server is full.
+	f, release := h.AddPreparer(ctx, pulse.PreparerFunc(func(pulse.Heartbeat) error {
+		return nil
+	}))
+	defer release()
+
+	err := f.Await(ctx)
+	require.ErrorContains(t, err, "too many preparers",
+		"should reject preparers beyond the limit")
+
+	start := time.Now()
+	hb := pulse.NewHeartbeat()
+	err = server.Prepare(hb)
+	require.NoError(t, err, "should prepare heartbeat")
+	assert.Len(t, fields(t, hb), 3, "should include all preparers' fields")
+	assert.Less(t, time.Since(start), time.Millisecond*500,
+		"should call preparers concurrently")
+}
+
+type announcer struct{ atomic.Int32 }
+
+func (a *announcer) Bootstrap(context.Context, ...pubsub.PubOpt) error {
+	a.Add(1)
+	return nil
+}
+
+func fields(t *testing.T, hb pulse.Heartbeat) []routing.MetaField {
+	t.Helper()
+
+	meta, err := hb.Meta()
+	require.NoError(t, err, "should return meta")
+
+	fs := make([]routing.MetaField, meta.Len())
+	for i := range fs {
+		fs[i], err = meta.At(i)
+		require.NoError(t, err)
+	}
+
+	return fs
+}
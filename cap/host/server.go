@@ -0,0 +1,351 @@
+package host
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+
+	api "github.com/wetware/pkg/api/cluster"
+	"github.com/wetware/pkg/cluster/pulse"
+	"github.com/wetware/pkg/cluster/routing"
+	"github.com/wetware/pkg/util/log"
+)
+
+const (
+	// DefaultPrepareTimeout bounds the time spent waiting for remote
+	// preparers, when preparing a heartbeat.
+	DefaultPrepareTimeout = time.Millisecond * 500
+
+	// DefaultMaxPreparers is the default number of remote preparers
+	// that can be added to a Server.
+	DefaultMaxPreparers = 32
+)
+
+// Announcer emits a heartbeat.  It is satisfied by *cluster.Router.
+type Announcer interface {
+	Bootstrap(context.Context, ...pubsub.PubOpt) error
+}
+
+// Server holds the metadata fields that are set at runtime, along with
+// the preparers that were added through the Host capability.  It is a
+// pulse.Preparer, and should be included in the router's preparer
+// chain.
+type Server struct {
+	Log log.Logger
+
+	// Announcer emits a heartbeat when the metadata changes.  If nil,
+	// changes are announced with the next scheduled heartbeat.
+	Announcer Announcer
+
+	// PrepareTimeout bounds the time spent waiting for remote
+	// preparers, which are called concurrently.  If zero,
+	// DefaultPrepareTimeout is used.
+	PrepareTimeout time.Duration
+
+	// MaxPreparers limits the number of remote preparers.  If zero,
+	// DefaultMaxPreparers is used.
+	MaxPreparers int
+
+	mu        sync.Mutex
+	fields    map[string]string
+	next      uint64
+	preparers map[uint64]api.Host_Preparer
+}
+
+// Host returns a Host capability for the server.
+func (s *Server) Host() Host {
+	return Host(api.Host_ServerToClient(hostServer{s}))
+}
+
+// SetMeta sets the fields, replacing any existing fields with the same
+// keys.
+func (s *Server) SetMeta(fields ...routing.MetaField) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.fields == nil {
+		s.fields = make(map[string]string, len(fields))
+	}
+
+	for _, f := range fields {
+		s.fields[f.Key] = f.Value
+	}
+}
+
+// UnsetMeta removes the fields for the keys.
+func (s *Server) UnsetMeta(keys ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range keys {
+		delete(s.fields, key)
+	}
+}
+
+// Meta returns the fields that were set with SetMeta, sorted by key.
+func (s *Server) Meta() []routing.MetaField {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fields := make([]routing.MetaField, 0, len(s.fields))
+	for key, value := range s.fields {
+		fields = append(fields, routing.MetaField{Key: key, Value: value})
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Key < fields[j].Key
+	})
+
+	return fields
+}
+
+// Prepare adds the server's fields to the heartbeat, followed by those
+// returned by each remote preparer, in the order in which they were
+// added.  Remote preparers are called concurrently, and share a single
+// deadline.  Preparers that fail or time out are skipped.
+func (s *Server) Prepare(h pulse.Heartbeat) error {
+	if err := h.AddMeta(s.Meta()...); err != nil {
+		return err
+	}
+
+	ps, release := s.remotes()
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout())
+	defer cancel()
+
+	var (
+		wg   sync.WaitGroup
+		res  = make([][]routing.MetaField, len(ps))
+		errs = make([]error, len(ps))
+	)
+	for i, p := range ps {
+		wg.Add(1)
+		go func(i int, p api.Host_Preparer) {
+			defer wg.Done()
+			res[i], errs[i] = prepare(ctx, p)
+		}(i, p)
+	}
+	wg.Wait()
+
+	for i, fields := range res {
+		if errs[i] != nil {
+			s.logger().Debug("preparer failed",
+				"error", errs[i])
+			continue
+		}
+
+		if err := h.AddMeta(fields...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// remotes returns the remote preparers in the order in which they were
+// added.  Callers MUST call the release function when finished.
+func (s *Server) remotes() ([]api.Host_Preparer, capnp.ReleaseFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]uint64, 0, len(s.preparers))
+	for id := range s.preparers {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	ps := make([]api.Host_Preparer, len(ids))
+	for i, id := range ids {
+		ps[i] = s.preparers[id].AddRef()
+	}
+
+	return ps, func() {
+		for _, p := range ps {
+			p.Release()
+		}
+	}
+}
+
+func (s *Server) timeout() time.Duration {
+	if s.PrepareTimeout > 0 {
+		return s.PrepareTimeout
+	}
+
+	return DefaultPrepareTimeout
+}
+
+func (s *Server) maxPreparers() int {
+	if s.MaxPreparers > 0 {
+		return s.MaxPreparers
+	}
+
+	return DefaultMaxPreparers
+}
+
+func prepare(ctx context.Context, p api.Host_Preparer) ([]routing.MetaField, error) {
+	f, release := p.Prepare(ctx, nil)
+	defer release()
+
+	select {
+	case <-f.Done():
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	res, err := f.Struct()
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := res.Fields()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseFields(list)
+}
+
+// addPreparer returns a function that removes the preparer.  It fails
+// if the server already holds the maximum number of preparers.
+func (s *Server) addPreparer(p api.Host_Preparer) (func(), error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.preparers == nil {
+		s.preparers = make(map[uint64]api.Host_Preparer)
+	}
+
+	if len(s.preparers) >= s.maxPreparers() {
+		return nil, errTooManyPreparers
+	}
+
+	id := s.next
+	s.next++
+	s.preparers[id] = p
+
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		delete(s.preparers, id)
+		p.Release()
+	}, nil
+}
+
+func (s *Server) announce(ctx context.Context) error {
+	if s.Announcer == nil {
+		return nil
+	}
+
+	return s.Announcer.Bootstrap(ctx)
+}
+
+func (s *Server) logger() log.Logger {
+	if s.Log == nil {
+		return slog.Default()
+	}
+
+	return s.Log
+}
+
+type hostServer struct{ *Server }
+
+func (s hostServer) SetMeta(ctx context.Context, call api.Host_setMeta) error {
+	list, err := call.Args().Fields()
+	if err != nil {
+		return err
+	}
+
+	fields, err := parseFields(list)
+	if err != nil {
+		return err
+	}
+
+	s.Server.SetMeta(fields...)
+	return s.announce(ctx)
+}
+
+func (s hostServer) UnsetMeta(ctx context.Context, call api.Host_unsetMeta) error {
+	list, err := call.Args().Keys()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, list.Len())
+	for i := range keys {
+		if keys[i], err = list.At(i); err != nil {
+			return err
+		}
+	}
+
+	s.Server.UnsetMeta(keys...)
+	return s.announce(ctx)
+}
+
+func (s hostServer) Meta(ctx context.Context, call api.Host_meta) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	fields := s.Server.Meta()
+	list, err := res.NewFields(int32(len(fields)))
+	if err != nil {
+		return err
+	}
+
+	for i, f := range fields {
+		if err = list.Set(i, f.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s hostServer) AddPreparer(ctx context.Context, call api.Host_addPreparer) error {
+	p := call.Args().Preparer()
+	if !capnp.Client(p).IsValid() {
+		return errNullPreparer
+	}
+
+	p = p.AddRef()
+	remove, err := s.addPreparer(p)
+	if err != nil {
+		p.Release()
+		return err
+	}
+	defer remove()
+
+	call.Go()
+
+	// The preparer's fields are announced immediately.
+	if err := s.announce(ctx); err != nil {
+		return err
+	}
+
+	<-ctx.Done()
+	return nil
+}
+
+func parseFields(list capnp.TextList) ([]routing.MetaField, error) {
+	fields := make([]routing.MetaField, list.Len())
+	for i := range fields {
+		s, err := list.At(i)
+		if err != nil {
+			return nil, err
+		}
+
+		if fields[i], err = routing.ParseField(s); err != nil {
+			return nil, err
+		}
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,119 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	capnp "capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/server"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	api "github.com/wetware/pkg/api/pubsub"
+)
+
+// ErrPermission is returned when an account attempts an operation on
+// a topic for which it was not granted the corresponding permission.
+var ErrPermission = errors.New("permission denied")
+
+// Permission is a set of rights over a topic.
+type Permission uint8
+
+const (
+	PermPublish   Permission = 1 << iota // publish messages
+	PermSubscribe                        // subscribe to messages
+	PermValidate                         // install a validator (topic owner)
+
+	PermNone Permission = 0
+	PermAll             = PermPublish | PermSubscribe | PermValidate
+)
+
+// Has reports whether p grants all the rights in q.
+func (p Permission) Has(q Permission) bool {
+	return p&q == q
+}
+
+// ACL reports the permissions granted to an account over a topic.  The
+// topic name is the one supplied by the account, without any namespace.
+// An account with no permissions cannot join the topic.
+type ACL func(account peer.ID, topic string) Permission
+
+// AllowAll grants every permission over every topic.
+func AllowAll(peer.ID, string) Permission {
+	return PermAll
+}
+
+// guard is a topic server that checks the caller's permissions before
+// delegating to the underlying topic.
+type guard struct {
+	perm  Permission
+	topic api.Topic // shared reference; keeps the topic alive
+	*topicServer
+}
+
+// newGuard wraps topic in a guard that enforces perm.  It steals the
+// reference to topic.
+func newGuard(perm Permission, topic api.Topic) (api.Topic, error) {
+	if perm.Has(PermAll) {
+		return topic, nil
+	}
+
+	t, ok := serverOf(topic)
+	if !ok {
+		defer topic.Release()
+		return api.Topic{}, errors.New("topic is not local")
+	}
+
+	return api.Topic_ServerToClient(guard{
+		perm:        perm,
+		topic:       topic,
+		topicServer: t,
+	}), nil
+}
+
+// serverOf returns the server for a topic hosted by the local process.
+func serverOf(topic api.Topic) (*topicServer, bool) {
+	brand := capnp.Client(topic).State().Brand
+	if s, ok := server.IsServer(brand); ok {
+		t, ok := s.(*topicServer)
+		return t, ok
+	}
+
+	return nil, false
+}
+
+func (g guard) Shutdown() {
+	g.topic.Release()
+}
+
+func (g guard) Publish(ctx context.Context, call api.Topic_publish) error {
+	if err := g.check(PermPublish); err != nil {
+		return err
+	}
+
+	return g.topicServer.Publish(ctx, call)
+}
+
+func (g guard) Subscribe(ctx context.Context, call api.Topic_subscribe) error {
+	if err := g.check(PermSubscribe); err != nil {
+		return err
+	}
+
+	return g.topicServer.Subscribe(ctx, call)
+}
+
+func (g guard) SetValidator(ctx context.Context, call api.Topic_setValidator) error {
+	if err := g.check(PermValidate); err != nil {
+		return err
+	}
+
+	return g.topicServer.SetValidator(ctx, call)
+}
+
+func (g guard) check(perm Permission) error {
+	if g.perm.Has(perm) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: topic %s", ErrPermission, g.name)
+}
@@ -0,0 +1,141 @@
+package pubsub_test
+
+import (
+	"context"
+	"testing"
+
+	capnp "capnproto.org/go/capnp/v3"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/wetware/pkg/api/pubsub"
+	"github.com/wetware/pkg/cap/pubsub"
+)
+
+func TestACL(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	gs, release := newGossipSub(ctx)
+	defer release()
+
+	r := &pubsub.Server{
+		TopicJoiner: gs,
+		ACL: func(account peer.ID, topic string) pubsub.Permission {
+			switch {
+			case account == "root":
+				return pubsub.PermAll
+			case topic == "secret":
+				return pubsub.PermNone
+			}
+
+			return pubsub.PermSubscribe
+		},
+	}
+
+	ps := r.Router("guest")
+	defer ps.Release()
+
+	secret, release := ps.Join(ctx, "secret")
+	defer release()
+
+	_, err := secret.Name(ctx)
+	require.ErrorContains(t, err, pubsub.ErrPermission.Error(),
+		"should not join topic without permissions")
+
+	topic, release := ps.Join(ctx, "test")
+	defer release()
+
+	name, err := topic.Name(ctx)
+	require.NoError(t, err, "should join topic")
+	assert.Equal(t, "test", name)
+
+	err = topic.Publish(ctx, []byte("test"))
+	if err == nil {
+		err = capnp.Client(topic).WaitStreaming()
+	}
+	require.ErrorContains(t, err, pubsub.ErrPermission.Error(),
+		"should not publish without permission")
+
+	v := pubsub.NewValidator(func(context.Context, pubsub.Message) api.Validator_Result {
+		return api.Validator_Result_accept
+	})
+	defer v.Release()
+
+	err = topic.SetValidator(ctx, v)
+	require.ErrorContains(t, err, pubsub.ErrPermission.Error(),
+		"should not set validator without permission")
+
+	// The root router is not subject to the guest's restrictions.
+	root := r.Router("root")
+	defer root.Release()
+
+	owned, release := root.Join(ctx, "test")
+	defer release()
+
+	err = owned.SetValidator(ctx, v)
+	require.NoError(t, err, "owner should set validator")
+}
+
+// Without an ACL, only the account that first joined a topic may install
+// its validator.
+func TestACL_owner(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	gs, release := newGossipSub(ctx)
+	defer release()
+
+	r := &pubsub.Server{TopicJoiner: gs}
+
+	owner := r.Router("owner")
+	defer owner.Release()
+
+	guest := r.Router("guest")
+	defer guest.Release()
+
+	owned, release := owner.Join(ctx, "test")
+	defer release()
+
+	// Ownership is claimed when the join completes, so make sure the
+	// owner's join resolves before the guest's is sent.
+	err := capnp.Client(owned).Resolve(ctx)
+	require.NoError(t, err, "owner should join")
+
+	topic, release := guest.Join(ctx, "test")
+	defer release()
+
+	err = topic.Publish(ctx, []byte("test"))
+	if err == nil {
+		err = capnp.Client(topic).WaitStreaming()
+	}
+	require.NoError(t, err, "guest should publish")
+
+	v := pubsub.NewValidator(func(context.Context, pubsub.Message) api.Validator_Result {
+		return api.Validator_Result_accept
+	})
+	defer v.Release()
+
+	err = topic.SetValidator(ctx, v)
+	require.ErrorContains(t, err, pubsub.ErrPermission.Error(),
+		"guest should not replace owner's validator")
+
+	err = owned.SetValidator(ctx, v)
+	require.NoError(t, err, "owner should set validator")
+}
+
+func TestPermission(t *testing.T) {
+	t.Parallel()
+
+	perm := pubsub.PermPublish | pubsub.PermSubscribe
+	assert.True(t, perm.Has(pubsub.PermPublish))
+	assert.True(t, perm.Has(pubsub.PermPublish|pubsub.PermSubscribe))
+	assert.False(t, perm.Has(pubsub.PermValidate))
+	assert.False(t, perm.Has(pubsub.PermAll))
+	assert.True(t, pubsub.PermAll.Has(perm))
+}
@@ -0,0 +1,214 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+
+	api "github.com/wetware/pkg/api/pubsub"
+	"github.com/wetware/pkg/util/log"
+)
+
+// ErrNoHistory is returned when replaying a topic that does not retain
+// history.
+var ErrNoHistory = errors.New("topic does not retain history")
+
+// Retention bounds the history retained by each topic.  Messages are
+// discarded once either bound is exceeded.  The zero value disables
+// history.
+type Retention struct {
+	Count    int           // max number of messages; zero means no limit
+	Duration time.Duration // max message age; zero means no limit
+}
+
+// Enabled reports whether r retains any history.
+func (r Retention) Enabled() bool {
+	return r.Count > 0 || r.Duration > 0
+}
+
+// RetentionPolicy selects the history retained by each topic.  Topics
+// retain history only if the policy opts them in, by returning an
+// enabled Retention.
+type RetentionPolicy func(topic string) Retention
+
+// Retain returns a policy that applies r to the named topics, and
+// disables history for all others.
+func Retain(r Retention, topics ...string) RetentionPolicy {
+	names := make(map[string]struct{}, len(topics))
+	for _, name := range topics {
+		names[name] = struct{}{}
+	}
+
+	return func(topic string) Retention {
+		if _, ok := names[topic]; ok {
+			return r
+		}
+
+		return Retention{}
+	}
+}
+
+// history is a bounded log of the messages received on a topic.  Each
+// message is assigned a sequence number, starting at 1.  Subscribers
+// read from the log using a cursor, which allows retained messages to
+// be replayed before live messages are delivered.
+//
+// A history is owned by the topicManager, and outlives the capabilities
+// for its topic.  It keeps the libp2p topic joined until it is closed.
+type history struct {
+	log    log.Logger
+	retain Retention
+	topic  *pubsub.Topic
+	sub    *pubsub.Subscription
+
+	mu      sync.Mutex
+	seq     uint64 // sequence number of the last message
+	entries []record
+	signal  chan struct{} // closed when a message is appended
+}
+
+type record struct {
+	seq  uint64
+	time time.Time
+	msg  *pubsub.Message
+}
+
+func newHistory(log log.Logger, r Retention, t *pubsub.Topic) (*history, error) {
+	sub, err := t.Subscribe()
+	if err != nil {
+		return nil, err
+	}
+
+	h := &history{
+		log:    log,
+		retain: r,
+		topic:  t,
+		sub:    sub,
+		signal: make(chan struct{}),
+	}
+
+	go h.listen()
+
+	return h, nil
+}
+
+// Close stops recording messages.  It does not close the topic.
+func (h *history) Close() {
+	h.sub.Cancel()
+}
+
+func (h *history) listen() {
+	for {
+		msg, err := h.sub.Next(context.Background())
+		if err != nil {
+			h.log.Debug("stopped recording history",
+				"topic", h.sub.Topic(),
+				"reason", err)
+			return
+		}
+
+		h.append(msg)
+	}
+}
+
+func (h *history) append(msg *pubsub.Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.seq++
+	h.entries = append(h.entries, record{
+		seq:  h.seq,
+		time: time.Now(),
+		msg:  msg,
+	})
+	h.trim(time.Now())
+
+	close(h.signal)
+	h.signal = make(chan struct{})
+}
+
+// trim discards records that exceed the retention bounds.  Caller
+// MUST hold mu.
+func (h *history) trim(now time.Time) {
+	var n int
+	if h.retain.Count > 0 && len(h.entries) > h.retain.Count {
+		n = len(h.entries) - h.retain.Count
+	}
+
+	if h.retain.Duration > 0 {
+		deadline := now.Add(-h.retain.Duration)
+		for n < len(h.entries) && h.entries[n].time.Before(deadline) {
+			n++
+		}
+	}
+
+	if n > 0 {
+		// Zero the discarded records so that messages can be collected.
+		clear(h.entries[:n])
+		h.entries = h.entries[n:]
+	}
+}
+
+// Cursor returns the cursor from which a subscriber should read, in
+// order to replay the messages selected by since.
+func (h *history) Cursor(since api.Topic_Since) uint64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	switch since.Which() {
+	case api.Topic_Since_Which_seq:
+		// Clamp cursors that are ahead of the log, e.g. those obtained
+		// before the host restarted.  Otherwise, the subscriber would
+		// miss messages until the sequence caught up.
+		return min(since.Seq(), h.seq)
+
+	case api.Topic_Since_Which_time:
+		t := time.Unix(0, since.Time())
+		i := sort.Search(len(h.entries), func(i int) bool {
+			return !h.entries[i].time.Before(t)
+		})
+		if i < len(h.entries) {
+			return h.entries[i].seq - 1
+		}
+	}
+
+	return h.seq // live
+}
+
+// Next blocks until a message with a sequence number greater than
+// cursor is available, and returns the earliest such message.  If the
+// messages immediately following the cursor have been discarded, they
+// are skipped.
+func (h *history) Next(ctx context.Context, cursor uint64) (record, error) {
+	for {
+		h.mu.Lock()
+		h.trim(time.Now())
+
+		if len(h.entries) > 0 {
+			// Sequence numbers are contiguous, so we can index directly.
+			first := h.entries[0].seq
+			if cursor < first {
+				cursor = first - 1
+			}
+
+			if i := cursor - first + 1; i < uint64(len(h.entries)) {
+				r := h.entries[i]
+				h.mu.Unlock()
+				return r, nil
+			}
+		}
+
+		signal := h.signal
+		h.mu.Unlock()
+
+		select {
+		case <-signal:
+		case <-ctx.Done():
+			return record{}, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	capnp "capnproto.org/go/capnp/v3"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/wetware/pkg/api/pubsub"
+)
+
+func TestHistory(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("Count", func(t *testing.T) {
+		t.Parallel()
+
+		h := &history{
+			retain: Retention{Count: 2},
+			signal: make(chan struct{}),
+		}
+
+		for _, s := range []string{"a", "b", "c"} {
+			h.append(newTestMessage(s))
+		}
+
+		require.Len(t, h.entries, 2, "should discard oldest message")
+		assert.Equal(t, uint64(2), h.entries[0].seq)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		// Cursor 0 precedes the retained window, so the discarded
+		// message should be skipped.
+		r, err := h.Next(ctx, 0)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2), r.seq)
+		assert.Equal(t, "b", string(r.msg.Data))
+
+		r, err = h.Next(ctx, r.seq)
+		require.NoError(t, err)
+		assert.Equal(t, "c", string(r.msg.Data))
+	})
+
+	t.Run("Duration", func(t *testing.T) {
+		t.Parallel()
+
+		h := &history{
+			retain: Retention{Duration: time.Millisecond * 10},
+			signal: make(chan struct{}),
+		}
+
+		h.append(newTestMessage("a"))
+		time.Sleep(time.Millisecond * 20)
+		h.append(newTestMessage("b"))
+
+		require.Len(t, h.entries, 1, "should discard expired message")
+		assert.Equal(t, "b", string(h.entries[0].msg.Data))
+	})
+
+	t.Run("Cursor", func(t *testing.T) {
+		t.Parallel()
+
+		h := &history{
+			retain: Retention{Count: 10},
+			signal: make(chan struct{}),
+		}
+
+		h.append(newTestMessage("a"))
+		checkpoint := time.Now()
+		h.append(newTestMessage("b"))
+
+		assert.Equal(t, uint64(2), h.Cursor(newSince(func(s api.Topic_Since) {
+			s.SetLive()
+		})), "live cursor should follow last message")
+
+		assert.Equal(t, uint64(1), h.Cursor(newSince(func(s api.Topic_Since) {
+			s.SetSeq(1)
+		})), "seq cursor should be unchanged")
+
+		assert.Equal(t, uint64(2), h.Cursor(newSince(func(s api.Topic_Since) {
+			s.SetSeq(100)
+		})), "seq cursor should be clamped to last message")
+
+		assert.Equal(t, uint64(1), h.Cursor(newSince(func(s api.Topic_Since) {
+			s.SetTime(checkpoint.UnixNano())
+		})), "time cursor should precede first matching message")
+	})
+
+	t.Run("Wait", func(t *testing.T) {
+		t.Parallel()
+
+		h := &history{
+			retain: Retention{Count: 10},
+			signal: make(chan struct{}),
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		go func() {
+			time.Sleep(time.Millisecond * 10)
+			h.append(newTestMessage("a"))
+		}()
+
+		r, err := h.Next(ctx, 0)
+		require.NoError(t, err, "should wait for next message")
+		assert.Equal(t, "a", string(r.msg.Data))
+
+		ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond*10)
+		defer cancel()
+
+		_, err = h.Next(ctx, r.seq)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestReplay(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	gs, release := newGossipSub(context.Background())
+	defer release()
+
+	server := &Server{
+		TopicJoiner: gs,
+		Retention:   Retain(Retention{Count: 10}, "test"),
+	}
+	defer server.Close()
+
+	ps := server.PubSub()
+	defer ps.Release()
+
+	topic, release := ps.Join(ctx, "test")
+	defer release()
+
+	for _, s := range []string{"a", "b", "c"} {
+		require.NoError(t, topic.Publish(ctx, []byte(s)))
+	}
+
+	sub, release := topic.SubscribeSince(ctx, SinceSeq(0))
+	defer release()
+
+	for i, s := range []string{"a", "b", "c"} {
+		msg, ok := sub.NextMessage()
+		require.True(t, ok, "should replay message")
+		assert.Equal(t, s, string(msg.Data))
+		assert.Equal(t, uint64(i+1), msg.Seq)
+	}
+
+	require.NoError(t, topic.Publish(ctx, []byte("d")))
+
+	msg, ok := sub.NextMessage()
+	require.True(t, ok, "should receive live message")
+	assert.Equal(t, "d", string(msg.Data))
+	assert.Equal(t, uint64(4), msg.Seq)
+}
+
+// History is retained after the last reference to the topic is released.
+func TestReplay_rejoin(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	gs, release := newGossipSub(context.Background())
+	defer release()
+
+	server := &Server{
+		TopicJoiner: gs,
+		Retention:   Retain(Retention{Count: 10}, "test"),
+	}
+	defer server.Close()
+
+	ps := server.PubSub()
+	defer ps.Release()
+
+	topic, release := ps.Join(ctx, "test")
+	require.NoError(t, topic.Publish(ctx, []byte("a")))
+	require.NoError(t, capnp.Client(topic).WaitStreaming())
+	release()
+
+	// The history subscription receives messages asynchronously.
+	require.Eventually(t, func() bool {
+		server.topics.mu.Lock()
+		h := server.topics.retained["test"]
+		server.topics.mu.Unlock()
+
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		return h.seq == 1
+	}, time.Second, time.Millisecond*10, "should record message")
+
+	topic, release = ps.Join(ctx, "test")
+	defer release()
+
+	sub, release := topic.SubscribeSince(ctx, SinceSeq(0))
+	defer release()
+
+	msg, ok := sub.NextMessage()
+	require.True(t, ok, "should replay message published before rejoining")
+	assert.Equal(t, "a", string(msg.Data))
+	assert.Equal(t, uint64(1), msg.Seq)
+}
+
+func TestReplay_noHistory(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	gs, release := newGossipSub(context.Background())
+	defer release()
+
+	ps := (&Server{TopicJoiner: gs}).PubSub()
+	defer ps.Release()
+
+	topic, release := ps.Join(ctx, "test")
+	defer release()
+
+	sub, release := topic.SubscribeSince(ctx, SinceTime(time.Now()))
+	defer release()
+
+	_, ok := sub.NextMessage()
+	require.False(t, ok, "should fail to replay")
+	assert.ErrorContains(t, sub.Err(), ErrNoHistory.Error())
+}
+
+func newTestMessage(data string) *pubsub.Message {
+	return &pubsub.Message{
+		Message: &pb.Message{Data: []byte(data)},
+	}
+}
+
+func newSince(f func(api.Topic_Since)) api.Topic_Since {
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	s, err := api.NewRootTopic_Since(seg)
+	if err != nil {
+		panic(err)
+	}
+
+	f(s)
+	return s
+}
@@ -0,0 +1,52 @@
+package pubsub
+
+import (
+	"encoding/binary"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	api "github.com/wetware/pkg/api/pubsub"
+)
+
+// Message is a pubsub message, along with the identity of its publisher.
+type Message struct {
+	Data  []byte
+	From  peer.ID // publisher
+	Seqno uint64  // sequence number assigned by the publisher
+	Seq   uint64  // position in the topic's retained history; zero if none
+}
+
+// ReadMessage decodes a message.  The data is not copied, and callers
+// MUST NOT retain it after the message's segment has been released.
+func ReadMessage(msg api.Message) (m Message, err error) {
+	if m.Data, err = msg.Data(); err != nil {
+		return
+	}
+
+	m.Seq = msg.Seq()
+
+	var from []byte
+	if from, err = msg.From(); err != nil || len(from) == 0 {
+		return
+	}
+
+	m.Seqno = msg.Seqno()
+	m.From, err = peer.IDFromBytes(from)
+	return
+}
+
+// writeMessage encodes a libp2p message into msg.
+func writeMessage(msg api.Message, m *pubsub.Message) error {
+	if err := msg.SetData(m.Data); err != nil {
+		return err
+	}
+
+	// The seqno is an 8-byte big-endian integer in the default
+	// message-ID scheme, but may be absent if messages are unsigned.
+	if len(m.Seqno) == 8 {
+		msg.SetSeqno(binary.BigEndian.Uint64(m.Seqno))
+	}
+
+	return msg.SetFrom(m.From)
+}
@@ -30,6 +30,7 @@ type TopicServer interface {
 	Name(context.Context, api.Topic_name) error
 	Publish(context.Context, api.Topic_publish) error
 	Subscribe(context.Context, api.Topic_subscribe) error
+	SetValidator(context.Context, api.Topic_setValidator) error
 }
 
 // NewTopic returns a Joiner (a capability client) from a JoinServer
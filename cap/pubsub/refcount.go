@@ -2,21 +2,30 @@ package pubsub
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	capnp "capnproto.org/go/capnp/v3"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
 	api "github.com/wetware/pkg/api/pubsub"
 	"github.com/wetware/pkg/util/log"
 )
 
 // topicManager is responsible for refcounting *pubsub.Topic instances.
+// Topics that retain history remain joined after their last capability
+// is released, so that their history survives until the manager is
+// closed.
 type topicManager struct {
-	mu     sync.Mutex
-	topics map[string]*capnp.WeakClient
+	mu       sync.Mutex
+	topics   map[string]*capnp.WeakClient
+	retained map[string]*history
+	closed   bool
 }
 
-func (tm *topicManager) GetOrCreate(ctx context.Context, log log.Logger, ps TopicJoiner, name string) (api.Topic, error) {
+// GetOrCreate returns the named topic, joining it if necessary.  If the
+// topic is joined, owner is recorded as the topic's owner.
+func (tm *topicManager) GetOrCreate(ctx context.Context, log log.Logger, ps TopicJoiner, name string, owner peer.ID, r Retention) (api.Topic, error) {
 	tm.mu.Lock()
 	defer tm.mu.Unlock()
 
@@ -27,7 +36,7 @@ func (tm *topicManager) GetOrCreate(ctx context.Context, log log.Logger, ps Topi
 
 	// Slow path; join the topic and add it to the map.
 
-	return tm.join(log, ps, name)
+	return tm.join(log, ps, name, owner, r)
 }
 
 // lookup an existing topic in the map.  Caller MUST hold mu.
@@ -42,37 +51,89 @@ func (tm *topicManager) lookup(name string) (t api.Topic) {
 	return
 }
 
-// join a topic and add it to the map.  Caller MUST hold mu.
-func (tm *topicManager) join(log log.Logger, ps TopicJoiner, name string) (topic api.Topic, err error) {
+// join a topic and add it to the map.  If r is enabled, the topic
+// records its history from the moment it is first joined.  Caller MUST
+// hold mu.
+func (tm *topicManager) join(log log.Logger, ps TopicJoiner, name string, owner peer.ID, r Retention) (api.Topic, error) {
+	h, err := tm.history(log, ps, name, r)
+	if err != nil {
+		return api.Topic{}, err
+	}
+
 	var t *pubsub.Topic
-	if t, err = ps.Join(name); err == nil {
-		// log = log.With("topic", name)
-		topic = tm.asCapability(log, t)
+	if h != nil {
+		t = h.topic
+	} else if t, err = ps.Join(name); err != nil {
+		return api.Topic{}, err
 	}
 
-	return
+	// log = log.With("topic", name)
+	server := &topicServer{
+		log:     log,
+		name:    name,
+		owner:   owner,
+		topic:   t,
+		history: h,
+		leave:   tm.leave,
+	}
+
+	if tv := validation(ps); tv != nil {
+		server.validator = &topicValidator{
+			log:   log,
+			topic: t.String(),
+			ps:    tv,
+		}
+	}
+
+	return tm.asCapability(server), nil
+}
+
+// history returns the retained history for the named topic, joining the
+// topic and recording its history if r is enabled.  It returns nil if
+// the topic does not retain history.  Caller MUST hold mu.
+func (tm *topicManager) history(log log.Logger, ps TopicJoiner, name string, r Retention) (*history, error) {
+	if h, ok := tm.retained[name]; ok {
+		return h, nil
+	}
+
+	if tm.closed || !r.Enabled() {
+		return nil, nil
+	}
+
+	t, err := ps.Join(name)
+	if err != nil {
+		return nil, err
+	}
 
+	h, err := newHistory(log, r, t)
+	if err != nil {
+		defer t.Close()
+		return nil, err
+	}
+
+	if tm.retained == nil {
+		tm.retained = make(map[string]*history)
+	}
+	tm.retained[name] = h
+
+	return h, nil
 }
 
-// returns a capability for the supplied topic.  Caller MUST hold mu.
-func (tm *topicManager) asCapability(log log.Logger, t *pubsub.Topic) api.Topic {
+// returns a capability for the supplied topic server.  The server's
+// name is the one under which the topic was requested, which may differ
+// from that of the underlying libp2p topic.  Caller MUST hold mu.
+func (tm *topicManager) asCapability(server *topicServer) api.Topic {
 	if tm.topics == nil {
 		tm.topics = make(map[string]*capnp.WeakClient)
 	}
 
-	topic := tm.newClient(log, t)
-	tm.topics[t.String()] = capnp.Client(topic).WeakRef()
+	topic := tm.newClient(server)
+	tm.topics[server.name] = capnp.Client(topic).WeakRef()
 
 	return topic
 }
 
-func (tm *topicManager) newClient(log log.Logger, t *pubsub.Topic) api.Topic {
-	server := &topicServer{
-		log:   log,
-		topic: t,
-		leave: tm.leave,
-	}
-
+func (tm *topicManager) newClient(server *topicServer) api.Topic {
 	hook := &managedServer{
 		mu:         &tm.mu,
 		ClientHook: api.Topic_NewServer(server),
@@ -81,11 +142,55 @@ func (tm *topicManager) newClient(log log.Logger, t *pubsub.Topic) api.Topic {
 	return api.Topic(capnp.NewClient(hook))
 }
 
-func (tm *topicManager) leave(t *pubsub.Topic) error {
-	delete(tm.topics, t.String())
+// validation returns the TopicValidator underlying ps, or nil if
+// ps does not support validation.
+func validation(ps TopicJoiner) TopicValidator {
+	if ns, ok := ps.(namespace); ok {
+		ps = ns.TopicJoiner
+	}
+
+	tv, _ := ps.(TopicValidator)
+	return tv
+}
+
+// leave the topic.  It is called by the topic server's Shutdown method,
+// which runs asynchronously after the last reference is released, so
+// it must acquire mu.
+func (tm *topicManager) leave(name string, t *pubsub.Topic) error {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	delete(tm.topics, name)
+
+	// Topics that retain history are closed by tm.Close.
+	if _, ok := tm.retained[name]; ok {
+		return nil
+	}
+
 	return t.Close()
 }
 
+// Close stops recording history, and leaves the topics that are only
+// joined in order to retain it.  Topics with outstanding capabilities
+// are left when their last capability is released.
+func (tm *topicManager) Close() error {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	var errs []error
+	for name, h := range tm.retained {
+		delete(tm.retained, name)
+		h.Close()
+
+		if tm.topics[name] == nil {
+			errs = append(errs, h.topic.Close())
+		}
+	}
+
+	tm.closed = true
+	return errors.Join(errs...)
+}
+
 // managedServer is a capnp.ClientHook that locks the topic manager
 // during shutdown.
 type managedServer struct {
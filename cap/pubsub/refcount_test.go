@@ -42,7 +42,7 @@ func TestTopicManager(t *testing.T) {
 		joiner, release := newGossipSub(ctx)
 		defer release()
 
-		topic, err := manager.GetOrCreate(ctx, slog.Default(), joiner, name)
+		topic, err := manager.GetOrCreate(ctx, slog.Default(), joiner, name, "", Retention{})
 		require.NoError(t, err, "should create new topic")
 		defer topic.Release()
 
@@ -68,7 +68,7 @@ func TestTopicManager(t *testing.T) {
 
 		var ts []api.Topic
 		for i := 0; i < n; i++ {
-			topic, err := manager.GetOrCreate(ctx, slog.Default(), joiner, name)
+			topic, err := manager.GetOrCreate(ctx, slog.Default(), joiner, name, "", Retention{})
 			require.NoError(t, err, "should get existing topic")
 			defer topic.Release()
 			ts = append(ts, topic)
@@ -106,12 +106,12 @@ func TestTopicManager(t *testing.T) {
 		joiner, release := newGossipSub(ctx)
 		defer release()
 
-		topic, err := manager.GetOrCreate(ctx, logger, joiner, name)
+		topic, err := manager.GetOrCreate(ctx, logger, joiner, name, "", Retention{})
 		require.NoError(t, err, "should create new topic")
 		defer topic.Release()
 
 		// create a second client to ensure the topic stays alive
-		t2, err := manager.GetOrCreate(ctx, logger, joiner, name)
+		t2, err := manager.GetOrCreate(ctx, logger, joiner, name, "", Retention{})
 		require.NoError(t, err, "should get existing topic")
 		defer t2.Release()
 
@@ -121,7 +121,7 @@ func TestTopicManager(t *testing.T) {
 
 		// check that we can still get a reference to the topic
 		require.NotPanics(t, func() {
-			t3, err := manager.GetOrCreate(ctx, logger, joiner, name)
+			t3, err := manager.GetOrCreate(ctx, logger, joiner, name, "", Retention{})
 			assert.NoError(t, err, "should get existing topic")
 			t3.Release()
 		})
@@ -2,11 +2,14 @@ package pubsub
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"log/slog"
 
 	capnp "capnproto.org/go/capnp/v3"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
 	api "github.com/wetware/pkg/api/pubsub"
 	"github.com/wetware/pkg/util/log"
 )
@@ -47,22 +50,65 @@ type TopicJoiner interface {
 type Server struct {
 	Log         log.Logger
 	TopicJoiner TopicJoiner
-	topics      topicManager
+
+	// NS namespaces the topics joined through the server.  If NS is
+	// non-empty, joining topic "foo" joins "<NS>/foo" on the underlying
+	// TopicJoiner.  This prevents callers from joining topics that are
+	// used internally by the host, such as the cluster heartbeat topic.
+	// Topic names reported to callers are never prefixed.
+	NS string
+
+	// ACL determines the permissions of each account over a topic.
+	// It is only consulted by routers returned from Router.  If ACL
+	// is nil, accounts may publish and subscribe to any topic, but only
+	// the topic's owner may install a validator.  The owner is the
+	// account that first joined the topic.
+	ACL ACL
+
+	// Retention selects the topics that retain history, allowing
+	// subscribers to replay messages published before they joined.
+	// A topic's history is retained from the moment it is first joined
+	// until the server is closed, regardless of whether the topic is
+	// in use.  If Retention is nil, no history is retained.
+	Retention RetentionPolicy
+
+	topics topicManager
+}
+
+// Close stops retaining topic history.  It does not affect topics that
+// are currently joined.
+func (r *Server) Close() error {
+	return r.topics.Close()
 }
 
+// PubSub returns a router that grants all permissions over all topics.
 func (r *Server) PubSub() Router {
 	return NewJoiner(r)
 }
 
+// Router returns a router for account.  Topics joined through it
+// are subject to the server's ACL.
+func (r *Server) Router(account peer.ID) Router {
+	return NewJoiner(accountRouter{
+		Server:  r,
+		account: account,
+	})
+}
+
 func (r *Server) Client() capnp.Client {
 	return capnp.Client(r.PubSub())
 }
 
 func (r *Server) Join(ctx context.Context, call api.Router_join) error {
-	if r.Log == nil {
-		r.Log = slog.Default()
-	}
+	return r.join(ctx, call, "", func(string, peer.ID) Permission {
+		return PermAll
+	})
+}
 
+// join the topic named in call on behalf of account.  The account's
+// permissions are determined by acl, which is passed the topic's name
+// and owner.
+func (r *Server) join(ctx context.Context, call api.Router_join, account peer.ID, acl func(string, peer.ID) Permission) error {
 	res, err := call.AllocResults()
 	if err != nil {
 		return err
@@ -73,10 +119,86 @@ func (r *Server) Join(ctx context.Context, call api.Router_join) error {
 		return err
 	}
 
-	t, err := r.topics.GetOrCreate(ctx, r.Log, r.TopicJoiner, name)
-	if err == nil {
+	if name == "" {
+		return errors.New("empty topic name")
+	}
+
+	// Check permissions before joining, so that accounts without any
+	// rights over the topic can neither join nor claim it.
+	if acl(name, account) == PermNone {
+		return fmt.Errorf("%w: topic %s", ErrPermission, name)
+	}
+
+	t, err := r.topics.GetOrCreate(ctx, r.logger(), r.joiner(), name, account, r.retention(name))
+	if err != nil {
+		return err
+	}
+
+	perm := PermAll
+	if s, ok := serverOf(t); ok {
+		perm = acl(name, s.owner)
+	}
+
+	if t, err = newGuard(perm, t); err == nil {
 		err = res.SetTopic(t)
 	}
 
 	return err
 }
+
+// accountRouter joins topics on behalf of an account.
+type accountRouter struct {
+	*Server
+	account peer.ID
+}
+
+func (r accountRouter) Join(ctx context.Context, call api.Router_join) error {
+	return r.join(ctx, call, r.account, func(topic string, owner peer.ID) Permission {
+		if r.ACL != nil {
+			return r.ACL(r.account, topic)
+		}
+
+		if owner == r.account {
+			return PermAll
+		}
+
+		return PermPublish | PermSubscribe
+	})
+}
+
+func (r *Server) logger() log.Logger {
+	if r.Log == nil {
+		return slog.Default()
+	}
+
+	return r.Log
+}
+
+func (r *Server) retention(topic string) Retention {
+	if r.Retention == nil {
+		return Retention{}
+	}
+
+	return r.Retention(topic)
+}
+
+func (r *Server) joiner() TopicJoiner {
+	if r.NS == "" {
+		return r.TopicJoiner
+	}
+
+	return namespace{
+		prefix:      r.NS + "/",
+		TopicJoiner: r.TopicJoiner,
+	}
+}
+
+// namespace is a TopicJoiner that prefixes topic names.
+type namespace struct {
+	prefix string
+	TopicJoiner
+}
+
+func (ns namespace) Join(name string, opt ...pubsub.TopicOpt) (*pubsub.Topic, error) {
+	return ns.TopicJoiner.Join(ns.prefix+name, opt...)
+}
@@ -6,6 +6,7 @@ import (
 
 	capnp "capnproto.org/go/capnp/v3"
 	"github.com/golang/mock/gomock"
+	local_pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/stretchr/testify/require"
 
 	"github.com/wetware/pkg/cap/pubsub"
@@ -48,3 +49,46 @@ func TestRouter(t *testing.T) {
 	require.NoError(t, err, "should resolve topic capability")
 	require.True(t, capnp.Client(topic).IsValid(), "client should be valid")
 }
+
+func TestRouter_Namespace(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ps, release := newGossipSub(ctx)
+	defer release()
+
+	var joined []string
+	r := &pubsub.Server{
+		TopicJoiner: joinFunc(func(name string, opt ...local_pubsub.TopicOpt) (*local_pubsub.Topic, error) {
+			joined = append(joined, name)
+			return ps.Join(name, opt...)
+		}),
+		NS: "ns",
+	}
+
+	joiner := r.PubSub()
+	defer joiner.Release()
+
+	topic, release := joiner.Join(ctx, "test")
+	defer release()
+
+	name, err := topic.Name(ctx)
+	require.NoError(t, err, "should return topic name")
+	require.Equal(t, "test", name, "name should not be prefixed")
+	require.Equal(t, []string{"ns/test"}, joined,
+		"should join namespaced topic")
+
+	empty, release := joiner.Join(ctx, "")
+	defer release()
+
+	_, err = empty.Name(ctx)
+	require.Error(t, err, "should reject empty topic name")
+}
+
+type joinFunc func(string, ...local_pubsub.TopicOpt) (*local_pubsub.Topic, error)
+
+func (join joinFunc) Join(name string, opt ...local_pubsub.TopicOpt) (*local_pubsub.Topic, error) {
+	return join(name, opt...)
+}
@@ -9,22 +9,29 @@ import (
 )
 
 // Subscription is a stateful iterator over a stream of topic messages.
-type Subscription casm.Iterator[[]byte]
+type Subscription casm.Iterator[Message]
 
-// Next blocks until the next message is received, and returns it.  It
-// returns nil when the subscription is canceled.
+// Next blocks until the next message is received, and returns its data.
+// It returns nil when the subscription is canceled.
 func (sub Subscription) Next() []byte {
-	b, _ := casm.Iterator[[]byte](sub).Next()
-	return b
+	msg, _ := sub.NextMessage()
+	return msg.Data
+}
+
+// NextMessage blocks until the next message is received, and returns
+// it along with the identity of its publisher.  The boolean is false
+// when the subscription is canceled.
+func (sub Subscription) NextMessage() (Message, bool) {
+	return casm.Iterator[Message](sub).Next()
 }
 
 // Err returns the first non-nil error encountered by the subscription.
 // If there is no error, Err() returns nil.
 func (sub Subscription) Err() error {
-	return casm.Iterator[[]byte](sub).Err()
+	return casm.Iterator[Message](sub).Err()
 }
 
-type consumer chan []byte
+type consumer chan Message
 
 func (ch consumer) Params(ps api.Topic_subscribe_Params) error {
 	ps.SetBuf(uint16(cap(ch)))
@@ -33,25 +40,31 @@ func (ch consumer) Params(ps api.Topic_subscribe_Params) error {
 
 func (ch consumer) Shutdown() { close(ch) }
 
-func (ch consumer) Next() (b []byte, ok bool) {
-	b, ok = <-ch
+func (ch consumer) Next() (msg Message, ok bool) {
+	msg, ok = <-ch
 	return
 }
 
 func (ch consumer) Consume(ctx context.Context, call api.Topic_Consumer_consume) error {
-	msg, err := call.Args().Msg()
+	ptr, err := call.Args().Msg()
+	if err != nil {
+		return err
+	}
+
+	msg, err := ReadMessage(ptr)
 	if err != nil {
 		return err
 	}
 
 	// Copy the message data.  The segment will be zeroed when Send returns.
-	buf := bufferpool.Default.Get(len(msg))
-	copy(buf, msg)
+	buf := bufferpool.Default.Get(len(msg.Data))
+	copy(buf, msg.Data)
+	msg.Data = buf
 
 	// It's okay to block here, since there is only one writer.
 	// Back-pressure will be handled by the BBR flow-limiter.
 	select {
-	case ch <- buf:
+	case ch <- msg:
 		return nil
 	case <-ctx.Done():
 		return ctx.Err()
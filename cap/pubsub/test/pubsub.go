@@ -100,6 +100,20 @@ func (mr *MockTopicServerMockRecorder) Publish(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockTopicServer)(nil).Publish), arg0, arg1)
 }
 
+// SetValidator mocks base method.
+func (m *MockTopicServer) SetValidator(arg0 context.Context, arg1 pubsub.Topic_setValidator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetValidator", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetValidator indicates an expected call of SetValidator.
+func (mr *MockTopicServerMockRecorder) SetValidator(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetValidator", reflect.TypeOf((*MockTopicServer)(nil).SetValidator), arg0, arg1)
+}
+
 // Subscribe mocks base method.
 func (m *MockTopicServer) Subscribe(arg0 context.Context, arg1 pubsub.Topic_subscribe) error {
 	m.ctrl.T.Helper()
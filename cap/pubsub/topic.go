@@ -4,10 +4,12 @@ package pubsub
 
 import (
 	"context"
+	"time"
 
 	"capnproto.org/go/capnp/v3"
 	"capnproto.org/go/capnp/v3/flowcontrol"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
 
 	api "github.com/wetware/pkg/api/pubsub"
 	"github.com/wetware/pkg/util/casm"
@@ -49,6 +51,14 @@ func (t Topic) Publish(ctx context.Context, b []byte) error {
 // Subscribe to the topic.  Callers MUST call the provided ReleaseFunc
 // when finished with the subscription, or a resource leak will occur.
 func (t Topic) Subscribe(ctx context.Context) (Subscription, capnp.ReleaseFunc) {
+	return t.SubscribeSince(ctx, Live)
+}
+
+// SubscribeSince subscribes to the topic, first replaying the retained
+// messages selected by since.  The subscription fails if since is not
+// Live and the topic does not retain history.  Callers MUST call the
+// provided ReleaseFunc when finished with the subscription.
+func (t Topic) SubscribeSince(ctx context.Context, since Since) (Subscription, capnp.ReleaseFunc) {
 	// Aborting early simplifies the lifecycle logic for the handler.
 	// We still invoke api.Topic.Subscribe() in order to report the
 	// null capability error to the caller.
@@ -63,7 +73,13 @@ func (t Topic) Subscribe(ctx context.Context) (Subscription, capnp.ReleaseFunc)
 
 	var (
 		c          = make(consumer, 16)
-		f, release = api.Topic(t).Subscribe(ctx, c.Params)
+		f, release = api.Topic(t).Subscribe(ctx, func(ps api.Topic_subscribe_Params) error {
+			if err := c.Params(ps); err != nil {
+				return err
+			}
+
+			return since.bind(ps)
+		})
 	)
 
 	return Subscription{
@@ -75,6 +91,62 @@ func (t Topic) Subscribe(ctx context.Context) (Subscription, capnp.ReleaseFunc)
 		}
 }
 
+// SetValidator installs a validator for the topic, replacing any existing
+// one.  Passing a null validator removes the current validator.  The
+// validator is removed when the last reference to the topic is released.
+func (t Topic) SetValidator(ctx context.Context, v Validator) error {
+	f, release := api.Topic(t).SetValidator(ctx, func(ps api.Topic_setValidator_Params) error {
+		return ps.SetValidator(api.Validator(v).AddRef())
+	})
+	defer release()
+
+	_, err := f.Struct()
+	return err
+}
+
+// Since selects the retained messages that are replayed to a new
+// subscriber.  See SinceSeq and SinceTime.
+type Since struct {
+	which api.Topic_Since_Which
+	seq   uint64
+	time  time.Time
+}
+
+// Live subscriptions do not replay any messages.
+var Live = Since{which: api.Topic_Since_Which_live}
+
+// SinceSeq replays retained messages whose Seq is greater than seq.
+// Subscribers can resume after a disconnect by passing the Seq of the
+// last message they received.  SinceSeq(0) replays all retained messages.
+func SinceSeq(seq uint64) Since {
+	return Since{which: api.Topic_Since_Which_seq, seq: seq}
+}
+
+// SinceTime replays retained messages received at or after t.
+func SinceTime(t time.Time) Since {
+	return Since{which: api.Topic_Since_Which_time, time: t}
+}
+
+func (since Since) bind(ps api.Topic_subscribe_Params) error {
+	if since.which == api.Topic_Since_Which_live {
+		return nil // default
+	}
+
+	s, err := ps.NewSince()
+	if err != nil {
+		return err
+	}
+
+	switch since.which {
+	case api.Topic_Since_Which_seq:
+		s.SetSeq(since.seq)
+	case api.Topic_Since_Which_time:
+		s.SetTime(since.time.UnixNano())
+	}
+
+	return nil
+}
+
 func message(b []byte) func(api.Topic_publish_Params) error {
 	return func(ps api.Topic_publish_Params) error {
 		return ps.SetMsg(b)
@@ -86,13 +158,25 @@ func message(b []byte) func(api.Topic_publish_Params) error {
 */
 
 type topicServer struct {
-	log   log.Logger
-	topic *pubsub.Topic
-	leave func(*pubsub.Topic) error
+	log       log.Logger
+	name      string
+	owner     peer.ID // account that joined the topic first
+	topic     *pubsub.Topic
+	validator *topicValidator // nil if validation is unsupported
+	history   *history        // nil if history is not retained; owned by topicManager
+	leave     func(string, *pubsub.Topic) error
 }
 
 func (t topicServer) Shutdown() {
-	if err := t.leave(t.topic); err != nil {
+	if t.validator != nil {
+		if err := t.validator.Close(); err != nil {
+			t.log.Warn("failed to remove validator",
+				"topic", t.name,
+				"error", err)
+		}
+	}
+
+	if err := t.leave(t.name, t.topic); err != nil {
 		panic(err) // invalid refcount
 	}
 }
@@ -100,7 +184,7 @@ func (t topicServer) Shutdown() {
 func (t topicServer) Name(_ context.Context, call api.Topic_name) error {
 	res, err := call.AllocResults()
 	if err == nil {
-		err = res.SetName(t.topic.String())
+		err = res.SetName(t.name)
 	}
 	return err
 }
@@ -124,7 +208,29 @@ func (t topicServer) Publish(ctx context.Context, call api.Topic_publish) error
 	return t.topic.Publish(ctx, msg)
 }
 
+func (t topicServer) SetValidator(ctx context.Context, call api.Topic_setValidator) error {
+	if t.validator == nil {
+		return ErrNoValidation
+	}
+
+	v := call.Args().Validator()
+	return t.validator.Set(v.AddRef())
+}
+
 func (t topicServer) Subscribe(ctx context.Context, call api.Topic_subscribe) error {
+	since, err := call.Args().Since()
+	if err != nil {
+		return err
+	}
+
+	if t.history != nil {
+		return t.replay(ctx, call, since)
+	}
+
+	if since.Which() != api.Topic_Since_Which_live {
+		return ErrNoHistory
+	}
+
 	// Subscribe can't be called with a released client, so there's no need to
 	// check the context before subscribing to the libp2p topic. We will catch
 	// context cancellations in the stream handler.
@@ -151,6 +257,32 @@ func (t topicServer) Subscribe(ctx context.Context, call api.Topic_subscribe) er
 	return consumer.WaitStreaming()
 }
 
+// replay the topic's history to the consumer, starting after the
+// messages selected by since, then continue with live messages.
+func (t topicServer) replay(ctx context.Context, call api.Topic_subscribe, since api.Topic_Since) error {
+	consumer := call.Args().Consumer()
+	consumer.SetFlowLimiter(flowcontrol.NewFixedLimiter(1e6)) // TODO:  use BBR once scheduler bug is fixed
+
+	cursor := t.history.Cursor(since)
+	t.log.Debug("registered replay handler", "cursor", cursor)
+	defer t.log.Debug("unregistered replay handler")
+
+	for call.Go(); ctx.Err() == nil; {
+		r, err := t.history.Next(ctx, cursor)
+		if err != nil {
+			break
+		}
+
+		if err = consumer.Consume(ctx, replay(r)); err != nil {
+			break
+		}
+
+		cursor = r.seq
+	}
+
+	return consumer.WaitStreaming()
+}
+
 func (t topicServer) subscribe(call api.Topic_subscribe) (*pubsub.Subscription, error) {
 	bufsize := int(call.Args().Buf())
 	return t.topic.Subscribe(pubsub.WithBufferSize(bufsize))
@@ -166,7 +298,22 @@ func bind(ctx context.Context, sub *pubsub.Subscription) func(api.Topic_Consumer
 	}
 
 	return func(ps api.Topic_Consumer_consume_Params) error {
-		return ps.SetMsg(msg.Data)
+		m, err := ps.NewMsg()
+		if err == nil {
+			err = writeMessage(m, msg)
+		}
+		return err
+	}
+}
+
+func replay(r record) func(api.Topic_Consumer_consume_Params) error {
+	return func(ps api.Topic_Consumer_consume_Params) error {
+		m, err := ps.NewMsg()
+		if err == nil {
+			m.SetSeq(r.seq)
+			err = writeMessage(m, r.msg)
+		}
+		return err
 	}
 }
 
@@ -0,0 +1,167 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	capnp "capnproto.org/go/capnp/v3"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	api "github.com/wetware/pkg/api/pubsub"
+	"github.com/wetware/pkg/util/log"
+)
+
+const (
+	// ValidatorTimeout bounds the time spent validating a message.
+	// Messages whose validation times out are ignored.
+	ValidatorTimeout = time.Second
+
+	// ValidatorConcurrency bounds the number of messages validated
+	// concurrently on a topic.  Messages are dropped when the limit is
+	// reached, so that a slow validator cannot stall the pubsub router.
+	ValidatorConcurrency = 64
+)
+
+// ErrNoValidation is returned when installing a validator on a topic
+// whose TopicJoiner does not support validation.
+var ErrNoValidation = errors.New("validation not supported")
+
+// Validator is a capability that decides whether a message should be
+// delivered to subscribers and forwarded to peers.
+type Validator api.Validator
+
+func (v Validator) AddRef() Validator {
+	return Validator(capnp.Client(v).AddRef())
+}
+
+func (v Validator) Release() {
+	capnp.Client(v).Release()
+}
+
+// ValidatorFunc is a Validator implemented by a function.
+type ValidatorFunc func(context.Context, Message) api.Validator_Result
+
+// NewValidator returns a Validator capability for f.
+func NewValidator(f ValidatorFunc) Validator {
+	return Validator(api.Validator_ServerToClient(f))
+}
+
+func (validate ValidatorFunc) Validate(ctx context.Context, call api.Validator_validate) error {
+	msg, err := call.Args().Msg()
+	if err != nil {
+		return err
+	}
+
+	m, err := ReadMessage(msg)
+	if err != nil {
+		return err
+	}
+
+	res, err := call.AllocResults()
+	if err == nil {
+		res.SetResult(validate(ctx, m))
+	}
+
+	return err
+}
+
+// TopicValidator can register libp2p topic validators.  It is an
+// optional interface for TopicJoiner, and is satisfied by the libp2p
+// PubSub type.
+type TopicValidator interface {
+	RegisterTopicValidator(string, interface{}, ...pubsub.ValidatorOpt) error
+	UnregisterTopicValidator(string) error
+}
+
+// topicValidator binds a Validator capability to the validation
+// pipeline of a libp2p topic.
+type topicValidator struct {
+	log   log.Logger
+	topic string
+	ps    TopicValidator
+
+	mu        sync.Mutex
+	validator api.Validator
+}
+
+// Set the validator, replacing any existing one.  A null validator
+// clears the current one.  Set steals the reference to v.
+func (tv *topicValidator) Set(v api.Validator) error {
+	tv.mu.Lock()
+	defer tv.mu.Unlock()
+
+	if err := tv.reset(); err != nil {
+		v.Release()
+		return err
+	}
+
+	if !v.IsValid() {
+		return nil
+	}
+
+	if err := tv.ps.RegisterTopicValidator(tv.topic, tv.bind(v),
+		pubsub.WithValidatorTimeout(ValidatorTimeout),
+		pubsub.WithValidatorConcurrency(ValidatorConcurrency)); err != nil {
+		v.Release()
+		return err
+	}
+
+	tv.validator = v
+	return nil
+}
+
+// Close removes the current validator, if any.
+func (tv *topicValidator) Close() error {
+	tv.mu.Lock()
+	defer tv.mu.Unlock()
+
+	return tv.reset()
+}
+
+// reset unregisters the current validator.  Caller MUST hold mu.
+func (tv *topicValidator) reset() error {
+	if !tv.validator.IsValid() {
+		return nil
+	}
+
+	defer func() {
+		tv.validator.Release()
+		tv.validator = api.Validator{}
+	}()
+
+	return tv.ps.UnregisterTopicValidator(tv.topic)
+}
+
+func (tv *topicValidator) bind(v api.Validator) pubsub.ValidatorEx {
+	return func(ctx context.Context, _ peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+		f, release := v.Validate(ctx, func(ps api.Validator_validate_Params) error {
+			m, err := ps.NewMsg()
+			if err == nil {
+				err = writeMessage(m, msg)
+			}
+			return err
+		})
+		defer release()
+
+		res, err := f.Struct()
+		if err != nil {
+			tv.log.Debug("validation failed",
+				"topic", tv.topic,
+				"error", err)
+			return pubsub.ValidationIgnore
+		}
+
+		switch res.Result() {
+		case api.Validator_Result_accept:
+			return pubsub.ValidationAccept
+
+		case api.Validator_Result_reject:
+			return pubsub.ValidationReject
+		}
+
+		return pubsub.ValidationIgnore
+	}
+}
@@ -0,0 +1,112 @@
+package pubsub_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	capnp "capnproto.org/go/capnp/v3"
+	local_pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/wetware/pkg/api/pubsub"
+	"github.com/wetware/pkg/cap/pubsub"
+)
+
+func TestValidator(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHost()
+	defer h.Close()
+
+	gs, err := local_pubsub.NewGossipSub(context.Background(), h)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	ps := (&pubsub.Server{TopicJoiner: gs}).PubSub()
+	defer ps.Release()
+
+	topic, release := ps.Join(ctx, "test")
+	defer release()
+
+	origin := make(chan peer.ID, 1)
+	v := pubsub.NewValidator(func(ctx context.Context, msg pubsub.Message) api.Validator_Result {
+		select {
+		case origin <- msg.From:
+		default:
+		}
+
+		if string(msg.Data) == "good" {
+			return api.Validator_Result_accept
+		}
+
+		return api.Validator_Result_reject
+	})
+	defer v.Release()
+
+	err = topic.SetValidator(ctx, v)
+	require.NoError(t, err, "should set validator")
+
+	sub, release := topic.Subscribe(ctx)
+	defer release()
+
+	// The subscription is registered asynchronously; keep publishing
+	// until a message is received.
+	go func() {
+		for ctx.Err() == nil {
+			if topic.Publish(ctx, []byte("good")) != nil {
+				return
+			}
+			time.Sleep(time.Millisecond * 10)
+		}
+	}()
+
+	msg, ok := sub.NextMessage()
+	require.True(t, ok, "should receive message")
+	assert.Equal(t, "good", string(msg.Data))
+	assert.Equal(t, h.ID(), msg.From, "should report publisher")
+	assert.NotZero(t, msg.Seqno, "should report sequence number")
+	assert.Equal(t, h.ID(), <-origin, "validator should see publisher")
+
+	// Locally published messages are validated synchronously, so the
+	// rejection is reported to the publisher.
+	cancel() // stop publishing
+	bad, release := ps.Join(context.Background(), "test")
+	defer release()
+
+	err = bad.Publish(context.Background(), []byte("bad"))
+	if err == nil {
+		err = capnp.Client(bad).WaitStreaming()
+	}
+	assert.Error(t, err, "should reject invalid message")
+}
+
+func TestValidator_unsupported(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	gs, release := newGossipSub(ctx)
+	defer release()
+
+	// Hide the validation methods of the libp2p router.
+	r := &pubsub.Server{TopicJoiner: joinFunc(gs.Join)}
+	ps := r.PubSub()
+	defer ps.Release()
+
+	topic, release := ps.Join(ctx, "test")
+	defer release()
+
+	v := pubsub.NewValidator(func(context.Context, pubsub.Message) api.Validator_Result {
+		return api.Validator_Result_accept
+	})
+	defer v.Release()
+
+	err := topic.SetValidator(ctx, v)
+	require.ErrorContains(t, err, pubsub.ErrNoValidation.Error())
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// cache of the signed locations observed on each topic.  Locations are
+// collapsed by provider, such that each provider's most recent location
+// is retained until it expires or is withdrawn.  Withdrawn locations are
+// replaced by a tombstone, so that they are not restored by responses
+// that were delayed in transit.
+type cache struct {
+	mu     sync.Mutex
+	topics map[string]*providers
+}
+
+// providers of a topic.  If watched is true, a goroutine is watching
+// the topic for new locations and withdrawals.
+type providers struct {
+	entries map[peer.ID]entry
+	watched bool
+}
+
+type entry struct {
+	envelope  []byte
+	seq       uint64
+	expires   time.Time
+	withdrawn bool
+}
+
+// supersedes reports whether e should replace old.
+func (e entry) supersedes(old entry) bool {
+	if old.withdrawn {
+		return e.seq > old.seq
+	}
+
+	return e.seq >= old.seq
+}
+
+// put the provider's location into the cache, unless a more recent one
+// is already present.  It returns true if the caller should start
+// watching the topic.
+func (c *cache) put(topic string, id peer.ID, e entry) (watch bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.topics == nil {
+		c.topics = make(map[string]*providers)
+	}
+
+	ps := c.topics[topic]
+	if ps == nil {
+		ps = &providers{entries: make(map[peer.ID]entry)}
+		c.topics[topic] = ps
+	}
+
+	if old, ok := ps.entries[id]; !ok || e.supersedes(old) {
+		ps.entries[id] = e
+	}
+
+	watch = !ps.watched
+	ps.watched = true
+	return
+}
+
+// withdraw the provider's locations whose sequence number is less than
+// or equal to seq.
+func (c *cache) withdraw(topic string, id peer.ID, seq uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	tombstone := entry{
+		seq:       seq,
+		expires:   time.Now().Add(DefaultTTL),
+		withdrawn: true,
+	}
+
+	if ps := c.topics[topic]; ps != nil {
+		if old, ok := ps.entries[id]; !ok || tombstone.supersedes(old) {
+			ps.entries[id] = tombstone
+		}
+	}
+}
+
+// get the unexpired locations for the topic.
+func (c *cache) get(topic string) map[peer.ID][]byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ps := c.topics[topic]
+	if ps == nil {
+		return nil
+	}
+
+	ps.expire(time.Now())
+
+	es := make(map[peer.ID][]byte, len(ps.entries))
+	for id, e := range ps.entries {
+		if !e.withdrawn {
+			es[id] = e.envelope
+		}
+	}
+
+	return es
+}
+
+// deadline returns the time at which the last of the topic's locations
+// expires.  If the topic has no locations, deadline returns false, and
+// the topic is no longer considered to be watched.
+func (c *cache) deadline(topic string) (t time.Time, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ps := c.topics[topic]
+	if ps == nil {
+		return
+	}
+
+	if ps.expire(time.Now()); len(ps.entries) == 0 {
+		delete(c.topics, topic)
+		return
+	}
+
+	for _, e := range ps.entries {
+		if e.expires.After(t) {
+			t = e.expires
+		}
+	}
+
+	return t, true
+}
+
+// drop the topic's locations.  This is called when the topic can no
+// longer be watched, since withdrawals would go unnoticed.
+func (c *cache) drop(topic string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.topics, topic)
+}
+
+// expire locations whose TTL has elapsed.  Caller MUST hold mu.
+func (ps *providers) expire(now time.Time) {
+	for id, e := range ps.entries {
+		if !now.Before(e.expires) {
+			delete(ps.entries, id)
+		}
+	}
+}
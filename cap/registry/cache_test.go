@@ -0,0 +1,61 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	const topic = "test"
+	expires := time.Now().Add(time.Minute)
+
+	t.Run("Collapse", func(t *testing.T) {
+		t.Parallel()
+
+		var c cache
+		assert.True(t, c.put(topic, "alice", entry{envelope: []byte("v2"), seq: 2, expires: expires}),
+			"should watch new topic")
+		assert.False(t, c.put(topic, "alice", entry{envelope: []byte("v1"), seq: 1, expires: expires}),
+			"should not watch topic twice")
+		c.put(topic, "bob", entry{envelope: []byte("v1"), seq: 1, expires: expires})
+
+		got := c.get(topic)
+		require.Len(t, got, 2, "should collapse locations by provider")
+		assert.Equal(t, "v2", string(got["alice"]), "should keep most recent location")
+	})
+
+	t.Run("Withdraw", func(t *testing.T) {
+		t.Parallel()
+
+		var c cache
+		c.put(topic, "alice", entry{envelope: []byte("v1"), seq: 1, expires: expires})
+		c.withdraw(topic, "alice", 1)
+		assert.Empty(t, c.get(topic), "should evict withdrawn location")
+
+		c.put(topic, "alice", entry{envelope: []byte("v1"), seq: 1, expires: expires})
+		assert.Empty(t, c.get(topic), "should not restore withdrawn location")
+
+		c.put(topic, "alice", entry{envelope: []byte("v2"), seq: 2, expires: expires})
+		assert.Len(t, c.get(topic), 1, "should accept newer location")
+	})
+
+	t.Run("Expire", func(t *testing.T) {
+		t.Parallel()
+
+		var c cache
+		c.put(topic, "alice", entry{seq: 1, expires: time.Now()})
+
+		assert.Empty(t, c.get(topic), "should expire location")
+
+		_, ok := c.deadline(topic)
+		assert.False(t, ok, "should stop watching empty topic")
+		assert.True(t, c.put(topic, "alice", entry{seq: 1, expires: expires}),
+			"should watch topic again")
+	})
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"capnproto.org/go/capnp/v3"
 	"github.com/libp2p/go-libp2p/core/record"
@@ -15,15 +17,22 @@ import (
 
 type Registry api.Registry
 
+func (c Registry) AddRef() Registry {
+	return Registry(api.Registry(c).AddRef())
+}
+
 func (c Registry) Release() {
 	api.Registry(c).Release()
 }
 
-func (c Registry) Provide(ctx context.Context, topic pubsub.Topic, e *record.Envelope) (casm.Future, capnp.ReleaseFunc) {
+// Provide advertises the signed location in e until the returned
+// release function is called.  If withdrawal is non-nil, it is published
+// when the location is no longer provided.  See Seal.
+func (c Registry) Provide(ctx context.Context, topic pubsub.Topic, e, withdrawal *record.Envelope) (casm.Future, capnp.ReleaseFunc) {
 	ctx, cancel := context.WithCancel(ctx)
 
 	fut, release := api.Registry(c).Provide(ctx, func(ps api.Registry_provide_Params) error {
-		if err := ps.SetTopic(ps_api.Topic(topic)); err != nil {
+		if err := ps.SetTopic(ps_api.Topic(topic.AddRef())); err != nil {
 			return err
 		}
 
@@ -31,7 +40,14 @@ func (c Registry) Provide(ctx context.Context, topic pubsub.Topic, e *record.Env
 		if err != nil {
 			return err
 		}
-		return ps.SetEnvelope(b)
+		if err = ps.SetEnvelope(b); err != nil || withdrawal == nil {
+			return err
+		}
+
+		if b, err = withdrawal.Marshal(); err != nil {
+			return err
+		}
+		return ps.SetWithdrawal(b)
 	})
 
 	return casm.Future(fut), func() {
@@ -40,7 +56,11 @@ func (c Registry) Provide(ctx context.Context, topic pubsub.Topic, e *record.Env
 	}
 }
 
-func (c Registry) FindProviders(ctx context.Context, topic pubsub.Topic) (casm.Iterator[Location], capnp.ReleaseFunc) {
+// FindProviders returns the locations of the topic's providers.  Each
+// provider is returned at most once.  The iterator is exhausted after
+// limit providers have been found, or after the context's deadline.
+// A zero limit means no limit.
+func (c Registry) FindProviders(ctx context.Context, topic pubsub.Topic, limit int) (casm.Iterator[Location], capnp.ReleaseFunc) {
 	ctx, cancel := context.WithCancel(ctx)
 
 	topicName, err := topic.Name(ctx)
@@ -56,9 +76,17 @@ func (c Registry) FindProviders(ctx context.Context, topic pubsub.Topic) (casm.I
 	handler := handler{ch: make(chan Location, 32), topic: topicName}
 
 	fut, release := api.Registry(c).FindProviders(ctx, func(ps api.Registry_findProviders_Params) error {
-		if err := ps.SetTopic(ps_api.Topic(topic)); err != nil {
+		if err := ps.SetTopic(ps_api.Topic(topic.AddRef())); err != nil {
 			return err
 		}
+
+		// Deadlines are not propagated by RPC, so we pass a timeout
+		// to the server.
+		if t, ok := ctx.Deadline(); ok {
+			ps.SetTimeout(uint32(max(time.Until(t)/time.Millisecond, 1)))
+		}
+
+		ps.SetLimit(uint32(limit))
 		return ps.SetChan(chan_api.Sender_ServerToClient(handler))
 	})
 
@@ -73,6 +101,53 @@ func (c Registry) FindProviders(ctx context.Context, topic pubsub.Topic) (casm.I
 	}
 }
 
+// Resolver dereferences the capability referenced by a location.  It is
+// satisfied by vat.Dialer.
+type Resolver interface {
+	Resolve(context.Context, Location) (capnp.Client, error)
+}
+
+// FindClients is like FindProviders, but yields the capabilities that are
+// referenced by the providers' locations, after resolving them with r.
+// Locations that do not reference a capability, or that fail to resolve,
+// are skipped.  The caller is responsible for releasing the clients.
+func (c Registry) FindClients(ctx context.Context, topic pubsub.Topic, limit int, r Resolver) (casm.Iterator[capnp.Client], capnp.ReleaseFunc) {
+	it, release := c.FindProviders(ctx, topic, limit)
+	return casm.Iterator[capnp.Client]{
+		Future: it.Future,
+		Seq: resolver{
+			ctx:      ctx,
+			it:       it,
+			Resolver: r,
+		},
+	}, release
+}
+
+type resolver struct {
+	ctx context.Context
+	it  casm.Iterator[Location]
+	Resolver
+}
+
+func (r resolver) Next() (capnp.Client, bool) {
+	for loc, ok := r.it.Next(); ok; loc, ok = r.it.Next() {
+		if loc.Which() != api.Location_Which_capability {
+			continue
+		}
+
+		c, err := r.Resolve(r.ctx, loc)
+		if err == nil {
+			return c, true
+		}
+
+		slog.Debug("failed to resolve location",
+			"provider", loc.Provider(),
+			"error", err)
+	}
+
+	return capnp.Client{}, false
+}
+
 type handler struct {
 	ch    chan Location
 	topic string
@@ -97,16 +172,10 @@ func (h handler) Send(ctx context.Context, call chan_api.Sender_send) error {
 	b := make([]byte, len(data))
 	copy(b, data)
 
-	// decode
-	var loc Location
-	_, err = record.ConsumeTypedEnvelope(b, &loc)
+	// decode and validate
+	loc, _, err := ConsumeLocation(b, h.topic)
 	if err != nil {
-		return fmt.Errorf("failed to consume typed envelope: %w", err)
-	}
-
-	// validate
-	if err := loc.Validate(h.topic); err != nil {
-		return fmt.Errorf("failed to validate location: %w", err)
+		return err
 	}
 
 	select {
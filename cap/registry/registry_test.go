@@ -3,14 +3,17 @@ package service_test
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
+	"capnproto.org/go/capnp/v3"
 	"github.com/libp2p/go-libp2p"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/record"
 	inproc "github.com/lthibault/go-libp2p-inproc-transport"
 	ma "github.com/multiformats/go-multiaddr"
@@ -57,12 +60,12 @@ func TestDiscover(t *testing.T) {
 	topic, release := ps.Join(ctx, serviceName)
 	defer release()
 
-	_, release = client.Provide(ctx, topic, e)
+	_, release = client.Provide(ctx, topic, e, nil)
 	defer release()
 
 	time.Sleep(time.Second) // give time for the provider to set
 
-	providers, release := client.FindProviders(ctx, topic)
+	providers, release := client.FindProviders(ctx, topic, 0)
 	defer release()
 
 	gotLocation, ok := providers.Next()
@@ -77,6 +80,334 @@ func TestDiscover(t *testing.T) {
 	require.EqualValues(t, expected, got)
 }
 
+func TestProvide_spoofed(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	gs, release := newGossipSub(ctx)
+	defer release()
+
+	ps := (&pscap.Server{TopicJoiner: gs}).PubSub()
+	defer ps.Release()
+
+	client := (&service.Server{}).Registry()
+	defer client.Release()
+
+	const serviceName = "service.test"
+
+	topic, release := ps.Join(ctx, serviceName)
+	defer release()
+
+	// The location advertises another peer's address.
+	victim, _, err := crypto.GenerateKeyPairWithReader(crypto.Ed25519, 2048, rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPrivateKey(victim)
+	require.NoError(t, err)
+
+	loc, err := service.NewLocation()
+	require.NoError(t, err)
+	require.NoError(t, loc.SetService(serviceName))
+	require.NoError(t, loc.SetMaddrs([]ma.Multiaddr{
+		ma.StringCast("/ip4/127.0.0.1/tcp/2020/p2p/" + id.String()),
+	}))
+
+	privKey, _, err := crypto.GenerateKeyPairWithReader(crypto.Ed25519, 2048, rand.Reader)
+	require.NoError(t, err)
+
+	e, err := record.Seal(&loc, privKey)
+	require.NoError(t, err)
+
+	f, release := client.Provide(ctx, topic, e, nil)
+	defer release()
+	require.ErrorContains(t, f.Await(ctx), service.ErrSpoofed.Error(),
+		"should reject location signed by another peer")
+
+	// The addresses of a capability reference must identify its host.
+	loc, err = service.NewLocation()
+	require.NoError(t, err)
+	require.NoError(t, loc.SetService(serviceName))
+	ref, err := loc.SetCapRef(peer.AddrInfo{
+		Addrs: []ma.Multiaddr{ma.StringCast("/ip4/127.0.0.1/tcp/2020/p2p/" + id.String())},
+	})
+	require.NoError(t, err)
+	require.NoError(t, ref.SetCell("/service"))
+
+	e, err = record.Seal(&loc, privKey)
+	require.NoError(t, err)
+
+	f, release = client.Provide(ctx, topic, e, nil)
+	defer release()
+	require.ErrorContains(t, f.Await(ctx), service.ErrSpoofed.Error(),
+		"should reject capability hosted by another peer")
+
+	// A location for a different service is rejected.
+	loc, err = generateLocation(1, "other.test")
+	require.NoError(t, err)
+
+	e, err = record.Seal(&loc, privKey)
+	require.NoError(t, err)
+
+	f, release = client.Provide(ctx, topic, e, nil)
+	defer release()
+	require.Error(t, f.Await(ctx), "should reject location for another service")
+
+	// A location whose TTL would cause it to be re-announced too
+	// frequently is rejected.
+	loc, err = generateLocation(1, serviceName)
+	require.NoError(t, err)
+	loc.SetTTL(time.Millisecond)
+
+	e, err = record.Seal(&loc, privKey)
+	require.NoError(t, err)
+
+	f, release = client.Provide(ctx, topic, e, nil)
+	defer release()
+	require.ErrorContains(t, f.Await(ctx), service.ErrTTL.Error(),
+		"should reject location with a TTL below the minimum")
+}
+
+// Invalid messages published on the topic are ignored by FindProviders.
+func TestFindProviders_invalid(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	gs, release := newGossipSub(ctx)
+	defer release()
+
+	ps := (&pscap.Server{TopicJoiner: gs}).PubSub()
+	defer ps.Release()
+
+	client := (&service.Server{}).Registry()
+	defer client.Release()
+
+	const serviceName = "service.test"
+
+	topic, release := ps.Join(ctx, serviceName)
+	defer release()
+
+	providers, release := client.FindProviders(ctx, topic, 0)
+	defer release()
+
+	time.Sleep(time.Millisecond * 100) // give time for the request to be sent
+
+	privKey, _, err := crypto.GenerateKeyPairWithReader(crypto.Ed25519, 2048, rand.Reader)
+	require.NoError(t, err)
+
+	spoofed, err := generateLocation(1, "other.test")
+	require.NoError(t, err)
+	bad, err := record.Seal(&spoofed, privKey)
+	require.NoError(t, err)
+
+	loc, err := generateLocation(1, serviceName)
+	require.NoError(t, err)
+	good, err := record.Seal(&loc, privKey)
+	require.NoError(t, err)
+
+	require.NoError(t, topic.Publish(ctx, []byte("garbage")))
+	require.NoError(t, topic.Publish(ctx, response(t, bad)))
+	require.NoError(t, topic.Publish(ctx, response(t, good)))
+
+	got, ok := providers.Next()
+	require.True(t, ok, "should receive valid location")
+
+	name, err := got.Service()
+	require.NoError(t, err)
+	require.Equal(t, serviceName, name)
+}
+
+func TestFindProviders_limit(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	gs, release := newGossipSub(ctx)
+	defer release()
+
+	ps := (&pscap.Server{TopicJoiner: gs}).PubSub()
+	defer ps.Release()
+
+	client := (&service.Server{}).Registry()
+	defer client.Release()
+
+	const serviceName = "service.test"
+
+	topic, release := ps.Join(ctx, serviceName)
+	defer release()
+
+	for i := 0; i < 2; i++ {
+		e, w := sealLocation(t, serviceName)
+		_, release := client.Provide(ctx, topic, e, w)
+		defer release()
+	}
+
+	providers, release := client.FindProviders(ctx, topic, 1)
+	defer release()
+
+	_, ok := providers.Next()
+	require.True(t, ok, "should find provider")
+
+	_, ok = providers.Next()
+	require.False(t, ok, "should stop after limit is reached")
+	require.NoError(t, providers.Err())
+}
+
+// Withdrawn providers are evicted from the cache of requesters.
+func TestFindProviders_withdraw(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	gs, release := newGossipSub(ctx)
+	defer release()
+
+	ps := (&pscap.Server{TopicJoiner: gs}).PubSub()
+	defer ps.Release()
+
+	client := (&service.Server{}).Registry()
+	defer client.Release()
+
+	const serviceName = "service.test"
+
+	topic, release := ps.Join(ctx, serviceName)
+	defer release()
+
+	e, w := sealLocation(t, serviceName)
+	_, withdraw := client.Provide(ctx, topic, e, w)
+	defer withdraw()
+
+	find := func(timeout time.Duration) (n int) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		providers, release := client.FindProviders(ctx, topic, 0)
+		defer release()
+
+		for _, ok := providers.Next(); ok; _, ok = providers.Next() {
+			n++
+		}
+		return
+	}
+
+	require.Equal(t, 1, find(time.Millisecond*500), "should find provider")
+
+	withdraw()
+
+	require.Eventually(t, func() bool {
+		return find(time.Millisecond*100) == 0
+	}, time.Second*5, time.Millisecond*10, "should not find withdrawn provider")
+}
+
+// FindClients resolves the locations that reference a capability.
+func TestFindClients(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	gs, release := newGossipSub(ctx)
+	defer release()
+
+	ps := (&pscap.Server{TopicJoiner: gs}).PubSub()
+	defer ps.Release()
+
+	client := (&service.Server{}).Registry()
+	defer client.Release()
+
+	const serviceName = "service.test"
+
+	topic, release := ps.Join(ctx, serviceName)
+	defer release()
+
+	// This provider's location does not reference a capability.
+	e, w := sealLocation(t, serviceName)
+	_, release = client.Provide(ctx, topic, e, w)
+	defer release()
+
+	loc, err := service.NewLocation()
+	require.NoError(t, err)
+	require.NoError(t, loc.SetService(serviceName))
+	ref, err := loc.SetCapRef(peer.AddrInfo{})
+	require.NoError(t, err)
+	require.NoError(t, ref.SetCell("/service"))
+
+	privKey, _, err := crypto.GenerateKeyPairWithReader(crypto.Ed25519, 2048, rand.Reader)
+	require.NoError(t, err)
+	signer, err := peer.IDFromPrivateKey(privKey)
+	require.NoError(t, err)
+
+	e, w, err = service.Seal(loc, privKey)
+	require.NoError(t, err)
+	_, release = client.Provide(ctx, topic, e, w)
+	defer release()
+
+	ctx, cancel = context.WithTimeout(ctx, time.Millisecond*500)
+	defer cancel()
+
+	var resolved []peer.ID
+	clients, release := client.FindClients(ctx, topic, 0, resolveFunc(func(ctx context.Context, loc service.Location) (capnp.Client, error) {
+		require.Equal(t, signer, loc.Provider(), "should report signer as provider")
+
+		host, err := loc.CapHost()
+		require.NoError(t, err)
+		resolved = append(resolved, host.ID)
+
+		return capnp.ErrorClient(errors.New("test")), nil
+	}))
+	defer release()
+
+	var n int
+	for c, ok := clients.Next(); ok; c, ok = clients.Next() {
+		c.Release()
+		n++
+	}
+
+	require.Equal(t, 1, n, "should only yield capability locations")
+	require.Equal(t, []peer.ID{signer}, resolved,
+		"should default to capabilities hosted by the signer")
+}
+
+type resolveFunc func(context.Context, service.Location) (capnp.Client, error)
+
+func (f resolveFunc) Resolve(ctx context.Context, loc service.Location) (capnp.Client, error) {
+	return f(ctx, loc)
+}
+
+func sealLocation(t *testing.T, serviceName string) (e, withdrawal *record.Envelope) {
+	t.Helper()
+
+	loc, err := generateLocation(1, serviceName)
+	require.NoError(t, err)
+
+	privKey, _, err := crypto.GenerateKeyPairWithReader(crypto.Ed25519, 2048, rand.Reader)
+	require.NoError(t, err)
+
+	e, withdrawal, err = service.Seal(loc, privKey)
+	require.NoError(t, err)
+	return
+}
+
+func response(t *testing.T, e *record.Envelope) []byte {
+	t.Helper()
+
+	b, err := e.Marshal()
+	require.NoError(t, err)
+
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	msg, err := api.NewRootMessage(seg)
+	require.NoError(t, err)
+	require.NoError(t, msg.SetResponse(b))
+
+	b, err = msg.Message().MarshalPacked()
+	require.NoError(t, err)
+	return b
+}
+
 func generateLocation(n int, serviceName string) (service.Location, error) {
 	loc, err := service.NewLocation()
 	if err != nil {
@@ -2,22 +2,52 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"sync"
+	"time"
 
 	"capnproto.org/go/capnp/v3"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/record"
 	"github.com/wetware/pkg/api/channel"
 	api "github.com/wetware/pkg/api/registry"
 	"github.com/wetware/pkg/cap/pubsub"
+	"github.com/wetware/pkg/util/log"
 )
 
-type Server struct{}
+// Server provides the Registry capability.  Registries returned by the
+// same Server share a cache of the providers they have observed.
+type Server struct {
+	Log log.Logger
 
-func (s Server) Registry() Registry {
-	sds := RegistryServer{}
-	return Registry(sds.Client())
+	once   sync.Once
+	server *RegistryServer
 }
 
-type RegistryServer struct{}
+func (s *Server) Registry() Registry {
+	s.once.Do(func() {
+		s.server = &RegistryServer{Log: s.Log}
+	})
+
+	return Registry(s.server.Client())
+}
+
+// RegistryServer advertises and discovers service locations over pubsub
+// topics.  Locations are signed envelopes.  The server verifies each
+// location's signature, and validates it against the topic, before
+// advertising it or returning it to a caller.  Invalid messages are
+// ignored, so that peers cannot disrupt the topic by publishing them.
+//
+// The server caches the locations it observes, until they expire or
+// are withdrawn by their provider.  While a topic has cached locations,
+// the server watches it for withdrawals.
+type RegistryServer struct {
+	Log log.Logger
+
+	cache cache
+}
 
 func (s *RegistryServer) Client() capnp.Client {
 	return capnp.Client(api.Registry_ServerToClient(s))
@@ -29,22 +59,60 @@ func (s *RegistryServer) Provide(ctx context.Context, call api.Registry_provide)
 		return fmt.Errorf("failed to read location: %w", err)
 	}
 
-	response, err := encodeResponse(e)
+	topic := pubsub.Topic(call.Args().Topic())
+
+	name, err := topic.Name(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read topic name: %w", err)
+	}
+
+	loc, env, err := ConsumeLocation(e, name)
 	if err != nil {
 		return err
 	}
 
-	topic := pubsub.Topic(call.Args().Topic())
+	withdrawal, err := s.withdrawal(call.Args(), name, env)
+	if err != nil {
+		return err
+	}
+
+	response, err := encodeResponse(e)
+	if err != nil {
+		return err
+	}
 
 	// subscribe to topic
 	sub, release := topic.Subscribe(ctx)
 	defer release()
 
 	call.Go()
+
+	// Announce the location, and re-announce it before it expires from
+	// the caches of requesters.  ConsumeLocation ensures that the TTL is
+	// at least MinTTL, which bounds the announcement rate.
+	announcer := make(chan struct{})
+	go func() {
+		defer close(announcer)
+
+		ticker := time.NewTicker(loc.TTL() / 2)
+		defer ticker.Stop()
+
+		for err := topic.Publish(ctx, response); err == nil; err = topic.Publish(ctx, response) {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
 	for b := sub.Next(); b != nil; b = sub.Next() {
 		msg, err := decodeMessage(b)
 		if err != nil {
-			return err
+			s.logger().Debug("ignored malformed message",
+				"topic", name,
+				"error", err)
+			continue
 		}
 
 		if msg.Which() == api.Message_Which_request {
@@ -54,9 +122,58 @@ func (s *RegistryServer) Provide(ctx context.Context, call api.Registry_provide)
 		}
 	}
 
+	// Withdraw the location once the announcer has stopped, so that
+	// announcements do not race with the withdrawal.
+	if <-announcer; withdrawal != nil {
+		s.withdraw(topic, name, withdrawal)
+	}
+
 	return sub.Err()
 }
 
+// withdrawal returns the encoded withdraw message passed to Provide, or
+// nil if the caller did not supply one.  The withdrawal MUST be signed
+// by the location's provider.
+func (s *RegistryServer) withdrawal(args api.Registry_provide_Params, topic string, loc *record.Envelope) ([]byte, error) {
+	if !args.HasWithdrawal() {
+		return nil, nil
+	}
+
+	b, err := args.Withdrawal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read withdrawal: %w", err)
+	}
+
+	_, e, err := ConsumeWithdrawal(b, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	if !e.PublicKey.Equals(loc.PublicKey) {
+		return nil, errors.New("withdrawal and location have different signers")
+	}
+
+	return encodeWithdraw(b)
+}
+
+// withdraw publishes the withdraw message.  It is called after Provide's
+// context has expired.
+func (s *RegistryServer) withdraw(topic pubsub.Topic, name string, withdrawal []byte) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	err := topic.Publish(ctx, withdrawal)
+	if err == nil {
+		err = capnp.Client(topic).WaitStreaming()
+	}
+
+	if err != nil {
+		s.logger().Debug("failed to withdraw location",
+			"topic", name,
+			"error", err)
+	}
+}
+
 func (s *RegistryServer) FindProviders(ctx context.Context, call api.Registry_findProviders) error {
 	request, err := encodeRequest(call)
 	if err != nil {
@@ -65,48 +182,194 @@ func (s *RegistryServer) FindProviders(ctx context.Context, call api.Registry_fi
 
 	topic := pubsub.Topic(call.Args().Topic())
 
+	name, err := topic.Name(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read topic name: %w", err)
+	}
+
+	if ms := call.Args().Timeout(); ms > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(ms)*time.Millisecond)
+		defer cancel()
+	}
+
+	var (
+		limit  = int(call.Args().Limit())
+		sender = call.Args().Chan()
+		found  = make(map[peer.ID]struct{})
+	)
+
+	// send the provider's location to the caller, unless it was already
+	// sent.  It reports whether the limit has been reached.
+	send := func(id peer.ID, loc []byte) (bool, error) {
+		if _, ok := found[id]; !ok {
+			found[id] = struct{}{}
+			if err := s.send(ctx, sender, loc); err != nil {
+				return false, err
+			}
+		}
+
+		return limit > 0 && len(found) >= limit, nil
+	}
+
+	call.Go()
+
+	// Serve cached locations first.  If they satisfy the limit, we don't
+	// need to query the providers.
+	for id, loc := range s.cache.get(name) {
+		if done, err := send(id, loc); done || err != nil {
+			return err
+		}
+	}
+
 	sub, release := topic.Subscribe(ctx)
 	defer release()
 
 	// publish a request
-	call.Go()
 	if err := topic.Publish(ctx, request); err != nil {
-		return err
+		return ignoreTimeout(ctx, err)
 	}
 
 	// wait for responses or until context is canceled
-	sender := call.Args().Chan()
-
 	for b := sub.Next(); b != nil; b = sub.Next() {
-		msg, err := decodeMessage(b)
+		id, loc, err := s.observe(topic, name, b)
 		if err != nil {
+			s.logger().Debug("ignored invalid message",
+				"topic", name,
+				"error", err)
+			continue
+		} else if loc == nil {
+			continue // not a response
+		}
+
+		if done, err := send(id, loc); done || err != nil {
 			return err
 		}
-		if msg.Which() == api.Message_Which_response {
-			loc, err := msg.Response()
-			if err != nil {
-				return err
-			}
+	}
 
-			fut, release := sender.Send(ctx, func(ps channel.Sender_send_Params) error {
-				_, seg := capnp.NewSingleSegmentMessage(nil)
-				data, err := capnp.NewData(seg, loc)
-				if err != nil {
-					return err
-				}
+	return ignoreTimeout(ctx, sub.Err())
+}
 
-				return ps.SetValue(data.ToPtr())
-			})
-			defer release()
+// observe a message published on the topic, and update the cache
+// accordingly.  If the message is a response, observe returns the
+// provider and its signed location.
+func (s *RegistryServer) observe(topic pubsub.Topic, name string, b []byte) (peer.ID, []byte, error) {
+	msg, err := decodeMessage(b)
+	if err != nil {
+		return "", nil, err
+	}
 
-			_, err = fut.Struct()
-			if err != nil {
-				return err
-			}
+	switch msg.Which() {
+	case api.Message_Which_response:
+		e, err := msg.Response()
+		if err != nil {
+			return "", nil, err
+		}
+
+		loc, env, err := ConsumeLocation(e, name)
+		if err != nil {
+			return "", nil, err
+		}
 
+		id, err := peer.IDFromPublicKey(env.PublicKey)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if s.cache.put(name, id, entry{
+			envelope: e,
+			seq:      loc.Seq(),
+			expires:  time.Now().Add(loc.TTL()),
+		}) {
+			go s.watch(topic.AddRef(), name)
 		}
+
+		return id, e, nil
+
+	case api.Message_Which_withdraw:
+		b, err := msg.Withdraw()
+		if err != nil {
+			return "", nil, err
+		}
+
+		w, env, err := ConsumeWithdrawal(b, name)
+		if err != nil {
+			return "", nil, err
+		}
+
+		id, err := peer.IDFromPublicKey(env.PublicKey)
+		if err == nil {
+			s.cache.withdraw(name, id, w.Seq())
+		}
+
+		return "", nil, err
 	}
-	return nil
+
+	return "", nil, nil
+}
+
+// watch the topic for new locations and withdrawals, until all of its
+// cached locations have expired.  If the topic fails, its locations are
+// dropped from the cache.
+func (s *RegistryServer) watch(topic pubsub.Topic, name string) {
+	defer topic.Release()
+
+	for {
+		deadline, ok := s.cache.deadline(name)
+		if !ok {
+			return
+		}
+
+		ctx, cancel := context.WithDeadline(context.Background(), deadline)
+		sub, release := topic.Subscribe(ctx)
+		for b := sub.Next(); b != nil; b = sub.Next() {
+			s.observe(topic, name, b)
+		}
+		release()
+		cancel()
+
+		if ctx.Err() == nil {
+			s.logger().Debug("stopped watching topic",
+				"topic", name,
+				"error", sub.Err())
+			s.cache.drop(name)
+			return
+		}
+	}
+}
+
+func (s *RegistryServer) send(ctx context.Context, sender channel.Sender, loc []byte) error {
+	fut, release := sender.Send(ctx, func(ps channel.Sender_send_Params) error {
+		_, seg := capnp.NewSingleSegmentMessage(nil)
+		data, err := capnp.NewData(seg, loc)
+		if err != nil {
+			return err
+		}
+
+		return ps.SetValue(data.ToPtr())
+	})
+	defer release()
+
+	_, err := fut.Struct()
+	return err
+}
+
+// ignoreTimeout returns nil if err was caused by the expiry of ctx's
+// deadline, since FindProviders returns normally after its timeout.
+func ignoreTimeout(ctx context.Context, err error) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil
+	}
+
+	return err
+}
+
+func (s *RegistryServer) logger() log.Logger {
+	if s.Log == nil {
+		return slog.Default()
+	}
+
+	return s.Log
 }
 
 func encodeRequest(call api.Registry_findProviders) ([]byte, error) {
@@ -131,7 +394,20 @@ func encodeResponse(e []byte) ([]byte, error) {
 	}
 
 	return msg.Message().MarshalPacked()
+}
+
+func encodeWithdraw(e []byte) ([]byte, error) {
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	msg, err := api.NewRootMessage(seg)
+	if err != nil {
+		return nil, err
+	}
 
+	if err := msg.SetWithdraw(e); err != nil {
+		return nil, err
+	}
+
+	return msg.Message().MarshalPacked()
 }
 
 func decodeMessage(b []byte) (api.Message, error) {
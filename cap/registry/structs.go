@@ -3,8 +3,11 @@ package service
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"capnproto.org/go/capnp/v3"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/record"
 	ma "github.com/multiformats/go-multiaddr"
 	api "github.com/wetware/pkg/api/registry"
@@ -17,16 +20,84 @@ var (
 	EnvelopeDomain      = "ww/registry/location"
 	EnvelopePayloadType = []byte{0x1f, 0x01}
 	ErrInavlidType      = errors.New("invalid type")
+
+	WithdrawalDomain      = "ww/registry/withdrawal"
+	WithdrawalPayloadType = []byte{0x1f, 0x02}
+)
+
+const (
+	// DefaultTTL is the time for which a location remains valid after
+	// it is received, unless the location specifies its own TTL.
+	DefaultTTL = time.Minute
+
+	// MinTTL is the shortest TTL that a location may specify.  Providers
+	// re-announce their locations every TTL/2, so short TTLs would flood
+	// the topic.
+	MinTTL = time.Second * 2
 )
 
 func init() {
 	record.RegisterType(&Location{})
+	record.RegisterType(&Withdrawal{})
+}
+
+// Seal signs the location with key, along with a withdrawal that can
+// be published when the location is no longer provided.  If the
+// location's sequence number is zero, it is set to the current time.
+func Seal(loc Location, key crypto.PrivKey) (e, withdrawal *record.Envelope, err error) {
+	if loc.Seq() == 0 {
+		loc.SetSeq(uint64(time.Now().UnixNano()))
+	}
+
+	if e, err = record.Seal(&loc, key); err != nil {
+		return
+	}
+
+	w, err := NewWithdrawal(loc)
+	if err == nil {
+		withdrawal, err = record.Seal(&w, key)
+	}
+
+	return
 }
 
-var ErrInvalidSignature = errors.New("invalid signature")
+var (
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrSpoofed          = errors.New("location advertises another peer")
+	ErrTTL              = fmt.Errorf("ttl below minimum of %s", MinTTL)
+)
+
+// ConsumeLocation verifies the signature of the envelope in b, and
+// validates the location it contains against the topic on which it
+// was advertised.  Locations whose multiaddrs identify a peer other
+// than the envelope's signer are rejected with ErrSpoofed.
+func ConsumeLocation(b []byte, topic string) (Location, *record.Envelope, error) {
+	var loc Location
+	e, err := record.ConsumeTypedEnvelope(b, &loc)
+	if errors.Is(err, record.ErrInvalidSignature) {
+		return Location{}, nil, ErrInvalidSignature
+	} else if err != nil {
+		return Location{}, nil, fmt.Errorf("failed to consume typed envelope: %w", err)
+	}
+
+	if err = loc.Validate(topic); err != nil {
+		return Location{}, nil, fmt.Errorf("failed to validate location: %w", err)
+	}
+
+	if loc.provider, err = peer.IDFromPublicKey(e.PublicKey); err != nil {
+		return Location{}, nil, fmt.Errorf("failed to derive signer: %w", err)
+	}
+
+	if err = loc.checkSigner(); err != nil {
+		return Location{}, nil, err
+	}
+
+	return loc, e, nil
+}
 
 type Location struct {
 	api.Location
+	provider peer.ID
 }
 
 func NewLocation() (Location, error) {
@@ -48,9 +119,90 @@ func (loc Location) Validate(topic string) error {
 		return fmt.Errorf("the topic and the service name are different, topic: %s - service: %s", topic, service)
 	}
 
+	if loc.TTL() < MinTTL {
+		return fmt.Errorf("%w: %s", ErrTTL, loc.TTL())
+	}
+
+	switch loc.Which() {
+	case api.Location_Which_maddrs:
+		_, err = loc.Maddrs()
+	case api.Location_Which_anchor:
+		_, err = loc.Anchor()
+	case api.Location_Which_custom:
+		_, err = loc.Custom()
+	case api.Location_Which_capability:
+		_, err = loc.CapHost()
+	default:
+		err = fmt.Errorf("unknown location type: %d", loc.Which())
+	}
+
+	return err
+}
+
+// checkSigner ensures that the location's multiaddrs do not identify a
+// peer other than the one that signed the location.  The multiaddrs of
+// a capability reference must instead identify the vat that hosts it.
+func (loc Location) checkSigner() error {
+	var (
+		maddrs []ma.Multiaddr
+		want   = loc.provider
+		err    error
+	)
+
+	switch loc.Which() {
+	case api.Location_Which_maddrs:
+		maddrs, err = loc.Maddrs()
+
+	case api.Location_Which_capability:
+		var info peer.AddrInfo
+		info, err = loc.CapHost()
+		maddrs, want = info.Addrs, info.ID
+
+	default:
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, maddr := range maddrs {
+		v, err := maddr.ValueForProtocol(ma.P_P2P)
+		if err != nil {
+			continue // no peer component
+		}
+
+		if id, err := peer.Decode(v); err != nil || id != want {
+			return fmt.Errorf("%w: %s", ErrSpoofed, maddr)
+		}
+	}
+
 	return nil
 }
 
+// Provider returns the peer that signed the location.  It is empty
+// unless the location was obtained from ConsumeLocation.
+func (loc Location) Provider() peer.ID {
+	return loc.provider
+}
+
+// TTL returns the time for which the location remains valid after it
+// is received.
+func (loc Location) TTL() time.Duration {
+	if ttl := loc.Ttl(); ttl != 0 {
+		return time.Duration(ttl) * time.Millisecond
+	}
+
+	return DefaultTTL
+}
+
+// SetTTL sets the location's TTL, with millisecond precision.  A zero
+// TTL selects the DefaultTTL.  Locations with a TTL below MinTTL are
+// rejected by Validate.
+func (loc Location) SetTTL(d time.Duration) {
+	loc.SetTtl(uint32(d / time.Millisecond))
+}
+
 func (loc Location) SetMaddrs(maddrs []ma.Multiaddr) error {
 	capMaddrs, err := loc.NewMaddrs(int32(len(maddrs)))
 	if err != nil {
@@ -86,6 +238,81 @@ func (loc Location) Maddrs() ([]ma.Multiaddr, error) {
 		return nil, fmt.Errorf("failed to get Multiaddresses: %w", err)
 	}
 
+	return decodeMaddrs(capMaddrs)
+}
+
+// SetCapRef sets the location to reference a capability hosted by the
+// vat at host, and returns the reference so that the caller may specify
+// the capability.  If host.ID is empty, the capability is hosted by the
+// peer that signs the location.
+func (loc Location) SetCapRef(host peer.AddrInfo) (api.CapRef, error) {
+	ref, err := loc.NewCapability()
+	if err != nil {
+		return api.CapRef{}, fmt.Errorf("fail to create capnp CapRef: %w", err)
+	}
+
+	if host.ID != "" {
+		if err = ref.SetHost(host.ID.String()); err != nil {
+			return api.CapRef{}, fmt.Errorf("fail to set host in CapRef: %w", err)
+		}
+	}
+
+	maddrs, err := ref.NewMaddrs(int32(len(host.Addrs)))
+	if err != nil {
+		return api.CapRef{}, fmt.Errorf("fail to create capnp Multiaddr: %w", err)
+	}
+
+	for i, maddr := range host.Addrs {
+		if err = maddrs.Set(i, maddr.Bytes()); err != nil {
+			return api.CapRef{}, fmt.Errorf("fail to set maddr in CapRef: %w", err)
+		}
+	}
+
+	return ref, nil
+}
+
+// CapHost returns the address of the vat hosting the capability that
+// is referenced by the location.  If the reference does not specify a
+// host, the vat is the location's provider.
+func (loc Location) CapHost() (info peer.AddrInfo, err error) {
+	ref, err := loc.Capability()
+	if err != nil {
+		return info, fmt.Errorf("failed to get CapRef: %w", err)
+	}
+
+	switch ref.Which() {
+	case api.CapRef_Which_sturdyRef:
+		_, err = ref.SturdyRef()
+	case api.CapRef_Which_cell:
+		_, err = ref.Cell()
+	default:
+		err = fmt.Errorf("unknown capability reference: %d", ref.Which())
+	}
+	if err != nil {
+		return info, err
+	}
+
+	if info.ID = loc.provider; ref.HasHost() {
+		host, err := ref.Host()
+		if err != nil {
+			return info, fmt.Errorf("failed to get host: %w", err)
+		}
+
+		if info.ID, err = peer.Decode(host); err != nil {
+			return info, fmt.Errorf("failed to decode host: %w", err)
+		}
+	}
+
+	capMaddrs, err := ref.Maddrs()
+	if err != nil {
+		return info, fmt.Errorf("failed to get Multiaddresses: %w", err)
+	}
+
+	info.Addrs, err = decodeMaddrs(capMaddrs)
+	return info, err
+}
+
+func decodeMaddrs(capMaddrs capnp.DataList) ([]ma.Multiaddr, error) {
 	maddrs := make([]ma.Multiaddr, 0, capMaddrs.Len())
 	for i := 0; i < capMaddrs.Len(); i++ {
 		buffer, err := capMaddrs.At(i)
@@ -143,3 +370,70 @@ func (loc *Location) UnmarshalRecord(b []byte) error {
 
 	return nil
 }
+
+// Withdrawal is signed by a provider that no longer provides a service.
+// It supersedes the provider's locations with a lower or equal Seq.
+type Withdrawal struct {
+	api.Withdrawal
+}
+
+// NewWithdrawal returns a withdrawal for the location.
+func NewWithdrawal(loc Location) (Withdrawal, error) {
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	w, err := api.NewRootWithdrawal(seg)
+	if err != nil {
+		return Withdrawal{}, fmt.Errorf("failed to create withdrawal: %w", err)
+	}
+
+	service, err := loc.Service()
+	if err != nil {
+		return Withdrawal{}, fmt.Errorf("failed to read service name: %w", err)
+	}
+
+	w.SetSeq(loc.Seq())
+	return Withdrawal{Withdrawal: w}, w.SetService(service)
+}
+
+// ConsumeWithdrawal verifies the signature of the envelope in b, and
+// checks that the withdrawal it contains applies to topic.
+func ConsumeWithdrawal(b []byte, topic string) (Withdrawal, *record.Envelope, error) {
+	var w Withdrawal
+	e, err := record.ConsumeTypedEnvelope(b, &w)
+	if errors.Is(err, record.ErrInvalidSignature) {
+		return Withdrawal{}, nil, ErrInvalidSignature
+	} else if err != nil {
+		return Withdrawal{}, nil, fmt.Errorf("failed to consume typed envelope: %w", err)
+	}
+
+	service, err := w.Service()
+	if err != nil {
+		return Withdrawal{}, nil, fmt.Errorf("failed to read service name: %w", err)
+	}
+	if topic != service {
+		return Withdrawal{}, nil, fmt.Errorf("the topic and the service name are different, topic: %s - service: %s", topic, service)
+	}
+
+	return w, e, nil
+}
+
+func (w Withdrawal) Domain() string {
+	return WithdrawalDomain
+}
+
+func (w Withdrawal) Codec() []byte {
+	return WithdrawalPayloadType
+}
+
+func (w Withdrawal) MarshalRecord() ([]byte, error) {
+	return w.Message().MarshalPacked()
+}
+
+func (w *Withdrawal) UnmarshalRecord(b []byte) error {
+	m, err := capnp.UnmarshalPacked(b)
+	if err != nil {
+		return err
+	}
+
+	w.Withdrawal, err = api.ReadRootWithdrawal(m)
+	return err
+}
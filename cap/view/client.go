@@ -78,6 +78,95 @@ func (it Iterator) Next() routing.Record {
 	return r
 }
 
+// Watch returns an iterator that streams changes to the records that
+// match the supplied query.  The iterator is exhausted when the limit
+// constraint is reached, or when the context expires.  Callers MUST
+// call the ReleaseFunc when finished with the iterator.
+func (v View) Watch(ctx context.Context, query Query) (EventIterator, capnp.ReleaseFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	var (
+		w          = watcher(make(chan routing.Event, 32))
+		f, release = api.View(v).Watch(ctx, func(ps api.View_watch_Params) error {
+			if err := query(ps); err != nil {
+				return err
+			}
+
+			return ps.SetHandler(api.View_Watcher_ServerToClient(w))
+		})
+	)
+
+	return EventIterator{
+			Future: casm.Future(f),
+			Seq:    w,
+		}, func() {
+			cancel()
+			release()
+		}
+}
+
+// EventIterator enumerates changes to the routing table.  See
+// casm.Iterator() for important information on lifetime and error
+// handling.
+type EventIterator casm.Iterator[routing.Event]
+
+// Err reports any error encountered during iteration.
+func (it EventIterator) Err() error {
+	return casm.Iterator[routing.Event](it).Err()
+}
+
+// Next blocks until the next event is received.  It returns false
+// when the iterator is exhausted.  Unlike Iterator, events remain
+// valid after subsequent calls to Next.
+func (it EventIterator) Next() (routing.Event, bool) {
+	return casm.Iterator[routing.Event](it).Next()
+}
+
+type watcher chan routing.Event
+
+func (w watcher) Shutdown() { close(w) }
+
+func (w watcher) Next() (ev routing.Event, ok bool) {
+	ev, ok = <-w
+	return
+}
+
+func (w watcher) Recv(ctx context.Context, call api.View_Watcher_recv) error {
+	e, err := call.Args().Event()
+	if err != nil {
+		return err
+	}
+
+	rec, err := e.Record()
+	if err != nil {
+		return err
+	}
+
+	// The event is released when Recv returns, so we copy the record
+	// into a message of its own.
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	cp, err := api.NewRootView_Record(seg)
+	if err != nil {
+		return err
+	}
+
+	if err = clientRecord(rec).BindRecord(cp); err != nil {
+		return err
+	}
+
+	r, err := newRecord(cp)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case w <- routing.Event{Type: routing.EventType(e.Type()), Record: r}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 type handler struct {
 	send chan routing.Record
 	sync chan struct{}
@@ -234,6 +323,10 @@ func (r clientRecord) Meta() (routing.Meta, error) {
 	return r.heartbeat().Meta()
 }
 
+func (r clientRecord) Resources() (routing.Resources, bool) {
+	return r.heartbeat().Resources()
+}
+
 func (r clientRecord) heartbeat() pulse.Heartbeat {
 	hb, _ := api.View_Record(r).Heartbeat()
 	return pulse.Heartbeat{Heartbeat: hb}
@@ -33,10 +33,159 @@ func From(index routing.Index) Selector {
 	}
 }
 
+/*
+	Constraints
+*/
+
+// Limit restricts the results to n records.  For Watch, it restricts
+// the stream to n events.
+func Limit(n uint64) Constraint {
+	return func(c ConstraintStruct) error {
+		c.SetLimit(n)
+		return nil
+	}
+}
+
+// To restricts the results to records less-than-or-equal-to the index.
+// Use with From to implement range queries.
+func To(index routing.Index) Constraint {
+	return func(c ConstraintStruct) error {
+		return bindIndex(c.NewTo, index)
+	}
+}
+
+// Where restricts the results to records that satisfy the predicate.
+func Where(p Predicate) Constraint {
+	return func(c ConstraintStruct) error {
+		pred, err := c.NewWhere()
+		if err != nil {
+			return err
+		}
+
+		return p(pred)
+	}
+}
+
+/*
+	Predicates
+*/
+
+type (
+	PredicateStruct = api.View_Predicate
+	Predicate       func(PredicateStruct) error
+)
+
+// And is satisfied by records that satisfy all of the predicates.
+func And(ps ...Predicate) Predicate {
+	return func(p PredicateStruct) error {
+		list, err := p.NewAnd(int32(len(ps)))
+		if err == nil {
+			err = bindPredicates(list, ps)
+		}
+		return err
+	}
+}
+
+// Or is satisfied by records that satisfy any of the predicates.
+func Or(ps ...Predicate) Predicate {
+	return func(p PredicateStruct) error {
+		list, err := p.NewOr(int32(len(ps)))
+		if err == nil {
+			err = bindPredicates(list, ps)
+		}
+		return err
+	}
+}
+
+// Not is satisfied by records that do not satisfy the predicate.
+func Not(p Predicate) Predicate {
+	return func(ps PredicateStruct) error {
+		not, err := ps.NewNot()
+		if err == nil {
+			err = p(not)
+		}
+		return err
+	}
+}
+
+// Has is satisfied by records with a metadata field for the key.
+func Has(key string) Predicate {
+	return func(p PredicateStruct) error {
+		return p.SetHas(key)
+	}
+}
+
+// Meta is satisfied by records whose metadata field for the key holds
+// the value.
+func Meta(key, value string) Predicate {
+	return func(p PredicateStruct) error {
+		return p.SetMeta(routing.MetaField{Key: key, Value: value}.String())
+	}
+}
+
+// HostMatches is satisfied by records whose hostname matches the RE2
+// regular expression.
+func HostMatches(expr string) Predicate {
+	return func(p PredicateStruct) error {
+		return p.SetHost(expr)
+	}
+}
+
+// Comparison operators for numeric metadata fields.  See Compare.
+const (
+	Eq = api.View_Comparison_Op_eq
+	Ne = api.View_Comparison_Op_ne
+	Lt = api.View_Comparison_Op_lt
+	Le = api.View_Comparison_Op_le
+	Gt = api.View_Comparison_Op_gt
+	Ge = api.View_Comparison_Op_ge
+)
+
+// Compare is satisfied by records whose metadata field for the key
+// holds a number that satisfies "field <op> x".
+func Compare(key string, op api.View_Comparison_Op, x float64) Predicate {
+	return func(p PredicateStruct) error {
+		c, err := p.NewCompare()
+		if err != nil {
+			return err
+		}
+
+		c.SetOp(op)
+		c.SetValue(x)
+		return c.SetKey(key)
+	}
+}
+
+// Resource is satisfied by records that report resources, and whose
+// resource satisfies "resource <op> x".
+func Resource(r routing.Resource, op api.View_Comparison_Op, x float64) Predicate {
+	return func(p PredicateStruct) error {
+		c, err := p.NewResource()
+		if err != nil {
+			return err
+		}
+
+		c.SetResource(api.Resource(r)) // same ordinals
+		c.SetOp(op)
+		c.SetValue(x)
+		return nil
+	}
+}
+
 /*
 	Helpers
 */
 
+func bindPredicates(list api.View_Predicate_List, ps []Predicate) (err error) {
+	for i, bind := range ps {
+		if err = bind(list.At(i)); err != nil {
+			break
+		}
+	}
+
+	return
+}
+
 func bindIndex(fn func() (api.View_Index, error), index routing.Index) error {
 	target, err := fn()
 	if err != nil {
@@ -59,6 +208,10 @@ func bindIndex(fn func() (api.View_Index, error), index routing.Index) error {
 		return bindMeta(target, index)
 	}
 
+	if r, err := routing.ParseResource(index.String()); err == nil {
+		return bindResource(target, r, index)
+	}
+
 	return fmt.Errorf("invalid index: %s", index)
 }
 
@@ -140,3 +293,18 @@ func bindMeta(target api.View_Index, index routing.Index) error {
 
 	return errors.New("not a metadata index")
 }
+
+func bindResource(target api.View_Index, r routing.Resource, index routing.Index) error {
+	ix, ok := index.(routing.ResourceIndex)
+	if !ok {
+		return errors.New("not a resource index")
+	}
+
+	rv, err := target.NewResource()
+	if err == nil {
+		rv.SetResource(api.Resource(r)) // same ordinals
+		rv.SetValue(ix.ResourceValue())
+	}
+
+	return err
+}
@@ -2,7 +2,10 @@ package view
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"sync"
 
 	"capnproto.org/go/capnp/v3"
 
@@ -32,8 +35,13 @@ func (s Server) View() View {
 
 func (s Server) Lookup(ctx context.Context, call api.View_lookup) error {
 	sel, err := call.Args().Selector()
+	if err != nil {
+		return err
+	}
+
+	cs, err := call.Args().Constraints()
 	if err == nil {
-		err = s.bind(maybeRecord(call), selector(sel).Bind(query.First()))
+		err = s.bind(maybeRecord(call), constrain(selector(sel), cs).Bind(query.First()))
 	}
 
 	return err
@@ -45,6 +53,11 @@ func (s Server) Iter(ctx context.Context, call api.View_iter) error {
 		return err
 	}
 
+	cs, err := call.Args().Constraints()
+	if err != nil {
+		return err
+	}
+
 	var (
 		handler = call.Args().Handler()
 		// TODO(soon): use BBR once scheduler bug is fixed
@@ -52,7 +65,7 @@ func (s Server) Iter(ctx context.Context, call api.View_iter) error {
 		iter = iterator(ctx, handler)
 	)
 
-	if err = s.bind(iter, selector(sel)); err == nil {
+	if err = s.bind(iter, constrain(selector(sel), cs)); err == nil {
 		call.Go()
 		err = handler.WaitStreaming()
 	}
@@ -60,6 +73,164 @@ func (s Server) Iter(ctx context.Context, call api.View_iter) error {
 	return err
 }
 
+// Watcher is an optional interface for the routing table, which
+// allows changes to be streamed by Watch.  See routing.Table.
+type Watcher interface {
+	Watch(func(routing.Event)) (cancel func())
+}
+
+// ErrLagging is returned by Watch when the handler fails to keep up
+// with changes to the routing table.
+var ErrLagging = errors.New("watcher is lagging")
+
+// WatchBuffer is the number of events that are buffered for each call
+// to Watch before the call fails with ErrLagging.
+const WatchBuffer = 64
+
+func (s Server) Watch(ctx context.Context, call api.View_watch) error {
+	table, ok := s.RoutingTable.(Watcher)
+	if !ok {
+		return errors.New("routing table does not support watch")
+	}
+
+	match, limit, err := filter(call.Args())
+	if err != nil {
+		return err
+	}
+
+	var (
+		handler = call.Args().Handler()
+		events  = make(chan routing.Event, WatchBuffer)
+		lagging = make(chan struct{})
+		once    sync.Once
+	)
+
+	cancel := table.Watch(func(ev routing.Event) {
+		if !match.Match(ev.Record) {
+			return
+		}
+
+		select {
+		case events <- ev:
+		default:
+			once.Do(func() { close(lagging) })
+		}
+	})
+	defer cancel()
+
+	call.Go()
+
+	for n := uint64(0); limit == 0 || n < limit; n++ {
+		select {
+		case ev := <-events:
+			if err = handler.Recv(ctx, event(ev)); err != nil {
+				return err
+			}
+
+		case <-lagging:
+			return ErrLagging
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return handler.WaitStreaming()
+}
+
+// filter returns a matcher that evaluates a watch call's selector and
+// constraints against individual records, along with the maximum number
+// of events to stream.  Zero means no limit.
+func filter(args api.View_watch_Params) (match matchAll, limit uint64, err error) {
+	sel, err := args.Selector()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	m, err := selectorMatcher(sel)
+	if err != nil {
+		return nil, 0, err
+	}
+	match = append(match, m)
+
+	cs, err := args.Constraints()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i := 0; i < cs.Len(); i++ {
+		switch c := cs.At(i); c.Which() {
+		case api.View_Constraint_Which_limit:
+			limit = c.Limit()
+
+		case api.View_Constraint_Which_to:
+			to, err := c.To()
+			if err != nil {
+				return nil, 0, err
+			}
+
+			if m, err = query.Precedes(index{to}); err != nil {
+				return nil, 0, err
+			}
+			match = append(match, m)
+
+		case api.View_Constraint_Which_where:
+			p, err := c.Where()
+			if err != nil {
+				return nil, 0, err
+			}
+
+			if m, err = predicate(p); err != nil {
+				return nil, 0, err
+			}
+			match = append(match, m)
+
+		default:
+			return nil, 0, fmt.Errorf("invalid constraint: %s", c.Which())
+		}
+	}
+
+	return match, limit, nil
+}
+
+func selectorMatcher(s api.View_Selector) (query.Matcher, error) {
+	switch s.Which() {
+	case api.View_Selector_Which_all:
+		return matchAll(nil), nil
+
+	case api.View_Selector_Which_match:
+		match, err := s.Match()
+		if err != nil {
+			return nil, err
+		}
+
+		return query.Selects(index{match})
+
+	case api.View_Selector_Which_from:
+		from, err := s.From()
+		if err != nil {
+			return nil, err
+		}
+
+		return query.Follows(index{from})
+	}
+
+	return nil, fmt.Errorf("invalid selector: %s", s.Which())
+}
+
+// matchAll matches records that satisfy each of the matchers.
+type matchAll []query.Matcher
+
+func (ms matchAll) Match(r routing.Record) bool {
+	for _, m := range ms {
+		if !m.Match(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (s Server) Reverse(ctx context.Context, call api.View_reverse) error {
 	return fmt.Errorf("NOT IMPLEMENTED") // TODO(soon):  implement Reverse()
 }
@@ -89,6 +260,163 @@ func selector(s api.View_Selector) query.Selector {
 	return query.Failuref("invalid selector: %s", s.Which())
 }
 
+// constrain the selection with each of the constraints, in order.
+func constrain(sel query.Selector, cs api.View_Constraint_List) query.Selector {
+	for i := 0; i < cs.Len(); i++ {
+		sel = sel.Bind(constraint(cs.At(i)))
+	}
+
+	return sel
+}
+
+func constraint(c api.View_Constraint) query.Constraint {
+	switch c.Which() {
+	case api.View_Constraint_Which_limit:
+		return query.Limit(int(c.Limit()))
+
+	case api.View_Constraint_Which_to:
+		to, err := c.To()
+		if err != nil {
+			return failure(err)
+		}
+
+		return query.To(index{to})
+
+	case api.View_Constraint_Which_where:
+		p, err := c.Where()
+		if err != nil {
+			return failure(err)
+		}
+
+		match, err := predicate(p)
+		if err != nil {
+			return failure(err)
+		}
+
+		return query.Where(match)
+	}
+
+	return failure(fmt.Errorf("invalid constraint: %s", c.Which()))
+}
+
+func failure(err error) query.Constraint {
+	return func(routing.Iterator) query.Selector {
+		return query.Failure(err)
+	}
+}
+
+// predicate compiles p into a matcher.
+func predicate(p api.View_Predicate) (query.Matcher, error) {
+	switch p.Which() {
+	case api.View_Predicate_Which_and:
+		ps, err := p.And()
+		if err != nil {
+			return nil, err
+		}
+
+		ms, err := predicates(ps)
+		return query.And(ms...), err
+
+	case api.View_Predicate_Which_or:
+		ps, err := p.Or()
+		if err != nil {
+			return nil, err
+		}
+
+		ms, err := predicates(ps)
+		return query.Or(ms...), err
+
+	case api.View_Predicate_Which_not:
+		p, err := p.Not()
+		if err != nil {
+			return nil, err
+		}
+
+		m, err := predicate(p)
+		if err != nil {
+			return nil, err
+		}
+
+		return query.Not(m), nil
+
+	case api.View_Predicate_Which_has:
+		key, err := p.Has()
+		return query.Has(key), err
+
+	case api.View_Predicate_Which_meta:
+		s, err := p.Meta()
+		if err != nil {
+			return nil, err
+		}
+
+		f, err := routing.ParseField(s)
+		return query.MetaEq(f.Key, f.Value), err
+
+	case api.View_Predicate_Which_compare:
+		c, err := p.Compare()
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := c.Key()
+		if err != nil {
+			return nil, err
+		}
+
+		if c.Op() > api.View_Comparison_Op_ge {
+			return nil, fmt.Errorf("invalid operator: %s", c.Op())
+		}
+
+		return query.Compare(key, query.Op(c.Op()), c.Value()), nil // same ordinals
+
+	case api.View_Predicate_Which_resource:
+		c, err := p.Resource()
+		if err != nil {
+			return nil, err
+		}
+
+		if c.Resource() > api.Resource_capacity {
+			return nil, fmt.Errorf("invalid resource: %s", c.Resource())
+		}
+
+		if c.Op() > api.View_Comparison_Op_ge {
+			return nil, fmt.Errorf("invalid operator: %s", c.Op())
+		}
+
+		return query.Resource(
+			routing.Resource(c.Resource()), // same ordinals
+			query.Op(c.Op()),
+			c.Value()), nil
+
+	case api.View_Predicate_Which_host:
+		expr, err := p.Host()
+		if err != nil {
+			return nil, err
+		}
+
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, err
+		}
+
+		return query.HostMatches(re), nil
+	}
+
+	return nil, fmt.Errorf("invalid predicate: %s", p.Which())
+}
+
+func predicates(ps api.View_Predicate_List) ([]query.Matcher, error) {
+	ms := make([]query.Matcher, ps.Len())
+	for i := range ms {
+		var err error
+		if ms[i], err = predicate(ps.At(i)); err != nil {
+			return nil, err
+		}
+	}
+
+	return ms, nil
+}
+
 // binds a record
 type bindFunc func(routing.Record) error
 
@@ -125,6 +453,24 @@ func iterator(ctx context.Context, h api.View_Handler) bindFunc {
 	}
 }
 
+func event(ev routing.Event) func(api.View_Watcher_recv_Params) error {
+	return func(ps api.View_Watcher_recv_Params) error {
+		e, err := ps.NewEvent()
+		if err != nil {
+			return err
+		}
+
+		e.SetType(api.View_Event_Type(ev.Type)) // same ordinals
+
+		rec, err := e.NewRecord()
+		if err != nil {
+			return err
+		}
+
+		return copyRecord(rec, ev.Record)
+	}
+}
+
 func record(r routing.Record) func(api.View_Handler_recv_Params) error {
 	return func(ps api.View_Handler_recv_Params) error {
 		rec, err := ps.NewRecord()
@@ -177,6 +523,10 @@ func copyRecord(rec api.View_Record, r routing.Record) error {
 		return err
 	}
 
+	if err := copyResources(hb, r); err != nil {
+		return err
+	}
+
 	return copyMeta(hb, r)
 }
 
@@ -189,6 +539,16 @@ func copyHost(rec pulse.Heartbeat, r routing.Record) error {
 	return err
 }
 
+func copyResources(rec pulse.Heartbeat, r routing.Record) error {
+	if rr, ok := r.(routing.ResourceRecord); ok {
+		if rs, ok := rr.Resources(); ok {
+			return rec.SetResources(rs)
+		}
+	}
+
+	return nil
+}
+
 func copyMeta(rec pulse.Heartbeat, r routing.Record) error {
 	meta, err := r.Meta()
 	if err == nil {
@@ -201,13 +561,27 @@ func copyMeta(rec pulse.Heartbeat, r routing.Record) error {
 type index struct{ api.View_Index }
 
 func (ix index) String() string {
-	if ix.Which() == api.View_Index_Which_peer {
+	switch ix.Which() {
+	case api.View_Index_Which_peer:
 		return "id"
+
+	case api.View_Index_Which_resource:
+		rv, err := ix.View_Index.Resource()
+		if err != nil {
+			return "resource"
+		}
+
+		return routing.Resource(rv.Resource()).String() // same ordinals
 	}
 
 	return ix.Which().String()
 }
 
+func (ix index) ResourceValue() float64 {
+	rv, _ := ix.View_Index.Resource()
+	return rv.Value()
+}
+
 func (ix index) ServerBytes() ([]byte, error) {
 	return ix.View_Index.Server()
 }
@@ -213,6 +213,162 @@ func BenchmarkIterator(b *testing.B) {
 	}
 }
 
+func TestView_Watch(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	table := routing.New(time.Now())
+
+	server := view.Server{RoutingTable: table}
+	client := view.View(server.Client())
+	defer client.Release()
+
+	// Only watch records for hostnames beginning with "foo".
+	events, release := client.Watch(ctx, view.NewQuery(
+		view.Match(hostPrefix("foo")),
+		view.Limit(2)))
+	defer release()
+
+	// The watch is registered asynchronously, so we keep inserting
+	// records until the events are received.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(time.Millisecond * 10)
+		defer ticker.Stop()
+
+		for {
+			table.Upsert(&record{host: "bar.test"})
+			table.Upsert(&record{host: "foo.test"})
+
+			select {
+			case <-ticker.C:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < 2; i++ {
+		ev, ok := events.Next()
+		require.True(t, ok, "should receive event")
+		assert.Equal(t, routing.Join, ev.Type)
+
+		host, err := ev.Record.Host()
+		require.NoError(t, err)
+		assert.Equal(t, "foo.test", host, "should only report matching records")
+	}
+
+	_, ok := events.Next()
+	require.False(t, ok, "should stop after limit is reached")
+	require.NoError(t, events.Err())
+}
+
+func TestView_Where(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	table := routing.New(time.Now())
+	for _, r := range []*record{
+		{host: "web-01", meta: newMeta("zone=us", "cpu=8")},
+		{host: "web-02", meta: newMeta("zone=eu", "cpu=8")},
+		{host: "web-03", meta: newMeta("zone=us", "cpu=2")},
+		{host: "db-01", meta: newMeta("zone=us", "cpu=16")},
+		{host: "web-04", meta: newMeta("cpu=8")},
+	} {
+		require.True(t, table.Upsert(r))
+	}
+
+	server := view.Server{RoutingTable: table}
+	client := view.View(server.Client())
+	defer client.Release()
+
+	it, release := client.Iter(ctx, view.NewQuery(view.All(),
+		view.Where(view.And(
+			view.Has("zone"),
+			view.Not(view.Meta("zone", "eu")),
+			view.Compare("cpu", view.Ge, 4),
+			view.HostMatches(`^web-\d+$`)))))
+	defer release()
+
+	var hosts []string
+	for r := it.Next(); r != nil; r = it.Next() {
+		host, err := r.Host()
+		require.NoError(t, err)
+		hosts = append(hosts, host)
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"web-01"}, hosts,
+		"should only return records that satisfy the predicate")
+
+	// Invalid predicates are rejected by the server.
+	f, release := client.Lookup(ctx, view.NewQuery(view.All(),
+		view.Where(view.HostMatches(`(`))))
+	defer release()
+
+	_, err := f.Await(ctx)
+	require.Error(t, err, "should reject invalid regular expression")
+}
+
+func TestView_Resources(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	table := routing.New(time.Now())
+	for _, r := range []*record{
+		{host: "c", res: &routing.Resources{CPUs: 8, MemFree: 3}},
+		{host: "a", res: &routing.Resources{CPUs: 2, MemFree: 1}},
+		{host: "b", res: &routing.Resources{CPUs: 4, MemFree: 2}},
+		{host: "none"},
+	} {
+		require.True(t, table.Upsert(r))
+	}
+
+	server := view.Server{RoutingTable: table}
+	client := view.View(server.Client())
+	defer client.Release()
+
+	it, release := client.Iter(ctx, view.NewQuery(
+		view.From(routing.ResourceKey{Resource: routing.MemFree}),
+		view.Where(view.Resource(routing.CPUs, view.Ge, 4))))
+	defer release()
+
+	var hosts []string
+	for r := it.Next(); r != nil; r = it.Next() {
+		host, err := r.Host()
+		require.NoError(t, err)
+		hosts = append(hosts, host)
+
+		rs, ok := r.(routing.ResourceRecord).Resources()
+		require.True(t, ok, "should report resources")
+		assert.GreaterOrEqual(t, rs.CPUs, uint32(4))
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"b", "c"}, hosts,
+		"should return matching records in order of free memory")
+}
+
+func newMeta(ss ...string) routing.Meta {
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	meta, _ := capnp.NewTextList(seg, int32(len(ss)))
+	for i, s := range ss {
+		meta.Set(i, s)
+	}
+	return routing.Meta(meta)
+}
+
+type hostPrefix string
+
+func (hostPrefix) String() string                { return "host" }
+func (hostPrefix) Prefix() bool                  { return true }
+func (ix hostPrefix) HostBytes() ([]byte, error) { return []byte(ix), nil }
+
 func all() view.Query {
 	return view.NewQuery(view.All())
 }
@@ -225,6 +381,7 @@ type record struct {
 	host string
 	meta routing.Meta
 	ttl  time.Duration
+	res  *routing.Resources
 }
 
 func (r *record) init() {
@@ -270,6 +427,24 @@ func (r *record) TTL() time.Duration {
 
 func (r *record) Meta() (routing.Meta, error) { return r.meta, nil }
 
+func (r *record) Resources() (routing.Resources, bool) {
+	if r.res == nil {
+		return routing.Resources{}, false
+	}
+
+	return *r.res, true
+}
+
+func (r *record) PeerBytes() ([]byte, error) {
+	r.init()
+	return []byte(r.id), nil
+}
+
+func (r *record) HostBytes() ([]byte, error) {
+	r.init()
+	return []byte(r.host), nil
+}
+
 func newPeerID() peer.ID {
 	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
 	sk, _, err := crypto.GenerateEd25519Key(rnd)
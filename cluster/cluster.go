@@ -59,6 +59,9 @@ type Router struct {
 	id             uint64 // instance ID
 	announce       chan []pubsub.PubOpt
 	wc             *capnp.WeakClient
+
+	pub  sync.Mutex // serializes heartbeats with Leave
+	left bool
 }
 
 func (r *Router) Close() error {
@@ -69,6 +72,30 @@ func (r *Router) Close() error {
 	return nil
 }
 
+// Leave announces that the host is leaving the cluster, so that peers
+// evict it from their routing tables immediately, rather than waiting
+// for its TTL to expire.  The router stops emitting heartbeats.
+func (r *Router) Leave(ctx context.Context, opt ...pubsub.PubOpt) error {
+	r.setup()
+	defer r.Close()
+
+	r.pub.Lock()
+	defer r.pub.Unlock()
+
+	if r.left {
+		return nil
+	}
+	r.left = true
+
+	hb, err := r.prepare()
+	if err != nil {
+		return err
+	}
+	hb.SetLeaving(true)
+
+	return r.publish(ctx, hb, opt)
+}
+
 func (r *Router) String() string {
 	return r.Topic.String()
 }
@@ -212,12 +239,8 @@ func (r *Router) heartbeat() {
 		Jitter: true,
 	}
 
-	hb := pulse.NewHeartbeat()
-	hb.SetTTL(r.TTL)
-	hb.SetServer(r.ID())
-
 	for a := range r.announce {
-		err := r.emit(r.Clock.Context(), hb, a)
+		err := r.emit(r.Clock.Context(), a)
 		if err == nil {
 			backoff.Reset()
 			continue
@@ -243,11 +266,35 @@ func (r *Router) heartbeat() {
 	}
 }
 
-func (r *Router) emit(ctx context.Context, hb pulse.Heartbeat, opt []pubsub.PubOpt) error {
-	if err := r.Meta.Prepare(hb); err != nil {
+func (r *Router) emit(ctx context.Context, opt []pubsub.PubOpt) error {
+	r.pub.Lock()
+	defer r.pub.Unlock()
+
+	// Announcing after Leave would add the host back to the routing
+	// tables of its peers.
+	if r.left {
+		return nil
+	}
+
+	hb, err := r.prepare()
+	if err != nil {
 		return err
 	}
 
+	return r.publish(ctx, hb, opt)
+}
+
+// prepare a heartbeat from scratch, so that metadata that is removed at
+// runtime is not carried over.
+func (r *Router) prepare() (pulse.Heartbeat, error) {
+	hb := pulse.NewHeartbeat()
+	hb.SetTTL(r.TTL)
+	hb.SetServer(r.ID())
+
+	return hb, r.Meta.Prepare(hb)
+}
+
+func (r *Router) publish(ctx context.Context, hb pulse.Heartbeat, opt []pubsub.PubOpt) error {
 	msg, err := hb.Message().MarshalPacked()
 	if err != nil {
 		return err
@@ -8,12 +8,15 @@ import (
 
 	"log/slog"
 
+	"capnproto.org/go/capnp/v3"
 	"github.com/golang/mock/gomock"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/wetware/pkg/cluster"
+	"github.com/wetware/pkg/cluster/pulse"
 	test_cluster "github.com/wetware/pkg/cluster/test"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRouter(t *testing.T) {
@@ -80,3 +83,68 @@ func TestRouter(t *testing.T) {
 	assert.ErrorIs(t, err, cluster.ErrClosing,
 		"should not bootstrap after router was stopped")
 }
+
+func TestRouter_leave(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	table := test_cluster.NewMockRoutingTable(ctrl)
+	table.EXPECT().
+		Advance(gomock.AssignableToTypeOf(time.Time{})).
+		AnyTimes()
+
+	msgs := make(chan []byte, 8)
+	topic := test_cluster.NewMockTopic(ctrl)
+	topic.EXPECT().
+		String().
+		Return("casm").
+		AnyTimes()
+	topic.EXPECT().
+		Relay().
+		Return(func() {}, nil).
+		Times(1)
+	topic.EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, msg []byte, _ ...pubsub.PubOpt) error {
+			msgs <- msg
+			return nil
+		}).
+		AnyTimes()
+
+	router := cluster.Router{
+		Log:          slog.Default(),
+		Topic:        topic,
+		RoutingTable: table,
+	}
+	defer router.Close()
+
+	err := router.Bootstrap(context.Background())
+	require.NoError(t, err, "bootstrap should succeed")
+	hb := heartbeat(t, <-msgs)
+	assert.False(t, hb.Leaving(), "should not announce leave")
+
+	err = router.Leave(context.Background())
+	require.NoError(t, err, "leave should succeed")
+	hb = heartbeat(t, <-msgs)
+	assert.True(t, hb.Leaving(), "should announce leave")
+	assert.Equal(t, router.ID(), hb.Server(), "should identify instance")
+
+	err = router.Leave(context.Background())
+	require.NoError(t, err, "leave should be idempotent")
+	assert.Empty(t, msgs, "should not announce after leave")
+
+	err = router.Bootstrap(context.Background())
+	assert.ErrorIs(t, err, cluster.ErrClosing,
+		"should not bootstrap after router left")
+}
+
+func heartbeat(t *testing.T, b []byte) pulse.Heartbeat {
+	t.Helper()
+
+	msg, err := capnp.UnmarshalPacked(b)
+	require.NoError(t, err, "must unmarshal heartbeat")
+
+	var hb pulse.Heartbeat
+	require.NoError(t, hb.ReadMessage(msg), "must read heartbeat")
+	return hb
+}
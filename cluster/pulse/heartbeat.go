@@ -61,6 +61,76 @@ func (h Heartbeat) SetMeta(fields []routing.MetaField) error {
 	return err
 }
 
+// AddMeta adds fields to the heartbeat's metadata.  Existing fields
+// with the same keys are replaced.
+func (h Heartbeat) AddMeta(fields ...routing.MetaField) error {
+	meta, err := h.Meta()
+	if err != nil {
+		return err
+	}
+
+	merged := make([]routing.MetaField, 0, meta.Len()+len(fields))
+	for i := 0; i < meta.Len(); i++ {
+		f, err := meta.At(i)
+		if err != nil {
+			return err
+		}
+
+		if !hasKey(fields, f.Key) {
+			merged = append(merged, f)
+		}
+	}
+
+	return h.SetMeta(append(merged, fields...))
+}
+
+func hasKey(fields []routing.MetaField, key string) bool {
+	for _, f := range fields {
+		if f.Key == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetResources reports the host's resources in the heartbeat.
+func (h Heartbeat) SetResources(rs routing.Resources) error {
+	res, err := h.NewResources()
+	if err == nil {
+		res.SetCpus(rs.CPUs)
+		res.SetCpuLoad(rs.CPULoad)
+		res.SetMemTotal(rs.MemTotal)
+		res.SetMemFree(rs.MemFree)
+		res.SetProcs(rs.Procs)
+		res.SetCapacity(rs.Capacity)
+	}
+
+	return err
+}
+
+// Resources returns the resources reported in the heartbeat.  The
+// boolean is false if the host did not report any.
+func (h Heartbeat) Resources() (routing.Resources, bool) {
+	if !h.HasResources() {
+		return routing.Resources{}, false
+	}
+
+	res, err := h.Heartbeat.Resources()
+	if err != nil {
+		return routing.Resources{}, false
+	}
+
+	return routing.Resources{
+		CPUs:     res.Cpus(),
+		CPULoad:  res.CpuLoad(),
+		MemTotal: res.MemTotal(),
+		MemFree:  res.MemFree(),
+		Procs:    res.Procs(),
+		Capacity: res.Capacity(),
+	}, true
+}
+
 func (h *Heartbeat) ReadMessage(m *capnp.Message) (err error) {
 	h.Heartbeat, err = api.ReadRootHeartbeat(m)
 	return
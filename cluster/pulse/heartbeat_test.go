@@ -1,6 +1,7 @@
 package pulse_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/wetware/pkg/cluster/pulse"
+	"github.com/wetware/pkg/cluster/routing"
 )
 
 func TestHeartbeat_MarshalUnmarshal(t *testing.T) {
@@ -66,3 +68,67 @@ func TestHeartbeat_MarshalUnmarshal(t *testing.T) {
 		require.Equal(t, want, value)
 	}
 }
+
+func TestHeartbeat_AddMeta(t *testing.T) {
+	t.Parallel()
+
+	h := pulse.NewHeartbeat()
+	err := h.AddMeta(
+		routing.MetaField{Key: "region", Value: "us-east-1"},
+		routing.MetaField{Key: "load", Value: "0.5"})
+	require.NoError(t, err, "should add fields")
+
+	err = h.AddMeta(routing.MetaField{Key: "load", Value: "0.75"})
+	require.NoError(t, err, "should replace field")
+
+	meta, err := h.Meta()
+	require.NoError(t, err, "should return meta")
+	require.Equal(t, 2, meta.Len(), "should replace existing key")
+
+	for key, want := range map[string]string{
+		"region": "us-east-1",
+		"load":   "0.75",
+	} {
+		value, err := meta.Get(key)
+		require.NoError(t, err)
+		assert.Equal(t, want, value)
+	}
+}
+
+func TestChain(t *testing.T) {
+	t.Parallel()
+
+	field := func(key, value string) pulse.Preparer {
+		return pulse.PreparerFunc(func(h pulse.Heartbeat) error {
+			return h.AddMeta(routing.MetaField{Key: key, Value: value})
+		})
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		h := pulse.NewHeartbeat()
+		err := pulse.Chain(field("a", "1"), nil, field("b", "2")).Prepare(h)
+		require.NoError(t, err, "should prepare heartbeat")
+
+		meta, err := h.Meta()
+		require.NoError(t, err)
+		assert.Equal(t, 2, meta.Len(), "should preserve earlier fields")
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		fail := pulse.PreparerFunc(func(pulse.Heartbeat) error {
+			return errors.New("test")
+		})
+
+		h := pulse.NewHeartbeat()
+		err := pulse.Chain(fail, field("a", "1")).Prepare(h)
+		require.EqualError(t, err, "test", "should stop at first error")
+
+		meta, err := h.Meta()
+		require.NoError(t, err)
+		assert.Zero(t, meta.Len(), "should not call later preparers")
+	})
+}
@@ -25,9 +25,61 @@ type Preparer interface {
 	Prepare(Heartbeat) error
 }
 
-func NewValidator(rt RoutingTable) pubsub.ValidatorEx {
+// PreparerFunc adapts a function into a Preparer.
+type PreparerFunc func(Heartbeat) error
+
+func (prepare PreparerFunc) Prepare(h Heartbeat) error {
+	return prepare(h)
+}
+
+// Chain returns a Preparer that calls each of the preparers in turn,
+// stopping at the first error.  Nil preparers are skipped.  Preparers
+// should use Heartbeat.AddMeta, so that the fields added by earlier
+// preparers are preserved.
+func Chain(ps ...Preparer) Preparer {
+	return PreparerFunc(func(h Heartbeat) error {
+		for _, p := range ps {
+			if p == nil {
+				continue
+			}
+
+			if err := p.Prepare(h); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ValidatorOption configures the heartbeat validator.
+type ValidatorOption func(*validator)
+
+// WithSecret causes the validator to reject heartbeats from peers that
+// did not authenticate with the namespace secret.  If s == nil, all
+// heartbeats are accepted.
+func WithSecret(s *Secret) ValidatorOption {
+	return func(v *validator) {
+		v.secret = s
+	}
+}
+
+type validator struct {
+	secret *Secret
+}
+
+func NewValidator(rt RoutingTable, opt ...ValidatorOption) pubsub.ValidatorEx {
+	var v validator
+	for _, option := range opt {
+		option(&v)
+	}
+
 	return func(_ context.Context, _ peer.ID, m *pubsub.Message) pubsub.ValidationResult {
 		if rec, err := record(m); err == nil {
+			if !v.secret.Verify(rec.Peer(), rec.Heartbeat) {
+				return pubsub.ValidationReject
+			}
+
 			if rt.Upsert(rec) {
 				return pubsub.ValidationAccept
 			}
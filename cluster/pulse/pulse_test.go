@@ -106,6 +106,57 @@ func TestValidator(t *testing.T) {
 		res = validate(context.Background(), id, second)
 		require.Equal(t, pubsub.ValidationIgnore, res)
 	})
+
+	t.Run("Authenticate", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			secret = pulse.NewSecret([]byte("secret"), "test")
+			member = newPeerID()
+			other  = newPeerID()
+		)
+
+		message := func(id peer.ID, s *pulse.Secret) *pubsub.Message {
+			hb := pulse.NewHeartbeat()
+			hb.SetTTL(time.Hour)
+			require.NoError(t, s.Preparer(member).Prepare(hb))
+
+			b, err := hb.Message().MarshalPacked()
+			require.NoError(t, err)
+
+			return &pubsub.Message{Message: &pb.Message{
+				From:  []byte(id),
+				Seqno: []byte{0, 0, 0, 0, 0, 0, 0, 1}, // Seq=1
+				Data:  b,
+			}}
+		}
+
+		rt := test_pulse.NewMockRoutingTable(ctrl)
+		rt.EXPECT().
+			Upsert(gomock.Any()).
+			Return(true).
+			Times(1)
+
+		validate := pulse.NewValidator(rt, pulse.WithSecret(secret))
+
+		res := validate(context.Background(), member, message(member, secret))
+		require.Equal(t, pubsub.ValidationAccept, res,
+			"should accept authenticated heartbeat")
+
+		res = validate(context.Background(), other, message(other, secret))
+		require.Equal(t, pubsub.ValidationReject, res,
+			"should reject heartbeat authenticated for another peer")
+
+		res = validate(context.Background(), member, message(member, nil))
+		require.Equal(t, pubsub.ValidationReject, res,
+			"should reject unauthenticated heartbeat")
+
+		wrong := pulse.NewSecret([]byte("wrong"), "test")
+		res = validate(context.Background(), member, message(member, wrong))
+		require.Equal(t, pubsub.ValidationReject, res,
+			"should reject heartbeat authenticated with another key")
+	})
 }
 
 func newPeerID() peer.ID {
@@ -0,0 +1,115 @@
+package pulse
+
+import (
+	"bufio"
+	"bytes"
+	"io/fs"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/wetware/pkg/cluster/routing"
+)
+
+// Workload reports the processes running on the host's executor.
+type Workload interface {
+	// Procs returns the number of running processes.
+	Procs() uint32
+
+	// Capacity returns the maximum number of processes that the
+	// executor will run concurrently, or zero if it is unlimited.
+	Capacity() uint32
+}
+
+// SysInfo is a Preparer that reports the host's resources in each
+// heartbeat.  Load and memory are read from the proc filesystem, and
+// are left unset on platforms that do not provide it.
+type SysInfo struct {
+	// Workload reports the executor's processes.  If nil, processes
+	// and capacity are not reported.
+	Workload Workload
+
+	// ProcFS is the proc filesystem.  If nil, /proc is used.
+	ProcFS fs.FS
+}
+
+func (info SysInfo) Prepare(h Heartbeat) error {
+	rs := routing.Resources{
+		CPUs: uint32(runtime.NumCPU()),
+	}
+
+	if load, err := info.loadavg(); err == nil {
+		rs.CPULoad = load / float64(rs.CPUs)
+	}
+
+	if meminfo, err := info.meminfo(); err == nil {
+		rs.MemTotal = meminfo["MemTotal"]
+		rs.MemFree = meminfo["MemAvailable"]
+	}
+
+	if info.Workload != nil {
+		rs.Procs = info.Workload.Procs()
+		rs.Capacity = info.Workload.Capacity()
+	}
+
+	return h.SetResources(rs)
+}
+
+func (info SysInfo) procfs() fs.FS {
+	if info.ProcFS == nil {
+		return os.DirFS("/proc")
+	}
+
+	return info.ProcFS
+}
+
+// loadavg returns the one-minute load average.
+func (info SysInfo) loadavg() (float64, error) {
+	b, err := fs.ReadFile(info.procfs(), "loadavg")
+	if err != nil {
+		return 0, err
+	}
+
+	fields := strings.Fields(string(b))
+	if len(fields) == 0 {
+		return 0, fs.ErrInvalid
+	}
+
+	return strconv.ParseFloat(fields[0], 64)
+}
+
+// meminfo returns the memory statistics, in bytes.
+func (info SysInfo) meminfo() (map[string]uint64, error) {
+	b, err := fs.ReadFile(info.procfs(), "meminfo")
+	if err != nil {
+		return nil, err
+	}
+
+	meminfo := make(map[string]uint64)
+	for s := bufio.NewScanner(bytes.NewReader(b)); s.Scan(); {
+		// e.g. "MemTotal:        6147400 kB"
+		key, value, ok := strings.Cut(s.Text(), ":")
+		if !ok {
+			continue
+		}
+
+		fields := strings.Fields(value)
+		if len(fields) == 0 {
+			continue
+		}
+
+		n, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		if len(fields) > 1 && fields[1] == "kB" {
+			n *= 1024
+		}
+
+		meminfo[key] = n
+	}
+
+	return meminfo, nil
+}
@@ -0,0 +1,71 @@
+package pulse_test
+
+import (
+	"runtime"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wetware/pkg/cluster/pulse"
+)
+
+func TestSysInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ProcFS", func(t *testing.T) {
+		t.Parallel()
+
+		info := pulse.SysInfo{
+			Workload: workload{procs: 3, capacity: 8},
+			ProcFS: fstest.MapFS{
+				"loadavg": {Data: []byte("2.00 0.38 0.36 2/72 11922\n")},
+				"meminfo": {Data: []byte(
+					"MemTotal:        4096 kB\n" +
+						"MemFree:         1024 kB\n" +
+						"MemAvailable:    2048 kB\n")},
+			},
+		}
+
+		h := pulse.NewHeartbeat()
+		require.NoError(t, info.Prepare(h), "should prepare heartbeat")
+
+		rs, ok := h.Resources()
+		require.True(t, ok, "should report resources")
+		assert.Equal(t, uint32(runtime.NumCPU()), rs.CPUs)
+		assert.Equal(t, 2/float64(runtime.NumCPU()), rs.CPULoad,
+			"should report load average per CPU")
+		assert.Equal(t, uint64(4096*1024), rs.MemTotal)
+		assert.Equal(t, uint64(2048*1024), rs.MemFree,
+			"should report available memory")
+		assert.Equal(t, uint32(3), rs.Procs)
+		assert.Equal(t, uint32(8), rs.Capacity)
+	})
+
+	t.Run("Unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		info := pulse.SysInfo{ProcFS: fstest.MapFS{}}
+
+		h := pulse.NewHeartbeat()
+		require.NoError(t, info.Prepare(h), "should prepare heartbeat")
+
+		rs, ok := h.Resources()
+		require.True(t, ok, "should report resources")
+		assert.NotZero(t, rs.CPUs, "should report CPUs")
+		assert.Zero(t, rs.MemTotal, "should not report memory")
+		assert.Zero(t, rs.Capacity, "should not report capacity")
+	})
+
+	t.Run("NotReported", func(t *testing.T) {
+		t.Parallel()
+
+		_, ok := pulse.NewHeartbeat().Resources()
+		assert.False(t, ok, "should not report resources")
+	})
+}
+
+type workload struct{ procs, capacity uint32 }
+
+func (w workload) Procs() uint32    { return w.procs }
+func (w workload) Capacity() uint32 { return w.capacity }
@@ -0,0 +1,58 @@
+package pulse
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/pnet"
+)
+
+const secretDomain = "ww/pulse/"
+
+// Secret authenticates the heartbeats emitted by members of a private
+// namespace.  Each heartbeat carries a MAC over the originator's peer
+// ID.  Since pubsub messages are signed by the originator, the MAC
+// cannot be replayed by other peers.
+type Secret struct{ key []byte }
+
+// NewSecret derives the heartbeat key for the namespace from the
+// cluster's pre-shared key.  It returns nil if psk is empty, in which
+// case heartbeats are not authenticated.
+func NewSecret(psk pnet.PSK, ns string) *Secret {
+	if len(psk) == 0 {
+		return nil
+	}
+
+	mac := hmac.New(sha256.New, psk)
+	mac.Write([]byte(secretDomain + ns))
+	return &Secret{key: mac.Sum(nil)}
+}
+
+// Preparer returns a Preparer that authenticates heartbeats for the
+// peer.  It SHOULD be the last preparer in the chain.
+func (s *Secret) Preparer(id peer.ID) Preparer {
+	return PreparerFunc(func(h Heartbeat) error {
+		if s == nil {
+			return nil
+		}
+
+		return h.SetAuth(s.sum(id))
+	})
+}
+
+// Verify returns true if the heartbeat was authenticated by the peer.
+func (s *Secret) Verify(id peer.ID, h Heartbeat) bool {
+	if s == nil {
+		return true
+	}
+
+	auth, err := h.Auth()
+	return err == nil && hmac.Equal(auth, s.sum(id))
+}
+
+func (s *Secret) sum(id peer.ID) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(id))
+	return mac.Sum(nil)
+}
@@ -1,6 +1,7 @@
 package query
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strings"
@@ -269,3 +270,62 @@ func metaEq(match func(s0, s1 string) bool, r routing.Record, meta routing.Meta)
 
 	return true
 }
+
+/*
+	Record matchers
+
+	The following matchers evaluate selectors and constraints against
+	individual records, rather than a snapshot of the routing table.
+	This is useful for records that are streamed by routing.Table.Watch.
+*/
+
+// Selects returns a Matcher that reports whether a record would be
+// selected by Select(index).
+func Selects(index routing.Index) (Matcher, error) {
+	if index.Prefix() {
+		return compare(index, bytes.HasPrefix)
+	}
+
+	return compare(index, bytes.Equal)
+}
+
+// Follows returns a Matcher that reports whether a record would be
+// selected by From(index).
+func Follows(index routing.Index) (Matcher, error) {
+	return compare(index, func(key, target []byte) bool {
+		return bytes.Compare(key, target) >= 0
+	})
+}
+
+// Precedes returns a Matcher that reports whether a record would be
+// selected by the To(index) constraint.
+func Precedes(index routing.Index) (Matcher, error) {
+	return compare(index, func(key, target []byte) bool {
+		return bytes.Compare(key, target) <= 0 ||
+			(index.Prefix() && bytes.HasPrefix(key, target))
+	})
+}
+
+// compare the keys under which a record is indexed with the index's
+// key.  The record matches if any of its keys matches.
+func compare(index routing.Index, match func(key, target []byte) bool) (matchFunc, error) {
+	target, err := routing.Key(index)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(r routing.Record) bool {
+		keys, err := routing.Keys(index.String(), r)
+		if err != nil {
+			return false
+		}
+
+		for _, key := range keys {
+			if match(key, target) {
+				return true
+			}
+		}
+
+		return false
+	}, nil
+}
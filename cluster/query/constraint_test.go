@@ -109,6 +109,47 @@ func TestWhere(t *testing.T) {
 	require.NotContains(t, got, recs[0])
 }
 
+func TestMatchers(t *testing.T) {
+	t.Parallel()
+
+	recs := []*record{
+		{host: "alpha"},
+		{host: "bravo"},
+		{host: "bravo.test"},
+		{host: "charlie"},
+	}
+
+	for _, tt := range []struct {
+		name    string
+		matcher func(routing.Index) (query.Matcher, error)
+		index   routing.Index
+		want    []bool
+	}{
+		{"Selects", query.Selects, hostIndex{host: "bravo"}, []bool{false, true, false, false}},
+		{"SelectsPrefix", query.Selects, hostIndex{host: "bravo", prefix: true}, []bool{false, true, true, false}},
+		{"Follows", query.Follows, hostIndex{host: "bravo"}, []bool{false, true, true, true}},
+		{"Precedes", query.Precedes, hostIndex{host: "bravo"}, []bool{true, true, false, false}},
+		{"PrecedesPrefix", query.Precedes, hostIndex{host: "bravo", prefix: true}, []bool{true, true, true, false}},
+	} {
+		m, err := tt.matcher(tt.index)
+		require.NoError(t, err, tt.name)
+
+		for i, r := range recs {
+			require.Equal(t, tt.want[i], m.Match(r),
+				"%s: unexpected result for host %s", tt.name, r.host)
+		}
+	}
+}
+
+type hostIndex struct {
+	host   string
+	prefix bool
+}
+
+func (hostIndex) String() string                { return "host" }
+func (ix hostIndex) Prefix() bool               { return ix.prefix }
+func (ix hostIndex) HostBytes() ([]byte, error) { return []byte(ix.host), nil }
+
 type matchFunc func(routing.Record) bool
 
 func (match matchFunc) Match(r routing.Record) bool {
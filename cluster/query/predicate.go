@@ -0,0 +1,150 @@
+package query
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/wetware/pkg/cluster/routing"
+)
+
+/*
+	Predicates are Matchers that can be composed into boolean
+	expressions, and used with Where.
+*/
+
+// And matches records that satisfy all of the matchers.  It matches
+// all records if ms is empty.
+func And(ms ...Matcher) Matcher {
+	return matchFunc(func(r routing.Record) bool {
+		for _, m := range ms {
+			if !m.Match(r) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+// Or matches records that satisfy any of the matchers.  It matches no
+// records if ms is empty.
+func Or(ms ...Matcher) Matcher {
+	return matchFunc(func(r routing.Record) bool {
+		for _, m := range ms {
+			if m.Match(r) {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+// Not matches records that do not satisfy m.
+func Not(m Matcher) Matcher {
+	return matchFunc(func(r routing.Record) bool {
+		return !m.Match(r)
+	})
+}
+
+// Has matches records with a metadata field for the key.
+func Has(key string) Matcher {
+	return matchFunc(func(r routing.Record) bool {
+		_, ok := metaValue(r, key)
+		return ok
+	})
+}
+
+// MetaEq matches records whose metadata field for the key has the
+// supplied value.
+func MetaEq(key, value string) Matcher {
+	return matchFunc(func(r routing.Record) bool {
+		v, ok := metaValue(r, key)
+		return ok && v == value
+	})
+}
+
+// Op is a numeric comparison operator.
+type Op uint8
+
+const (
+	Eq Op = iota
+	Ne
+	Lt
+	Le
+	Gt
+	Ge
+)
+
+func (op Op) compare(a, b float64) bool {
+	switch op {
+	case Eq:
+		return a == b
+	case Ne:
+		return a != b
+	case Lt:
+		return a < b
+	case Le:
+		return a <= b
+	case Gt:
+		return a > b
+	case Ge:
+		return a >= b
+	}
+
+	return false
+}
+
+// Compare matches records whose metadata field for the key holds a
+// number that satisfies 'value <op> x'.  Records whose field is missing
+// or is not a number do not match.
+func Compare(key string, op Op, x float64) Matcher {
+	return matchFunc(func(r routing.Record) bool {
+		v, ok := metaValue(r, key)
+		if !ok {
+			return false
+		}
+
+		f, err := strconv.ParseFloat(v, 64)
+		return err == nil && op.compare(f, x)
+	})
+}
+
+// HostMatches matches records whose hostname matches the regular
+// expression.
+func HostMatches(re *regexp.Regexp) Matcher {
+	return matchFunc(func(r routing.Record) bool {
+		name, err := r.Host()
+		return err == nil && re.MatchString(name)
+	})
+}
+
+// metaValue returns the value of the first metadata field for key.
+func metaValue(r routing.Record, key string) (string, bool) {
+	meta, err := r.Meta()
+	if err != nil {
+		return "", false
+	}
+
+	for i := 0; i < meta.Len(); i++ {
+		if f, err := meta.At(i); err == nil && f.Key == key {
+			return f.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// Resource matches records that report resources, and whose resource
+// satisfies 'value <op> x'.
+func Resource(res routing.Resource, op Op, x float64) Matcher {
+	return matchFunc(func(r routing.Record) bool {
+		rr, ok := r.(routing.ResourceRecord)
+		if !ok {
+			return false
+		}
+
+		rs, ok := rr.Resources()
+		return ok && op.compare(rs.Get(res), x)
+	})
+}
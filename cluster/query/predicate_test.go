@@ -0,0 +1,61 @@
+package query_test
+
+import (
+	"regexp"
+	"testing"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wetware/pkg/cluster/query"
+	"github.com/wetware/pkg/cluster/routing"
+)
+
+func TestPredicates(t *testing.T) {
+	t.Parallel()
+
+	r := &record{
+		host: "web-01.example.com",
+		meta: newMeta("zone=us-east", "cpu=4", "mem=2.5"),
+		res:  &routing.Resources{CPUs: 4, MemFree: 1 << 30},
+	}
+
+	for _, tt := range []struct {
+		name  string
+		match query.Matcher
+		want  bool
+	}{
+		{"Has", query.Has("zone"), true},
+		{"HasMissing", query.Has("gpu"), false},
+		{"MetaEq", query.MetaEq("zone", "us-east"), true},
+		{"MetaNe", query.MetaEq("zone", "eu-west"), false},
+		{"CompareGe", query.Compare("cpu", query.Ge, 4), true},
+		{"CompareLt", query.Compare("cpu", query.Lt, 4), false},
+		{"CompareFloat", query.Compare("mem", query.Gt, 2), true},
+		{"CompareNaN", query.Compare("zone", query.Ne, 0), false},
+		{"CompareMissing", query.Compare("gpu", query.Ne, 0), false},
+		{"Host", query.HostMatches(regexp.MustCompile(`^web-\d+\.`)), true},
+		{"HostMismatch", query.HostMatches(regexp.MustCompile(`^db-`)), false},
+		{"And", query.And(query.Has("zone"), query.Compare("cpu", query.Eq, 4)), true},
+		{"AndEmpty", query.And(), true},
+		{"Or", query.Or(query.Has("gpu"), query.Has("cpu")), true},
+		{"OrEmpty", query.Or(), false},
+		{"Not", query.Not(query.Has("gpu")), true},
+		{"Resource", query.Resource(routing.MemFree, query.Ge, 1<<30), true},
+		{"ResourceMismatch", query.Resource(routing.CPUs, query.Gt, 4), false},
+	} {
+		assert.Equal(t, tt.want, tt.match.Match(r), tt.name)
+	}
+
+	assert.False(t, query.Resource(routing.CPUs, query.Ge, 0).Match(&record{}),
+		"should not match records without resources")
+}
+
+func newMeta(ss ...string) routing.Meta {
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	meta, _ := capnp.NewTextList(seg, int32(len(ss)))
+	for i, s := range ss {
+		meta.Set(i, s)
+	}
+	return routing.Meta(meta)
+}
@@ -171,6 +171,7 @@ type record struct {
 	host string
 	meta routing.Meta
 	ttl  time.Duration
+	res  *routing.Resources
 }
 
 func (r *record) init() {
@@ -216,6 +217,14 @@ func (r *record) TTL() time.Duration {
 
 func (r *record) Meta() (routing.Meta, error) { return r.meta, nil }
 
+func (r *record) Resources() (routing.Resources, bool) {
+	if r.res == nil {
+		return routing.Resources{}, false
+	}
+
+	return *r.res, true
+}
+
 func newPeerID() peer.ID {
 	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
 	sk, _, err := crypto.GenerateEd25519Key(rnd)
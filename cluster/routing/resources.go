@@ -0,0 +1,174 @@
+package routing
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+
+	pool "github.com/libp2p/go-buffer-pool"
+)
+
+// Resources reports a host's capacity to run processes.  Fields that
+// the host is unable to measure are zero.
+type Resources struct {
+	CPUs     uint32  // number of logical CPUs
+	CPULoad  float64 // load average per CPU; 1.0 is fully loaded
+	MemTotal uint64  // total memory, in bytes
+	MemFree  uint64  // memory available to new processes, in bytes
+	Procs    uint32  // number of running processes
+	Capacity uint32  // max number of processes; zero if unlimited
+}
+
+// Get returns the value of the resource.
+func (rs Resources) Get(r Resource) float64 {
+	switch r {
+	case CPUs:
+		return float64(rs.CPUs)
+	case CPULoad:
+		return rs.CPULoad
+	case MemTotal:
+		return float64(rs.MemTotal)
+	case MemFree:
+		return float64(rs.MemFree)
+	case Procs:
+		return float64(rs.Procs)
+	case Capacity:
+		return float64(rs.Capacity)
+	}
+
+	return 0
+}
+
+// Resource designates a field in Resources.  Each resource has its
+// own index in the routing table, named by Resource.String(), which
+// orders records by the value of the resource.
+type Resource uint8
+
+const (
+	CPUs Resource = iota
+	CPULoad
+	MemTotal
+	MemFree
+	Procs
+	Capacity
+)
+
+func (r Resource) String() string {
+	switch r {
+	case CPUs:
+		return "cpus"
+	case CPULoad:
+		return "cpu_load"
+	case MemTotal:
+		return "mem_total"
+	case MemFree:
+		return "mem_free"
+	case Procs:
+		return "procs"
+	case Capacity:
+		return "capacity"
+	}
+
+	return "unknown"
+}
+
+// ParseResource returns the resource with the supplied name.
+func ParseResource(name string) (Resource, error) {
+	for r := CPUs; r <= Capacity; r++ {
+		if r.String() == name {
+			return r, nil
+		}
+	}
+
+	return 0, errors.New("invalid resource: " + name)
+}
+
+// ResourceRecord is an optional interface for Record, which is
+// implemented by records that report the host's resources.  The
+// boolean is false if the host did not report any.
+type ResourceRecord interface {
+	Resources() (Resources, bool)
+}
+
+// ResourceIndex is an optional interface for Index that designates one
+// of the resource indexes.  The index name is given by the resource's
+// String method.
+type ResourceIndex interface {
+	ResourceValue() float64
+}
+
+func (r record) Resources() (Resources, bool) {
+	if rr, ok := r.Record.(ResourceRecord); ok {
+		return rr.Resources()
+	}
+
+	return Resources{}, false
+}
+
+type resourceIndexer struct{ Resource }
+
+func (ix resourceIndexer) FromObject(obj any) (bool, []byte, error) {
+	switch rec := obj.(type) {
+	case ResourceRecord:
+		rs, ok := rec.Resources()
+		if !ok {
+			return false, nil, nil
+		}
+
+		return true, floatToBytes(rs.Get(ix.Resource)), nil
+
+	case Record:
+		return false, nil, nil
+	}
+
+	return false, nil, errType(obj)
+}
+
+func (ix resourceIndexer) FromArgs(args ...any) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, errNArgs(args)
+	}
+
+	switch arg := args[0].(type) {
+	case ResourceIndex:
+		return floatToBytes(arg.ResourceValue()), nil
+
+	case ResourceRecord:
+		rs, _ := arg.Resources()
+		return floatToBytes(rs.Get(ix.Resource)), nil
+
+	case float64:
+		return floatToBytes(arg), nil
+	}
+
+	return nil, errType(args[0])
+}
+
+// floatToBytes encodes f such that the lexical order of the encoding
+// matches the numeric order of the values.
+func floatToBytes(f float64) []byte {
+	bits := math.Float64bits(f)
+	if f < 0 {
+		bits = ^bits
+	} else {
+		bits |= 1 << 63
+	}
+
+	buf := pool.Get(8)
+	binary.BigEndian.PutUint64(buf, bits)
+	return buf
+}
+
+// ResourceKey is an Index that designates the value of a resource.
+// Selecting records from a ResourceKey yields the records that report
+// at least Value, in ascending order of the resource.
+type ResourceKey struct {
+	Resource Resource
+	Value    float64
+}
+
+func (key ResourceKey) String() string { return key.Resource.String() }
+
+func (ResourceKey) Prefix() bool { return false }
+
+func (key ResourceKey) ResourceValue() float64 { return key.Value }
@@ -72,6 +72,13 @@ type Record interface {
 	Meta() (Meta, error)
 }
 
+// LeaveRecord is an optional interface for Record, which is implemented
+// by records that can announce that the peer is leaving the cluster.
+// If Leaving returns true, the peer's record is evicted from the table.
+type LeaveRecord interface {
+	Leaving() bool
+}
+
 // Snapshot provides iteration strategies over an isolated snapshot
 // of the routing-table.  Implementations MUST NOT mutate the state
 // of the routing table, and MUST support concurrent iteration.
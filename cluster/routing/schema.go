@@ -40,6 +40,36 @@ var schema = memdb.TableSchema{
 			AllowMissing: true,
 			Indexer:      metaIndexer{},
 		},
+		"cpus": {
+			Name:         "cpus",
+			AllowMissing: true,
+			Indexer:      resourceIndexer{CPUs},
+		},
+		"cpu_load": {
+			Name:         "cpu_load",
+			AllowMissing: true,
+			Indexer:      resourceIndexer{CPULoad},
+		},
+		"mem_total": {
+			Name:         "mem_total",
+			AllowMissing: true,
+			Indexer:      resourceIndexer{MemTotal},
+		},
+		"mem_free": {
+			Name:         "mem_free",
+			AllowMissing: true,
+			Indexer:      resourceIndexer{MemFree},
+		},
+		"procs": {
+			Name:         "procs",
+			AllowMissing: true,
+			Indexer:      resourceIndexer{Procs},
+		},
+		"capacity": {
+			Name:         "capacity",
+			AllowMissing: true,
+			Indexer:      resourceIndexer{Capacity},
+		},
 	},
 }
 
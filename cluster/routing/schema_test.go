@@ -1,6 +1,7 @@
 package routing
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/hex"
 	"math/rand"
@@ -361,6 +362,29 @@ func TestMetaIndexer(t *testing.T) {
 	})
 }
 
+func TestResourceIndexer(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("FromObject", func(t *testing.T) {
+		ok, _, err := resourceIndexer{MemFree}.FromObject(testRecord{})
+		assert.NoError(t, err, "should index record")
+		assert.False(t, ok, "record without resources should not be indexed")
+	})
+
+	t.Run("FromArgs", func(t *testing.T) {
+		var prev []byte
+		for _, x := range []float64{-2, -1, -0.5, 0, 0.5, 1, 2, 1 << 40} {
+			index, err := resourceIndexer{MemFree}.FromArgs(x)
+			require.NoError(t, err, "should encode %v", x)
+
+			assert.Negative(t, bytes.Compare(prev, index),
+				"index for %v should sort after its predecessor", x)
+			prev = index
+		}
+	})
+}
+
 func newPeerID() peer.ID {
 	randsrc := rand.New(rand.NewSource(time.Now().UnixNano()))
 	sk, _, err := crypto.GenerateEd25519Key(randsrc)
@@ -13,9 +13,10 @@ type clock struct {
 }
 
 type Table struct {
-	clock   *clock
-	records stm.TableRef
-	sched   stm.Scheduler
+	clock    *clock
+	records  stm.TableRef
+	sched    stm.Scheduler
+	watchers *watchers
 }
 
 func (c *clock) Load() time.Time {
@@ -45,9 +46,10 @@ func New(t0 time.Time) Table {
 	}
 
 	return Table{
-		clock:   clock,
-		records: records,
-		sched:   sched,
+		clock:    clock,
+		records:  records,
+		sched:    sched,
+		watchers: new(watchers),
 	}
 }
 
@@ -67,9 +69,8 @@ func (table Table) Advance(t time.Time) {
 		// Most ticks will not have expired entries, so avoid locking.
 		if rx := table.sched.Txn(false); table.expiredRecords(rx, t) {
 			wx := table.sched.Txn(true)
-			defer wx.Commit()
-
-			table.dropExpired(wx, t)
+			events := table.dropExpired(wx, t)
+			table.watchers.commit(wx.Commit, events)
 		}
 	}
 }
@@ -82,7 +83,7 @@ func (table Table) expiredRecords(tx stm.Txn, t time.Time) bool {
 	return it != nil && it.Next() != nil
 }
 
-func (table Table) dropExpired(wx stm.Txn, t time.Time) {
+func (table Table) dropExpired(wx stm.Txn, t time.Time) (events []Event) {
 	it, err := wx.ReverseLowerBound(table.records, "ttl", t)
 	if err != nil {
 		panic(err)
@@ -93,7 +94,13 @@ func (table Table) dropExpired(wx stm.Txn, t time.Time) {
 			panic(err)
 		}
 
+		events = append(events, Event{
+			Type:   Leave,
+			Record: r.(*record).Record,
+		})
 	}
+
+	return
 }
 
 // Upsert inserts a record in the routing table, updating it
@@ -101,17 +108,61 @@ func (table Table) dropExpired(wx stm.Txn, t time.Time) {
 func (table Table) Upsert(rec Record) bool {
 	// Some records are stale, so avoid locking until we're
 	// sure to write.
+	if leaving(rec) {
+		return table.leave(rec)
+	}
+
 	if rx := table.sched.Txn(false); table.valid(rx, rec) {
 		wx := table.sched.Txn(true)
-		defer wx.Commit()
-
-		table.upsert(wx, rec)
+		ev := table.upsert(wx, rec)
+		table.watchers.commit(wx.Commit, []Event{ev})
 		return true
 	}
 
 	return false
 }
 
+// leave evicts the peer's record.  Only the instance that created the
+// record may evict it, so that a late announcement from a previous run
+// of the host does not evict the current one.  Returns false if rec is
+// stale.
+func (table Table) leave(rec Record) bool {
+	wx := table.sched.Txn(true)
+	v, err := wx.First(table.records, "id", rec)
+	if err != nil {
+		panic(err)
+	}
+
+	// Not in the table?  Accept the record, so that it is relayed to
+	// peers that have seen the host.
+	if v == nil {
+		wx.Abort()
+		return true
+	}
+
+	old := v.(*record)
+	if old.Server() != rec.Server() || old.Seq() >= rec.Seq() {
+		wx.Abort()
+		return false
+	}
+
+	if err = wx.Delete(table.records, old); err != nil {
+		panic(err)
+	}
+
+	table.watchers.commit(wx.Commit, []Event{{
+		Type:   Leave,
+		Record: old.Record,
+	}})
+
+	return true
+}
+
+func leaving(rec Record) bool {
+	l, ok := rec.(LeaveRecord)
+	return ok && l.Leaving()
+}
+
 func (table Table) valid(tx stm.Txn, rec Record) bool {
 	v, err := tx.First(table.records, "id", rec)
 	if v == nil {
@@ -130,11 +181,20 @@ func (table Table) valid(tx stm.Txn, rec Record) bool {
 	return old.Seq() > rec.Seq()
 }
 
-func (table Table) upsert(wx stm.Txn, rec Record) {
+func (table Table) upsert(wx stm.Txn, rec Record) Event {
+	ev := Event{Type: Join, Record: rec}
+	if old, err := wx.First(table.records, "id", rec); err != nil {
+		panic(err)
+	} else if old != nil {
+		ev.Type = Update
+	}
+
 	err := wx.Insert(table.records, table.withDeadline(rec))
 	if err != nil {
 		panic(err)
 	}
+
+	return ev
 }
 
 // record wraps a Record and provides a stable deadline, calculated
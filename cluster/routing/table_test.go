@@ -39,6 +39,40 @@ func TestRoutingTable_upsert(t *testing.T) {
 		"should ACCEPT non-matching instance id and matching sequence")
 }
 
+func TestRoutingTable_resources(t *testing.T) {
+	t.Parallel()
+
+	table := routing.New(t0)
+	for _, rec := range []*record{
+		{host: "c", res: &routing.Resources{MemFree: 3}},
+		{host: "a", res: &routing.Resources{MemFree: 1}},
+		{host: "none"}, // does not report resources
+		{host: "b", res: &routing.Resources{MemFree: 2}},
+	} {
+		require.True(t, table.Upsert(rec), "must upsert record")
+	}
+
+	hosts := func(key routing.ResourceKey) (names []string) {
+		it, err := table.Snapshot().LowerBound(key)
+		require.NoError(t, err, "should return iterator")
+
+		for r := it.Next(); r != nil; r = it.Next() {
+			name, err := r.Host()
+			require.NoError(t, err)
+			names = append(names, name)
+		}
+
+		return
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"},
+		hosts(routing.ResourceKey{Resource: routing.MemFree}),
+		"should order records by resource")
+	assert.Equal(t, []string{"b", "c"},
+		hosts(routing.ResourceKey{Resource: routing.MemFree, Value: 2}),
+		"should start at value")
+}
+
 func TestRoutingTable_advance(t *testing.T) {
 	t.Parallel()
 
@@ -109,6 +143,72 @@ func TestRoutingTable_advance(t *testing.T) {
 	}
 }
 
+func TestRoutingTable_watch(t *testing.T) {
+	t.Parallel()
+
+	table := routing.New(t0)
+
+	var events []routing.Event
+	cancel := table.Watch(func(ev routing.Event) {
+		events = append(events, ev)
+	})
+
+	rec := &record{ttl: time.Millisecond * 10}
+	require.True(t, table.Upsert(rec), "must upsert record")
+	require.False(t, table.Upsert(rec), "must reject stale record")
+	require.True(t, table.Upsert(&record{id: rec.id, ins: rec.ins, seq: 1, ttl: rec.ttl}),
+		"must upsert updated record")
+	table.Advance(t0.Add(time.Millisecond*10 + 1))
+
+	require.Len(t, events, 3, "should not report stale records")
+	assert.Equal(t, routing.Join, events[0].Type)
+	assert.Equal(t, routing.Update, events[1].Type)
+	assert.Equal(t, routing.Leave, events[2].Type)
+	assert.Equal(t, rec.Peer(), events[2].Record.Peer(),
+		"should report expired record")
+
+	cancel()
+	require.True(t, table.Upsert(&record{}), "must upsert record")
+	assert.Len(t, events, 3, "should not report events after cancel")
+}
+
+func TestRoutingTable_leave(t *testing.T) {
+	t.Parallel()
+
+	table := routing.New(t0)
+
+	var events []routing.Event
+	defer table.Watch(func(ev routing.Event) {
+		events = append(events, ev)
+	})()
+
+	rec := &record{seq: 1}
+	require.True(t, table.Upsert(rec), "must upsert record")
+
+	// A previous instance of the host announces that it is leaving.
+	assert.False(t, table.Upsert(&record{id: rec.Peer(), seq: 2, left: true}),
+		"should REJECT leave from other instance")
+
+	assert.False(t, table.Upsert(&record{id: rec.id, ins: rec.ins, left: true}),
+		"should REJECT stale leave")
+
+	assert.True(t, table.Upsert(&record{id: rec.id, ins: rec.ins, seq: 2, left: true}),
+		"should ACCEPT leave from same instance")
+
+	it, err := table.Snapshot().Get(all{})
+	require.NoError(t, err)
+	assert.Zero(t, countRecords(it), "should evict record")
+
+	require.Len(t, events, 2)
+	assert.Equal(t, routing.Leave, events[1].Type)
+	assert.Equal(t, rec.Peer(), events[1].Record.Peer(),
+		"should report evicted record")
+
+	assert.True(t, table.Upsert(&record{seq: 3, left: true}),
+		"should ACCEPT leave from unknown peer, so that it is relayed")
+	assert.Len(t, events, 2, "should not report unknown peer")
+}
+
 func TestRegression_ttl_index(t *testing.T) {
 	t.Parallel()
 
@@ -305,6 +405,8 @@ type record struct {
 	host string
 	meta routing.Meta
 	ttl  time.Duration
+	res  *routing.Resources
+	left bool
 }
 
 func (r *record) init() {
@@ -360,6 +462,16 @@ func (r *record) HostBytes() ([]byte, error) {
 	return []byte(r.host), nil
 }
 
+func (r *record) Resources() (routing.Resources, bool) {
+	if r.res == nil {
+		return routing.Resources{}, false
+	}
+
+	return *r.res, true
+}
+
+func (r *record) Leaving() bool { return r.left }
+
 func countRecords(it routing.Iterator) (i int) {
 	for it.Next() != nil {
 		i++
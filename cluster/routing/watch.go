@@ -0,0 +1,139 @@
+package routing
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/hashicorp/go-memdb"
+)
+
+// EventType describes a change to the routing table.
+type EventType uint8
+
+const (
+	// Join is emitted when a record is inserted for a new peer.
+	Join EventType = iota
+
+	// Update is emitted when a peer's record is replaced by a more
+	// recent one.
+	Update
+
+	// Leave is emitted when a peer's record expires, or when the
+	// peer announces that it is leaving.
+	Leave
+)
+
+func (t EventType) String() string {
+	switch t {
+	case Join:
+		return "join"
+	case Update:
+		return "update"
+	case Leave:
+		return "leave"
+	}
+
+	return "unknown"
+}
+
+// Event is a change to the routing table.  For Leave events, Record
+// is the record that was evicted.
+type Event struct {
+	Type   EventType
+	Record Record
+}
+
+// Watch calls f for each subsequent change to the routing table.
+// Events are delivered in the order in which the changes were made,
+// after they have been committed.  Calls to f are serialized with
+// changes to the table, so f MUST NOT block or modify the table.
+// The returned function stops the watch.
+func (table Table) Watch(f func(Event)) (cancel func()) {
+	return table.watchers.add(f)
+}
+
+type watchers struct {
+	mu   sync.Mutex
+	next uint64
+	fs   map[uint64]func(Event)
+}
+
+func (ws *watchers) add(f func(Event)) func() {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	if ws.fs == nil {
+		ws.fs = make(map[uint64]func(Event))
+	}
+
+	id := ws.next
+	ws.next++
+	ws.fs[id] = f
+
+	return func() {
+		ws.mu.Lock()
+		defer ws.mu.Unlock()
+
+		delete(ws.fs, id)
+	}
+}
+
+// commit the write transaction and notify the watchers of the events
+// it produced.  The watchers' lock is acquired before committing, so
+// that events are delivered in commit order.
+func (ws *watchers) commit(commit func(), events []Event) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	commit()
+
+	for _, ev := range events {
+		for _, f := range ws.fs {
+			f(ev)
+		}
+	}
+}
+
+// Keys returns the keys under which the record is indexed by the named
+// index.  Together with Key, it allows queries to be evaluated against
+// individual records, consistently with Snapshot.
+func Keys(index string, r Record) ([][]byte, error) {
+	s, ok := schema.Indexes[index]
+	if !ok {
+		return nil, errors.New("invalid index: " + index)
+	}
+
+	switch ix := s.Indexer.(type) {
+	case memdb.SingleIndexer:
+		ok, key, err := ix.FromObject(r)
+		if !ok || err != nil {
+			return nil, err
+		}
+		return [][]byte{key}, nil
+
+	case memdb.MultiIndexer:
+		ok, keys, err := ix.FromObject(r)
+		if !ok || err != nil {
+			return nil, err
+		}
+		return keys, nil
+	}
+
+	return nil, errors.New("invalid index: " + index)
+}
+
+// Key returns the key for the index, as used by Snapshot.
+func Key(ix Index) ([]byte, error) {
+	s, ok := schema.Indexes[ix.String()]
+	if !ok {
+		return nil, errors.New("invalid index: " + ix.String())
+	}
+
+	if ix.Prefix() {
+		if p, ok := s.Indexer.(memdb.PrefixIndexer); ok {
+			return p.PrefixFromArgs(ix)
+		}
+	}
+
+	return s.Indexer.FromArgs(ix)
+}
@@ -13,23 +13,22 @@ import (
 	"time"
 
 	"capnproto.org/go/capnp/v3"
+	p2p "github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p-kad-dht/dual"
 	"github.com/libp2p/go-libp2p/core/discovery"
-	local "github.com/libp2p/go-libp2p/core/host"
-	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/pnet"
 	disc_util "github.com/libp2p/go-libp2p/p2p/discovery/routing"
 	routedhost "github.com/libp2p/go-libp2p/p2p/host/routed"
-	ma "github.com/multiformats/go-multiaddr"
 
 	"github.com/urfave/cli/v2"
 
 	core_api "github.com/wetware/pkg/api/core"
 	"github.com/wetware/pkg/auth"
-	"github.com/wetware/pkg/boot"
 	"github.com/wetware/pkg/cap/csp"
 	csp_server "github.com/wetware/pkg/cap/csp/server"
 	"github.com/wetware/pkg/cluster/pulse"
 	"github.com/wetware/pkg/cluster/routing"
+	"github.com/wetware/pkg/cmd/ww/internal/discover"
 	"github.com/wetware/pkg/vat"
 )
 
@@ -201,7 +200,14 @@ func benchmark(c *cli.Context) error {
 }
 
 func serve(c *cli.Context, ec chan csp_server.Runtime, sc chan core_api.Session) error {
-	h, err := vat.ListenP2P(c.StringSlice("listen")...)
+	psk, err := vat.LoadPSK(c.Path("psk"))
+	if err != nil {
+		return fmt.Errorf("psk: %w", err)
+	}
+
+	h, err := p2p.New(vat.DefaultListenOpts(
+		p2p.ListenAddrStrings(listenAddrs(c, psk)...),
+		vat.PrivateNetwork(psk))...)
 	if err != nil {
 		return fmt.Errorf("listen: %w", err)
 	}
@@ -213,7 +219,7 @@ func serve(c *cli.Context, ec chan csp_server.Runtime, sc chan core_api.Session)
 	}
 	defer dht.Close()
 
-	bootstrap, err := newBootstrap(c, h)
+	bootstrap, err := discover.Listen(c, h, psk)
 	if err != nil {
 		return fmt.Errorf("discovery: %w", err)
 	}
@@ -226,26 +232,20 @@ func serve(c *cli.Context, ec chan csp_server.Runtime, sc chan core_api.Session)
 		Ambient:   ambient(dht),
 		Meta:      meta,
 		Auth:      auth.AllowAll,
+		PSK:       psk,
 	}.Serve(c.Context, ec, sc, h)
 }
 
-func newBootstrap(c *cli.Context, h local.Host) (_ boot.Service, err error) {
-	// use discovery service?
-	if len(c.StringSlice("peer")) == 0 {
-		serviceAddr := c.String("discover")
-		return boot.ListenString(h, serviceAddr)
-	}
-
-	// fast path; direct dial a peer
-	maddrs := make([]ma.Multiaddr, len(c.StringSlice("peer")))
-	for i, s := range c.StringSlice("peer") {
-		if maddrs[i], err = ma.NewMultiaddr(s); err != nil {
-			return
-		}
+// listenAddrs returns the host's listen addresses.  QUIC does not
+// support private networks, so private hosts listen on TCP by default.
+func listenAddrs(c *cli.Context, psk pnet.PSK) []string {
+	if len(psk) > 0 && !c.IsSet("listen") {
+		return []string{
+			"/ip4/0.0.0.0/tcp/0",
+			"/ip6/::0/tcp/0"}
 	}
 
-	infos, err := peer.AddrInfosFromP2pAddrs(maddrs...)
-	return boot.StaticAddrs(infos), err
+	return c.StringSlice("listen")
 }
 
 func ambient(dht *dual.DHT) discovery.Discovery {
@@ -255,7 +255,7 @@ func ambient(dht *dual.DHT) discovery.Discovery {
 type tags []routing.MetaField
 
 func (tags tags) Prepare(h pulse.Heartbeat) error {
-	if err := h.SetMeta(tags); err != nil {
+	if err := h.AddMeta(tags...); err != nil {
 		return err
 	}
 
@@ -4,12 +4,10 @@ import (
 	"fmt"
 
 	local "github.com/libp2p/go-libp2p/core/host"
-	"github.com/libp2p/go-libp2p/core/peer"
-	ma "github.com/multiformats/go-multiaddr"
 	"github.com/urfave/cli/v2"
 
 	"github.com/wetware/pkg/auth"
-	"github.com/wetware/pkg/boot"
+	"github.com/wetware/pkg/cmd/ww/internal/discover"
 	"github.com/wetware/pkg/vat"
 )
 
@@ -26,10 +24,27 @@ func Command() *cli.Command {
 	}
 }
 
+// DialP2P returns a host that dials the cluster.  If the --psk flag is
+// set, the host only connects to peers in the private network.
+func DialP2P(c *cli.Context) (local.Host, error) {
+	psk, err := vat.LoadPSK(c.Path("psk"))
+	if err != nil {
+		return nil, fmt.Errorf("psk: %w", err)
+	}
+
+	return vat.DialP2P(vat.PrivateNetwork(psk))
+}
+
 // Login in into the cluster and get an auth.Session capability.
 func BootstrapSession(c *cli.Context, h local.Host) (s auth.Session, r CloseFunc, err error) {
+	psk, err := vat.LoadPSK(c.Path("psk"))
+	if err != nil {
+		err = fmt.Errorf("psk: %w", err)
+		return
+	}
+
 	// Connect to peers.
-	bootstrap, err := newBootstrap(c, h)
+	bootstrap, err := discover.Dial(c, h, psk)
 	if err != nil {
 		err = fmt.Errorf("discovery: %w", err)
 		return
@@ -44,28 +59,10 @@ func BootstrapSession(c *cli.Context, h local.Host) (s auth.Session, r CloseFunc
 	s, err = vat.Dialer{
 		Host:    h,
 		Account: auth.SignerFromHost(h),
+		NS:      c.String("ns"),
 	}.DialDiscover(c.Context, bootstrap, c.String("ns"))
 	if err != nil {
 		return
 	}
 	return
 }
-
-func newBootstrap(c *cli.Context, h local.Host) (_ boot.Service, err error) {
-	// use discovery service?
-	if len(c.StringSlice("peer")) == 0 {
-		serviceAddr := c.String("discover")
-		return boot.DialString(h, serviceAddr)
-	}
-
-	// fast path; direct dial a peer
-	maddrs := make([]ma.Multiaddr, len(c.StringSlice("peer")))
-	for i, s := range c.StringSlice("peer") {
-		if maddrs[i], err = ma.NewMultiaddr(s); err != nil {
-			return
-		}
-	}
-
-	infos, err := peer.AddrInfosFromP2pAddrs(maddrs...)
-	return boot.StaticAddrs(infos), err
-}
@@ -1,6 +1,7 @@
 package cluster
 
 import (
+	"fmt"
 	"io"
 	"os"
 	"time"
@@ -8,7 +9,9 @@ import (
 	"github.com/urfave/cli/v2"
 
 	api "github.com/wetware/pkg/api/core"
-	"github.com/wetware/pkg/vat"
+	"github.com/wetware/pkg/cap/csp"
+	"github.com/wetware/pkg/cap/view"
+	"github.com/wetware/pkg/cluster/routing"
 )
 
 const killTimeout = 30 * time.Second
@@ -18,7 +21,25 @@ func run() *cli.Command {
 		Name:      "run",
 		Usage:     "run a WASM module on a cluster node",
 		ArgsUsage: "<path> (defaults to stdin)",
-		Action:    runAction(),
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "schedule",
+				Usage: "let the cluster choose the node that runs the module",
+			},
+			&cli.StringSliceFlag{
+				Name:  "where",
+				Usage: "only schedule on nodes with metadata `KEY=VALUE`",
+			},
+			&cli.StringSliceFlag{
+				Name:  "avoid",
+				Usage: "do not schedule on the node with server `ID`",
+			},
+			&cli.BoolFlag{
+				Name:  "spread",
+				Usage: "schedule on the least loaded node",
+			},
+		},
+		Action: runAction(),
 	}
 }
 
@@ -38,7 +59,7 @@ func runAction() cli.ActionFunc {
 		}
 
 		// Get a session.
-		h, err := vat.DialP2P()
+		h, err := DialP2P(c)
 		if err != nil {
 			return err
 		}
@@ -48,12 +69,54 @@ func runAction() cli.ActionFunc {
 			return err
 		}
 
-		p, release := sess.Exec().Exec(c.Context, api.Session(sess), rom, 0, args...)
+		if !c.Bool("schedule") {
+			p, release := sess.Exec().Exec(c.Context, api.Session(sess), rom, 0, args...)
+			defer release()
+			return p.Wait(c.Context)
+		}
+
+		placement, err := placement(c)
+		if err != nil {
+			return err
+		}
+
+		f, release := sess.Scheduler().Schedule(c.Context, api.Session{}, rom, placement, args...)
 		defer release()
-		return p.Wait(c.Context)
+
+		_, server, err := f.Host(c.Context)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(c.App.ErrWriter, "scheduled on /%s\n", server)
+
+		return f.Proc().Wait(c.Context)
 	}
 }
 
+func placement(c *cli.Context) (csp.Placement, error) {
+	p := csp.Placement{Spread: c.Bool("spread")}
+
+	for _, s := range c.StringSlice("where") {
+		f, err := routing.ParseField(s)
+		if err != nil {
+			return p, err
+		}
+
+		p.Constraints = append(p.Constraints, view.Where(view.Meta(f.Key, f.Value)))
+	}
+
+	for _, s := range c.StringSlice("avoid") {
+		var id routing.ID
+		if err := id.UnmarshalText([]byte(s)); err != nil {
+			return p, err
+		}
+
+		p.Avoid = append(p.Avoid, id)
+	}
+
+	return p, nil
+}
+
 func bytecode(c *cli.Context) ([]byte, error) {
 	if c.Args().Len() > 0 {
 		return os.ReadFile(c.Args().First()) // file path
@@ -0,0 +1,71 @@
+// Package discover builds the boot services that are used by the ww
+// commands to find peers in the cluster, from the --peer, --discover,
+// --ns and --psk flags.
+package discover
+
+import (
+	local "github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/pnet"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/urfave/cli/v2"
+
+	"github.com/wetware/pkg/boot"
+	"github.com/wetware/pkg/boot/socket"
+)
+
+// Dial returns the boot service of a command that joins the cluster as
+// a client.  The caller MUST close the service when finished.
+func Dial(c *cli.Context, h local.Host, psk pnet.PSK) (boot.Service, error) {
+	return newService(c, h, psk, boot.DialStrings)
+}
+
+// Listen returns the boot service of a command that runs a host.  The
+// caller MUST close the service when finished.
+func Listen(c *cli.Context, h local.Host, psk pnet.PSK) (boot.Service, error) {
+	return newService(c, h, psk, boot.ListenStrings)
+}
+
+type multiFunc func(local.Host, []string, ...socket.Option) (boot.Multi, error)
+
+func newService(c *cli.Context, h local.Host, psk pnet.PSK, newMulti multiFunc) (_ boot.Service, err error) {
+	var m boot.Multi
+
+	// fast path; direct dial a peer
+	if len(c.StringSlice("peer")) > 0 {
+		maddrs := make([]ma.Multiaddr, len(c.StringSlice("peer")))
+		for i, s := range c.StringSlice("peer") {
+			if maddrs[i], err = ma.NewMultiaddr(s); err != nil {
+				return
+			}
+		}
+
+		infos, err := peer.AddrInfosFromP2pAddrs(maddrs...)
+		if err != nil {
+			return nil, err
+		}
+
+		// only fall back on discovery services if explicitly requested
+		if !c.IsSet("discover") {
+			return boot.StaticAddrs(infos), nil
+		}
+
+		m = append(m, boot.Source{
+			Service:  boot.StaticAddrs(infos),
+			Priority: -1, // try static peers first
+		})
+	}
+
+	// use discovery services
+	secret, err := socket.NewSecret(psk, c.String("ns"))
+	if err != nil {
+		return nil, err
+	}
+
+	discover, err := newMulti(h, c.StringSlice("discover"), socket.WithSecret(secret))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(m, discover...), nil
+}
@@ -8,18 +8,27 @@ import (
 	"github.com/wetware/pkg/cap/view"
 	"github.com/wetware/pkg/cluster/routing"
 	"github.com/wetware/pkg/cmd/ww/cluster"
-	"github.com/wetware/pkg/vat"
 )
 
 func Command() *cli.Command {
 	return &cli.Command{
-		Name:   "ls",
+		Name: "ls",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "watch",
+				Usage: "stream changes to the cluster after listing it",
+			},
+			&cli.StringFlag{
+				Name:  "sort",
+				Usage: "list hosts in ascending order of `RESOURCE`, e.g. mem_free",
+			},
+		},
 		Action: list,
 	}
 }
 
 func list(c *cli.Context) error {
-	h, err := vat.DialP2P()
+	h, err := cluster.DialP2P(c)
 	if err != nil {
 		return err
 	}
@@ -30,18 +39,44 @@ func list(c *cli.Context) error {
 		return err
 	}
 
-	it, release := sess.View().Iter(c.Context, query(c))
+	q, err := query(c)
+	if err != nil {
+		return err
+	}
+
+	it, release := sess.View().Iter(c.Context, q)
 	defer release()
 
 	for r := it.Next(); r != nil; r = it.Next() {
 		render(c, r)
 	}
 
-	return it.Err()
+	if err = it.Err(); err != nil || !c.Bool("watch") {
+		return err
+	}
+
+	events, release := sess.View().Watch(c.Context, q)
+	defer release()
+
+	for ev, ok := events.Next(); ok; ev, ok = events.Next() {
+		fmt.Fprintf(c.App.Writer, "%s\t/%s\n", ev.Type, ev.Record.Server())
+	}
+
+	return events.Err()
 }
 
-func query(c *cli.Context) view.Query {
-	return view.NewQuery(view.All())
+func query(c *cli.Context) (view.Query, error) {
+	if !c.IsSet("sort") {
+		return view.NewQuery(view.All()), nil
+	}
+
+	// Hosts that do not report resources are omitted.
+	r, err := routing.ParseResource(c.String("sort"))
+	if err != nil {
+		return nil, err
+	}
+
+	return view.NewQuery(view.From(routing.ResourceKey{Resource: r})), nil
 }
 
 func render(c *cli.Context, r routing.Record) {
@@ -13,7 +13,6 @@ import (
 	"os"
 	"os/signal"
 	"path"
-	"runtime"
 	"syscall"
 	"time"
 
@@ -22,10 +21,12 @@ import (
 	"github.com/tetratelabs/wazero/sys"
 	"github.com/urfave/cli/v2"
 
+	"github.com/wetware/pkg/boot/survey"
 	"github.com/wetware/pkg/cmd/ww/benchmark"
 	"github.com/wetware/pkg/cmd/ww/cluster"
 	"github.com/wetware/pkg/cmd/ww/ls"
 	"github.com/wetware/pkg/cmd/ww/ps"
+	"github.com/wetware/pkg/cmd/ww/registry"
 	"github.com/wetware/pkg/cmd/ww/run"
 	"github.com/wetware/pkg/cmd/ww/start"
 	"github.com/wetware/pkg/util/proto"
@@ -44,11 +45,16 @@ var flags = []cli.Flag{
 		Usage:   "bootstrap peer `ADDR`",
 		EnvVars: []string{"WW_PEERS"},
 	},
-	&cli.StringFlag{
+	&cli.PathFlag{
+		Name:    "psk",
+		Usage:   "join a private cluster using the pre-shared key in `FILE`",
+		EnvVars: []string{"WW_PSK"},
+	},
+	&cli.StringSliceFlag{
 		Name:    "discover",
 		Aliases: []string{"d"},
-		Usage:   "use discovery service",
-		Value:   bootstrapAddr(),
+		Usage:   "use discovery service `ADDR` (repeatable)",
+		Value:   cli.NewStringSlice(bootstrapAddr()),
 		EnvVars: []string{"WW_DISCOVER"},
 	},
 
@@ -73,6 +79,7 @@ var commands = []*cli.Command{
 	run.Command(),
 	start.Command(),
 	cluster.Command(),
+	registry.Command(),
 	benchmark.Command(),
 }
 
@@ -150,35 +157,5 @@ func colorDisabled() bool {
 }
 
 func bootstrapAddr() string {
-	return path.Join("/ip4/228.8.8.8/udp/8822/multicast", eth())
-}
-
-//	func bootstrapAddr() string {
-//		return path.Join("/ip4/228.8.8.8/udp/8822/multicast", loopback())
-//	}
-func eth() string {
-	switch runtime.GOOS {
-	case "darwin":
-		return "lo0"
-	default:
-		if runtime.GOARCH == "amd64" {
-			hostname, err := os.Hostname()
-			if err != nil || hostname != "labtop" {
-				return "enp7s0"
-			} else {
-				return "enp58s0f1"
-			}
-		} else {
-			return "enxb827eb6d6e99"
-		}
-	}
-}
-
-func loopback() string {
-	switch runtime.GOOS {
-	case "darwin":
-		return "lo0"
-	default:
-		return "lo"
-	}
+	return path.Join("/ip4/228.8.8.8/udp/8822/multicast", survey.AnyInterface)
 }
@@ -25,7 +25,7 @@ func Command() *cli.Command {
 		Usage: "list processes running in the cluster",
 		Action: func(c *cli.Context) error {
 			// Get a session.
-			h, err := vat.DialP2P()
+			h, err := cluster.DialP2P(c)
 			if err != nil {
 				return err
 			}
@@ -45,18 +45,21 @@ func Command() *cli.Command {
 			tw.Init(c.App.Writer, 8, 8, 0, '\t', 0)
 			defer tw.Flush()
 
-			// Used to dial each host in the view.
-			d := vat.Dialer{
+			// Used to dial each host in the view.  Hosts that appear
+			// more than once share a session.
+			pool := &vat.Pool{Dialer: vat.Dialer{
 				Host:    h,
 				Account: auth.SignerFromHost(h),
-			}
+			}}
+			defer pool.Close()
+
 			for r := it.Next(); r != nil; r = it.Next() {
 				peer := r.Peer()
 				if peer == h.ID() {
 					continue // skip self, as it has no executor.
 				}
 				// Get a new session from the host.
-				nsess, err := d.Dial(
+				nsess, err := pool.Dial(
 					c.Context,
 					h.Peerstore().PeerInfo(peer),
 					proto.Namespace(c.String("ns"))...)
@@ -66,10 +69,8 @@ func Command() *cli.Command {
 				}
 
 				// Render the executor.
-				e := nsess.Exec()
-				defer e.Release()
-				defer release()
-				renderExec(c, tw, r, e)
+				renderExec(c, tw, r, nsess.Exec())
+				nsess.Release()
 			}
 
 			return it.Err()
@@ -0,0 +1,269 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/urfave/cli/v2"
+
+	core_api "github.com/wetware/pkg/api/core"
+	api "github.com/wetware/pkg/api/registry"
+	"github.com/wetware/pkg/auth"
+	service "github.com/wetware/pkg/cap/registry"
+	"github.com/wetware/pkg/cmd/ww/cluster"
+)
+
+func Command() *cli.Command {
+	return &cli.Command{
+		Name:  "registry",
+		Usage: "advertise and discover services in the cluster",
+		Subcommands: []*cli.Command{
+			provide(),
+			find(),
+		},
+	}
+}
+
+func provide() *cli.Command {
+	return &cli.Command{
+		Name:      "provide",
+		Usage:     "advertise a service until interrupted",
+		ArgsUsage: "<service>",
+		Flags: []cli.Flag{
+			&cli.StringSliceFlag{
+				Name:  "maddr",
+				Usage: "multiaddr at which the service is reachable",
+			},
+			&cli.StringFlag{
+				Name:  "anchor",
+				Usage: "anchor path at which the service is reachable",
+			},
+			&cli.StringFlag{
+				Name:  "cell",
+				Usage: "path of an anchor whose cell holds the service capability",
+			},
+			&cli.StringSliceFlag{
+				Name:  "meta",
+				Usage: "service metadata, as `KEY=VALUE`",
+			},
+			&cli.DurationFlag{
+				Name:  "ttl",
+				Usage: "time for which peers may cache the location",
+				Value: service.DefaultTTL,
+			},
+		},
+		Action: func(c *cli.Context) error {
+			name := c.Args().First()
+			if name == "" {
+				return errors.New("missing service name")
+			}
+
+			// Get a session.
+			h, err := cluster.DialP2P(c)
+			if err != nil {
+				return err
+			}
+			sess, close, err := cluster.BootstrapSession(c, h)
+			defer close()
+			if err != nil {
+				return err
+			}
+
+			loc, err := location(c, name, sess)
+			if err != nil {
+				return err
+			}
+
+			// Locations are signed with the host key, which identifies
+			// the provider to the peers that find it.  The location is
+			// withdrawn when the command exits.
+			e, withdrawal, err := service.Seal(loc, h.Peerstore().PrivKey(h.ID()))
+			if err != nil {
+				return fmt.Errorf("seal location: %w", err)
+			}
+
+			topic, release := sess.PubSub().Join(c.Context, name)
+			defer release()
+
+			f, release := sess.Registry().Provide(c.Context, topic, e, withdrawal)
+			defer release()
+
+			err = f.Await(c.Context)
+			if errors.Is(err, context.Canceled) {
+				err = nil
+			}
+			return err
+		},
+	}
+}
+
+func find() *cli.Command {
+	return &cli.Command{
+		Name:      "find",
+		Usage:     "list the providers of a service",
+		ArgsUsage: "<service>",
+		Flags: []cli.Flag{
+			&cli.DurationFlag{
+				Name:  "timeout",
+				Usage: "time to wait for providers",
+				Value: time.Second * 5,
+			},
+			&cli.IntFlag{
+				Name:  "limit",
+				Usage: "max number of providers to find; zero means no limit",
+			},
+		},
+		Action: func(c *cli.Context) error {
+			name := c.Args().First()
+			if name == "" {
+				return errors.New("missing service name")
+			}
+
+			// Get a session.
+			h, err := cluster.DialP2P(c)
+			if err != nil {
+				return err
+			}
+			sess, close, err := cluster.BootstrapSession(c, h)
+			defer close()
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(c.Context, c.Duration("timeout"))
+			defer cancel()
+
+			topic, release := sess.PubSub().Join(ctx, name)
+			defer release()
+
+			it, release := sess.Registry().FindProviders(ctx, topic, c.Int("limit"))
+			defer release()
+
+			for loc, ok := it.Next(); ok; loc, ok = it.Next() {
+				if err := render(c, loc); err != nil {
+					return err
+				}
+			}
+
+			// Providers are discovered until the timeout expires.
+			if err = it.Err(); errors.Is(err, context.DeadlineExceeded) {
+				err = nil
+			}
+			return err
+		},
+	}
+}
+
+func location(c *cli.Context, name string, sess auth.Session) (service.Location, error) {
+	loc, err := service.NewLocation()
+	if err != nil {
+		return loc, err
+	}
+
+	if err = loc.SetService(name); err != nil {
+		return loc, err
+	}
+
+	loc.SetTTL(c.Duration("ttl"))
+
+	meta, err := loc.NewMeta(int32(len(c.StringSlice("meta"))))
+	if err != nil {
+		return loc, err
+	}
+	for i, kv := range c.StringSlice("meta") {
+		if err = meta.Set(i, kv); err != nil {
+			return loc, err
+		}
+	}
+
+	switch {
+	case c.IsSet("cell"):
+		// The capability is hosted by the vat we logged into, rather
+		// than by the peer that signs the location.
+		host, err := core_api.Session(sess).Local().Peer()
+		if err != nil {
+			return loc, err
+		}
+
+		id, err := peer.Decode(host)
+		if err != nil {
+			return loc, err
+		}
+
+		ref, err := loc.SetCapRef(peer.AddrInfo{ID: id})
+		if err != nil {
+			return loc, err
+		}
+
+		return loc, ref.SetCell(c.String("cell"))
+
+	case c.IsSet("anchor"):
+		return loc, loc.SetAnchor(c.String("anchor"))
+
+	case c.IsSet("maddr"):
+		maddrs := make([]ma.Multiaddr, len(c.StringSlice("maddr")))
+		for i, s := range c.StringSlice("maddr") {
+			if maddrs[i], err = ma.NewMultiaddr(s); err != nil {
+				return loc, err
+			}
+		}
+
+		return loc, loc.SetMaddrs(maddrs)
+	}
+
+	return loc, errors.New("must specify --maddr, --anchor or --cell")
+}
+
+func render(c *cli.Context, loc service.Location) error {
+	var addr string
+	switch loc.Which() {
+	case api.Location_Which_maddrs:
+		maddrs, err := loc.Maddrs()
+		if err != nil {
+			return err
+		}
+
+		ss := make([]string, len(maddrs))
+		for i, maddr := range maddrs {
+			ss[i] = maddr.String()
+		}
+		addr = strings.Join(ss, ",")
+
+	case api.Location_Which_anchor:
+		path, err := loc.Anchor()
+		if err != nil {
+			return err
+		}
+		addr = path
+
+	case api.Location_Which_capability:
+		host, err := loc.CapHost()
+		if err != nil {
+			return err
+		}
+		addr = "cap@" + host.ID.String()
+
+	default:
+		addr = "<custom>"
+	}
+
+	meta, err := loc.Meta()
+	if err != nil {
+		return err
+	}
+
+	ss := make([]string, meta.Len())
+	for i := range ss {
+		if ss[i], err = meta.At(i); err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(c.App.Writer, "%s\t%s\n", addr, strings.Join(ss, " "))
+	return err
+}
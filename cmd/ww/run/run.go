@@ -4,14 +4,11 @@ import (
 	"fmt"
 	"os"
 
-	local "github.com/libp2p/go-libp2p/core/host"
-	"github.com/libp2p/go-libp2p/core/peer"
-	ma "github.com/multiformats/go-multiaddr"
 	"github.com/urfave/cli/v2"
 
 	ww "github.com/wetware/pkg"
 	"github.com/wetware/pkg/auth"
-	"github.com/wetware/pkg/boot"
+	"github.com/wetware/pkg/cmd/ww/internal/discover"
 	"github.com/wetware/pkg/rom"
 	"github.com/wetware/pkg/vat"
 )
@@ -40,13 +37,18 @@ func Command() *cli.Command {
 }
 
 func run(c *cli.Context) error {
-	h, err := vat.DialP2P()
+	psk, err := vat.LoadPSK(c.Path("psk"))
+	if err != nil {
+		return fmt.Errorf("psk: %w", err)
+	}
+
+	h, err := vat.DialP2P(vat.PrivateNetwork(psk))
 	if err != nil {
 		return err
 	}
 	defer h.Close()
 
-	bootstrap, err := newBootstrap(c, h)
+	bootstrap, err := discover.Dial(c, h, psk)
 	if err != nil {
 		return fmt.Errorf("discovery: %w", err)
 	}
@@ -101,22 +103,3 @@ func loadROM(c *cli.Context) (rom.ROM, error) {
 
 	return rom.Read(f)
 }
-
-func newBootstrap(c *cli.Context, h local.Host) (_ boot.Service, err error) {
-	// use discovery service?
-	if len(c.StringSlice("peer")) == 0 {
-		serviceAddr := c.String("discover")
-		return boot.DialString(h, serviceAddr)
-	}
-
-	// fast path; direct dial a peer
-	maddrs := make([]ma.Multiaddr, len(c.StringSlice("peer")))
-	for i, s := range c.StringSlice("peer") {
-		if maddrs[i], err = ma.NewMultiaddr(s); err != nil {
-			return
-		}
-	}
-
-	infos, err := peer.AddrInfosFromP2pAddrs(maddrs...)
-	return boot.StaticAddrs(infos), err
-}
@@ -4,22 +4,23 @@ import (
 	"fmt"
 	"os"
 
+	ds "github.com/ipfs/go-datastore"
+	leveldb "github.com/ipfs/go-ds-leveldb"
+	p2p "github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p-kad-dht/dual"
 	"github.com/libp2p/go-libp2p/core/discovery"
-	local "github.com/libp2p/go-libp2p/core/host"
-	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/pnet"
 	disc_util "github.com/libp2p/go-libp2p/p2p/discovery/routing"
 	routedhost "github.com/libp2p/go-libp2p/p2p/host/routed"
-	ma "github.com/multiformats/go-multiaddr"
 
 	"github.com/urfave/cli/v2"
 
 	core_api "github.com/wetware/pkg/api/core"
 	"github.com/wetware/pkg/auth"
-	"github.com/wetware/pkg/boot"
 	csp_server "github.com/wetware/pkg/cap/csp/server"
 	"github.com/wetware/pkg/cluster/pulse"
 	"github.com/wetware/pkg/cluster/routing"
+	"github.com/wetware/pkg/cmd/ww/internal/discover"
 	"github.com/wetware/pkg/vat"
 )
 
@@ -40,6 +41,22 @@ var flags = []cli.Flag{
 		Usage:   "metadata fields in key=value format",
 		EnvVars: []string{"WW_META"},
 	},
+	&cli.PathFlag{
+		Name:    "data",
+		Usage:   "persist host state in `DIR`",
+		EnvVars: []string{"WW_DATA"},
+	},
+	&cli.UintFlag{
+		Name:    "max-procs",
+		Usage:   "max number of concurrent processes; zero means no limit",
+		EnvVars: []string{"WW_MAX_PROCS"},
+	},
+	&cli.DurationFlag{
+		Name:    "shutdown-timeout",
+		Usage:   "time to wait for processes to exit on shutdown",
+		Value:   vat.DefaultShutdownTimeout,
+		EnvVars: []string{"WW_SHUTDOWN_TIMEOUT"},
+	},
 }
 
 func Command() *cli.Command {
@@ -71,7 +88,14 @@ func setup(c *cli.Context) error {
 }
 
 func serve(c *cli.Context) error {
-	h, err := vat.ListenP2P(c.StringSlice("listen")...)
+	psk, err := vat.LoadPSK(c.Path("psk"))
+	if err != nil {
+		return fmt.Errorf("psk: %w", err)
+	}
+
+	h, err := p2p.New(vat.DefaultListenOpts(
+		p2p.ListenAddrStrings(listenAddrs(c, psk)...),
+		vat.PrivateNetwork(psk))...)
 	if err != nil {
 		return fmt.Errorf("listen: %w", err)
 	}
@@ -83,12 +107,20 @@ func serve(c *cli.Context) error {
 	}
 	defer dht.Close()
 
-	bootstrap, err := newBootstrap(c, h)
+	bootstrap, err := discover.Listen(c, h, psk)
 	if err != nil {
 		return fmt.Errorf("discovery: %w", err)
 	}
 	defer bootstrap.Close()
 
+	store, err := newDatastore(c)
+	if err != nil {
+		return fmt.Errorf("datastore: %w", err)
+	}
+	if store != nil {
+		defer store.Close()
+	}
+
 	ec := make(chan csp_server.Runtime, 1)
 	sc := make(chan core_api.Session, 1)
 	return vat.Config{
@@ -98,26 +130,43 @@ func serve(c *cli.Context) error {
 		Ambient:   ambient(dht),
 		Meta:      meta,
 		Auth:      auth.AllowAll,
+		PSK:       psk,
+		Datastore: store,
+		MaxProcs:  uint32(c.Uint("max-procs")),
+
+		ShutdownTimeout: c.Duration("shutdown-timeout"),
 	}.Serve(c.Context, ec, sc, h)
 }
 
-func newBootstrap(c *cli.Context, h local.Host) (_ boot.Service, err error) {
-	// use discovery service?
-	if len(c.StringSlice("peer")) == 0 {
-		serviceAddr := c.String("discover")
-		return boot.ListenString(h, serviceAddr)
+// listenAddrs returns the host's listen addresses.  QUIC does not
+// support private networks, so private hosts listen on TCP by default.
+func listenAddrs(c *cli.Context, psk pnet.PSK) []string {
+	if len(psk) > 0 && !c.IsSet("listen") {
+		return vat.DefaultPrivateListenAddrs
 	}
 
-	// fast path; direct dial a peer
-	maddrs := make([]ma.Multiaddr, len(c.StringSlice("peer")))
-	for i, s := range c.StringSlice("peer") {
-		if maddrs[i], err = ma.NewMultiaddr(s); err != nil {
-			return
-		}
+	return c.StringSlice("listen")
+}
+
+// newDatastore opens a LevelDB datastore in the --data directory,
+// creating the directory if needed.  The caller MUST close it.  It returns nil if --data is unset, in which
+// case host state is held in memory.
+func newDatastore(c *cli.Context) (ds.Datastore, error) {
+	dir := c.Path("data")
+	if dir == "" {
+		return nil, nil
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	d, err := leveldb.NewDatastore(dir, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	infos, err := peer.AddrInfosFromP2pAddrs(maddrs...)
-	return boot.StaticAddrs(infos), err
+	return d, nil
 }
 
 func ambient(dht *dual.DHT) discovery.Discovery {
@@ -127,7 +176,7 @@ func ambient(dht *dual.DHT) discovery.Discovery {
 type tags []routing.MetaField
 
 func (tags tags) Prepare(h pulse.Heartbeat) error {
-	if err := h.SetMeta(tags); err != nil {
+	if err := h.AddMeta(tags...); err != nil {
 		return err
 	}
 
@@ -0,0 +1,63 @@
+//go:generate env GOOS=wasip1 GOARCH=wasm go build -o pubsub.wasm pubsub.go
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wetware/pkg/guest/system"
+)
+
+var msg = []byte("hello, world!")
+
+// Joins a topic through the session's pubsub router, then publishes
+// to it until the message is received by its own subscription.
+func main() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	sess, err := system.Bootstrap(ctx)
+	if err != nil {
+		panic(err)
+	}
+	defer sess.Release()
+
+	topic, release := sess.PubSub().Join(ctx, "test")
+	defer release()
+
+	sub, release := topic.Subscribe(ctx)
+	defer release()
+
+	// Subscriptions are registered asynchronously, so messages published
+	// immediately after subscribing may be missed.  Keep publishing until
+	// one is received.
+	go func() {
+		ticker := time.NewTicker(time.Millisecond * 100)
+		defer ticker.Stop()
+
+		for {
+			if err := topic.Publish(ctx, msg); err != nil {
+				return
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	got := sub.Next()
+	if err := sub.Err(); err != nil {
+		panic(err)
+	}
+
+	if !bytes.Equal(got, msg) {
+		panic(fmt.Errorf("unexpected message: %q", got))
+	}
+
+	fmt.Printf("Received message %q\n", got)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"capnproto.org/go/capnp/v3"
 	"capnproto.org/go/capnp/v3/rpc"
 	"golang.org/x/exp/slog"
 
@@ -13,9 +14,14 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
 
+	anchor_api "github.com/wetware/pkg/api/anchor"
+	capstore_api "github.com/wetware/pkg/api/capstore"
 	api "github.com/wetware/pkg/api/core"
+	registry_api "github.com/wetware/pkg/api/registry"
 	"github.com/wetware/pkg/auth"
 	"github.com/wetware/pkg/boot"
+	"github.com/wetware/pkg/cap/anchor"
+	service "github.com/wetware/pkg/cap/registry"
 	"github.com/wetware/pkg/system"
 	"github.com/wetware/pkg/util/proto"
 )
@@ -23,6 +29,10 @@ import (
 type Dialer struct {
 	Host    local.Host
 	Account auth.Signer
+
+	// NS is the cluster namespace, which is used by Resolve to select
+	// the protocol spoken by the vat.  It defaults to "ww".
+	NS string
 }
 
 func (d Dialer) DialDiscover(ctx context.Context, disc discovery.Discoverer, ns string) (auth.Session, error) {
@@ -75,6 +85,121 @@ func (d Dialer) Dial(ctx context.Context, addr peer.AddrInfo, protos ...protocol
 	return auth.Session(sess).AddRef(), nil
 }
 
+// Resolve the capability referenced by a registry location.  Resolve
+// logs into the vat that hosts the capability, and dereferences it from
+// the session.  The capability remains usable after the session is
+// released.  Its connection is closed when the last reference to the
+// returned client is released.
+func (d Dialer) Resolve(ctx context.Context, loc service.Location) (capnp.Client, error) {
+	host, err := loc.CapHost()
+	if err != nil {
+		return capnp.Client{}, err
+	}
+
+	ref, err := loc.Capability()
+	if err != nil {
+		return capnp.Client{}, err
+	}
+
+	ns := d.NS
+	if ns == "" {
+		ns = "ww"
+	}
+
+	conn, err := d.DialRPC(ctx, host, proto.Namespace(ns)...)
+	if err != nil {
+		return capnp.Client{}, fmt.Errorf("dial: %w", err)
+	}
+
+	client, err := d.deref(ctx, conn, ref)
+	if err != nil {
+		conn.Close()
+		return capnp.Client{}, err
+	}
+
+	return capnp.NewClient(&leaseHook{
+		client:  client, // steal the reference
+		release: func() { conn.Close() },
+	}), nil
+}
+
+// deref logs into the vat at the other end of conn, and dereferences
+// ref from the session.
+func (d Dialer) deref(ctx context.Context, conn *rpc.Conn, ref registry_api.CapRef) (capnp.Client, error) {
+	sess, err := d.DialConn(ctx, conn)
+	if err != nil {
+		return capnp.Client{}, err
+	}
+	defer sess.Release()
+
+	switch ref.Which() {
+	case registry_api.CapRef_Which_sturdyRef:
+		sturdy, err := ref.SturdyRef()
+		if err != nil {
+			return capnp.Client{}, err
+		}
+		return restore(ctx, sess, sturdy)
+
+	case registry_api.CapRef_Which_cell:
+		path, err := ref.Cell()
+		if err != nil {
+			return capnp.Client{}, err
+		}
+		return load(ctx, sess.Anchor(), path)
+	}
+
+	return capnp.Client{}, fmt.Errorf("unknown capability reference: %d", ref.Which())
+}
+
+func restore(ctx context.Context, sess auth.Session, ref capstore_api.SturdyRef) (capnp.Client, error) {
+	switch ref.Which() {
+	case capstore_api.SturdyRef_Which_executor:
+		return capnp.Client(sess.Exec()).AddRef(), nil
+
+	case capstore_api.SturdyRef_Which_anchor:
+		path, err := ref.Anchor()
+		if err != nil {
+			return capnp.Client{}, err
+		}
+
+		a, release := sess.Anchor().Walk(ctx, path)
+		defer release()
+
+		return capnp.Client(a).AddRef(), nil
+	}
+
+	return capnp.Client{}, fmt.Errorf("unknown sturdy ref: %s", ref.Which())
+}
+
+// load the capability held by the cell of the anchor at path.
+func load(ctx context.Context, root anchor.Anchor, path string) (capnp.Client, error) {
+	a, release := root.Walk(ctx, path)
+	defer release()
+
+	cell, release := anchor_api.Anchor(a).Cell(ctx, nil)
+	defer release()
+
+	f, release := cell.Loader().Load(ctx, nil)
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return capnp.Client{}, fmt.Errorf("load %s: %w", path, err)
+	}
+
+	v, err := res.Value()
+	if err != nil {
+		return capnp.Client{}, fmt.Errorf("load %s: %w", path, err)
+	}
+
+	c := v.Interface().Client()
+	if c == (capnp.Client{}) {
+		return capnp.Client{}, fmt.Errorf("load %s: cell does not hold a capability", path)
+	}
+
+	return c.AddRef(), nil
+}
+
 func (d Dialer) DialRPC(ctx context.Context, addr peer.AddrInfo, protos ...protocol.ID) (*rpc.Conn, error) {
 	s, err := d.DialP2P(ctx, addr, protos...)
 	if err != nil {
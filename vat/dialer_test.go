@@ -0,0 +1,73 @@
+package vat_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cluster_api "github.com/wetware/pkg/api/cluster"
+	"github.com/wetware/pkg/auth"
+	service "github.com/wetware/pkg/cap/registry"
+	"github.com/wetware/pkg/cap/view"
+	"github.com/wetware/pkg/util/proto"
+	"github.com/wetware/pkg/vat"
+)
+
+func TestDialer_Resolve(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	server, client := newInprocHost(t), newInprocHost(t)
+
+	term := new(terminal)
+	for _, id := range proto.Namespace("test") {
+		server.SetStreamHandler(id, term.handle)
+	}
+
+	loc, err := service.NewLocation()
+	require.NoError(t, err, "should create location")
+	ref, err := loc.SetCapRef(*host.InfoFromHost(server))
+	require.NoError(t, err, "should set capability reference")
+	sturdy, err := ref.NewSturdyRef()
+	require.NoError(t, err, "should set sturdy ref")
+	sturdy.SetExecutor()
+
+	d := vat.Dialer{
+		Host:    client,
+		Account: auth.SignerFromHost(client),
+		NS:      "test",
+	}
+
+	c, err := d.Resolve(ctx, loc)
+	require.NoError(t, err, "should resolve capability")
+
+	v := view.View(cluster_api.View(c))
+	f, release := v.Lookup(ctx, view.NewQuery(view.All()))
+	defer release()
+
+	_, err = f.Await(ctx)
+	require.NoError(t, err, "capability should outlive the session")
+
+	select {
+	case <-term.Conn(0).Done():
+		t.Fatal("connection closed while capability is in use")
+	default:
+	}
+
+	c.Release()
+
+	assert.Eventually(t, func() bool {
+		select {
+		case <-term.Conn(0).Done():
+			return true
+		default:
+			return false
+		}
+	}, time.Second*5, time.Millisecond*10, "should close connection after release")
+}
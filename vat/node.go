@@ -0,0 +1,306 @@
+package vat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+
+	p2p "github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/discovery"
+	local "github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/pnet"
+	disc_util "github.com/libp2p/go-libp2p/p2p/discovery/routing"
+	routedhost "github.com/libp2p/go-libp2p/p2p/host/routed"
+	"github.com/tetratelabs/wazero"
+
+	core_api "github.com/wetware/pkg/api/core"
+	"github.com/wetware/pkg/auth"
+	"github.com/wetware/pkg/boot"
+	"github.com/wetware/pkg/boot/socket"
+	"github.com/wetware/pkg/boot/survey"
+	csp_server "github.com/wetware/pkg/cap/csp/server"
+	"github.com/wetware/pkg/cluster"
+	"github.com/wetware/pkg/cluster/pulse"
+)
+
+// DefaultNamespace is the cluster namespace that a Node joins by default.
+const DefaultNamespace = "ww"
+
+var (
+	// DefaultListenAddrs are the addresses on which a Node listens by
+	// default.
+	DefaultListenAddrs = []string{
+		"/ip4/0.0.0.0/udp/0/quic-v1",
+		"/ip6/::0/udp/0/quic-v1"}
+
+	// DefaultPrivateListenAddrs are the addresses on which a Node in a
+	// private network listens by default.  QUIC does not support private
+	// networks, so they are TCP addresses.
+	DefaultPrivateListenAddrs = []string{
+		"/ip4/0.0.0.0/tcp/0",
+		"/ip6/::0/tcp/0"}
+
+	// DefaultBootstrapAddr is the discovery service that a Node uses to
+	// find its peers by default.
+	DefaultBootstrapAddr = path.Join("/ip4/228.8.8.8/udp/8822/multicast", survey.AnyInterface)
+)
+
+// Node is a wetware host that is embedded in a Go program.  It is
+// created with New, and runs until it is closed.
+type Node struct {
+	conf   Config
+	listen []string
+
+	host    local.Host  // unwrapped host, passed to Serve
+	closers []io.Closer // resources owned by the node
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	err    error
+
+	sess   core_api.Session
+	exec   csp_server.Runtime
+	router *cluster.Router
+}
+
+// Option configures a Node.
+type Option func(*Node)
+
+// WithListenAddrs sets the addresses on which the node's host listens.
+// If addrs is empty, the host listens on DefaultListenAddrs, or on
+// DefaultPrivateListenAddrs if the node is in a private network.  It
+// has no effect if the host is set with WithHost.
+func WithListenAddrs(addrs ...string) Option {
+	return func(n *Node) {
+		n.listen = addrs
+	}
+}
+
+// WithHost causes the node to run on an existing host, instead of
+// creating its own.  The caller retains ownership of h, and MUST close
+// it after closing the node.
+func WithHost(h local.Host) Option {
+	return func(n *Node) {
+		n.conf.Host = h
+	}
+}
+
+// WithPSK joins the private network of peers holding the pre-shared
+// key.  It restricts the host that the node creates to those peers, and
+// is used to authenticate discovery.  If psk is empty, the node joins
+// the public network.  When the host is set with WithHost, the caller
+// is responsible for configuring it with PrivateNetwork.
+func WithPSK(psk pnet.PSK) Option {
+	return func(n *Node) {
+		n.conf.PSK = psk
+	}
+}
+
+// WithNamespace sets the cluster namespace.
+func WithNamespace(ns string) Option {
+	if ns == "" {
+		ns = DefaultNamespace
+	}
+
+	return func(n *Node) {
+		n.conf.NS = ns
+	}
+}
+
+// WithBootstrap sets the discovery service used to find peers when
+// joining the cluster.  The caller retains ownership of d.  If d == nil,
+// the node listens for peers on DefaultBootstrapAddr.
+func WithBootstrap(d discovery.Discovery) Option {
+	return func(n *Node) {
+		n.conf.Bootstrap = d
+	}
+}
+
+// WithAuth sets the policy that authenticates accounts logging into
+// the node.  If p == nil, all accounts are allowed.
+func WithAuth(p auth.Policy) Option {
+	if p == nil {
+		p = auth.AllowAll
+	}
+
+	return func(n *Node) {
+		n.conf.Auth = p
+	}
+}
+
+// WithMeta sets the preparer that adds static metadata to the node's
+// heartbeats.  If p == nil, no static metadata is announced.
+func WithMeta(p pulse.Preparer) Option {
+	return func(n *Node) {
+		n.conf.Meta = p
+	}
+}
+
+// WithRuntimeConfig sets the configuration for the WASM runtime that
+// executes processes.  If rc == nil, a default configuration is used.
+func WithRuntimeConfig(rc wazero.RuntimeConfig) Option {
+	return func(n *Node) {
+		n.conf.RuntimeConfig = rc
+	}
+}
+
+func withDefault(opt []Option) []Option {
+	return append([]Option{
+		WithListenAddrs(),
+		WithNamespace(""),
+		WithAuth(nil),
+	}, opt...)
+}
+
+// New starts a node, and returns once it has joined the cluster.  The
+// node runs until Close is called, or it fails.
+func New(opt ...Option) (*Node, error) {
+	n := &Node{done: make(chan struct{})}
+	for _, option := range withDefault(opt) {
+		option(n)
+	}
+
+	if err := n.setup(); err != nil {
+		n.release()
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	n.cancel = cancel
+
+	ready := make(chan struct{})
+	go func() {
+		defer close(n.done)
+		defer n.release()
+
+		n.err = n.conf.serve(ctx, n.host, func(s core_api.Session, e csp_server.Runtime, r *cluster.Router) {
+			n.sess, n.exec, n.router = s, e, r
+			close(ready)
+		})
+
+		if sess := auth.Session(n.sess); sess != (auth.Session{}) {
+			sess.Release()
+		}
+	}()
+
+	select {
+	case <-ready:
+		return n, nil
+	case <-n.done:
+		cancel()
+		return nil, n.err
+	}
+}
+
+// setup creates the host and discovery services that were not supplied
+// as options.
+func (n *Node) setup() (err error) {
+	if n.conf.Host == nil {
+		n.conf.Host, err = p2p.New(DefaultListenOpts(
+			p2p.ListenAddrStrings(n.listenAddrs()...),
+			PrivateNetwork(n.conf.PSK))...)
+		if err != nil {
+			return fmt.Errorf("listen: %w", err)
+		}
+		n.closers = append(n.closers, n.conf.Host)
+	}
+	n.host = n.conf.Host
+
+	dht, err := NewDHT(context.Background(), n.host, n.conf.NS)
+	if err != nil {
+		return fmt.Errorf("dht: %w", err)
+	}
+	n.closers = append(n.closers, dht)
+
+	n.conf.Host = routedhost.Wrap(n.host, dht)
+	n.conf.Ambient = disc_util.NewRoutingDiscovery(dht)
+
+	if n.conf.Bootstrap == nil {
+		secret, err := socket.NewSecret(n.conf.PSK, n.conf.NS)
+		if err != nil {
+			return fmt.Errorf("discovery: %w", err)
+		}
+
+		d, err := boot.ListenString(n.host, DefaultBootstrapAddr, socket.WithSecret(secret))
+		if err != nil {
+			return fmt.Errorf("discovery: %w", err)
+		}
+		n.closers = append(n.closers, d)
+		n.conf.Bootstrap = d
+	}
+
+	return nil
+}
+
+// listenAddrs returns the addresses on which the node's host listens.
+func (n *Node) listenAddrs() []string {
+	switch {
+	case len(n.listen) > 0:
+		return n.listen
+	case len(n.conf.PSK) > 0:
+		return DefaultPrivateListenAddrs
+	default:
+		return DefaultListenAddrs
+	}
+}
+
+// release the resources owned by the node, in reverse order of creation.
+func (n *Node) release() {
+	for i := len(n.closers) - 1; i >= 0; i-- {
+		n.closers[i].Close()
+	}
+}
+
+// Session returns the node's root session.  The caller MUST release
+// the session when finished.
+func (n *Node) Session() auth.Session {
+	return auth.Session(n.sess).AddRef()
+}
+
+// Executor returns the runtime that executes the node's processes.
+func (n *Node) Executor() csp_server.Runtime {
+	return n.exec
+}
+
+// Router returns the node's cluster router.
+func (n *Node) Router() *cluster.Router {
+	return n.router
+}
+
+// Host returns the node's libp2p host.
+func (n *Node) Host() local.Host {
+	return n.conf.Host
+}
+
+// Close the node.  It shuts down gracefully, as described in
+// Config.ShutdownTimeout, and releases the resources that it owns.
+// Close returns the error that stopped the node, if any.
+func (n *Node) Close() error {
+	n.cancel()
+	<-n.done
+
+	return n.Err()
+}
+
+// Done returns a channel that is closed when the node has stopped.
+func (n *Node) Done() <-chan struct{} {
+	return n.done
+}
+
+// Err returns the error that stopped the node.  It returns nil if the
+// node is running, or if it was stopped by Close.
+func (n *Node) Err() error {
+	select {
+	case <-n.done:
+	default:
+		return nil
+	}
+
+	if errors.Is(n.err, context.Canceled) {
+		return nil
+	}
+
+	return n.err
+}
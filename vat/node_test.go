@@ -0,0 +1,122 @@
+package vat_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/pnet"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/pkg/cap/view"
+	"github.com/wetware/pkg/cluster/pulse"
+	"github.com/wetware/pkg/cluster/routing"
+	"github.com/wetware/pkg/vat"
+)
+
+func TestNode(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	h := newInprocHost(t)
+
+	n, err := vat.New(
+		vat.WithHost(h),
+		vat.WithNamespace("test"),
+		vat.WithBootstrap(nopDiscovery{}),
+		vat.WithMeta(pulse.PreparerFunc(func(hb pulse.Heartbeat) error {
+			return hb.AddMeta(routing.MetaField{Key: "foo", Value: "bar"})
+		})))
+	require.NoError(t, err, "should start node")
+
+	sess := n.Session()
+	require.NotZero(t, sess, "should return root session")
+	sess.Release()
+
+	assert.Equal(t, h.ID(), n.Host().ID(), "should run on host")
+	assert.Zero(t, n.Executor().Procs(), "should return executor")
+
+	// The node should see its own heartbeat, including its metadata.
+	v := n.Router().View()
+	defer v.Release()
+
+	require.Eventually(t, func() bool {
+		f, release := v.Lookup(ctx, view.NewQuery(view.All()))
+		defer release()
+
+		rec, err := f.Await(ctx)
+		if err != nil || rec == nil {
+			return false
+		}
+
+		meta, err := rec.Meta()
+		if err != nil {
+			return false
+		}
+
+		foo, err := meta.Get("foo")
+		return err == nil && foo == "bar" && rec.Server() == n.Router().ID()
+	}, time.Second*5, time.Millisecond*10, "should announce metadata")
+
+	select {
+	case <-n.Done():
+		t.Fatal("node stopped before it was closed")
+	default:
+	}
+
+	require.NoError(t, n.Close(), "should close node")
+
+	select {
+	case <-n.Done():
+	default:
+		t.Fatal("node should stop when closed")
+	}
+	assert.NoError(t, n.Err(), "should not report error after Close")
+}
+
+func TestNode_PSK(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	psk := pnet.PSK(bytes.Repeat([]byte{1}, 32))
+
+	n, err := vat.New(
+		vat.WithPSK(psk),
+		vat.WithNamespace("test"),
+		vat.WithBootstrap(nopDiscovery{}))
+	require.NoError(t, err, "should start node")
+	defer n.Close()
+
+	info := peer.AddrInfo{ID: n.Host().ID(), Addrs: n.Host().Addrs()}
+	require.NotEmpty(t, info.Addrs, "should listen")
+	for _, addr := range info.Addrs {
+		_, err := addr.ValueForProtocol(ma.P_TCP)
+		assert.NoError(t, err, "should listen on TCP by default")
+	}
+
+	member, err := libp2p.New(vat.DefaultListenOpts(
+		libp2p.NoListenAddrs,
+		vat.PrivateNetwork(psk))...)
+	require.NoError(t, err, "must create host")
+	defer member.Close()
+
+	public, err := libp2p.New(vat.DefaultListenOpts(libp2p.NoListenAddrs)...)
+	require.NoError(t, err, "must create host")
+	defer public.Close()
+
+	assert.NoError(t, member.Connect(ctx, info), "member should connect")
+
+	// The handshake stalls instead of failing outright.
+	ctx, cancel = context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	assert.Error(t, public.Connect(ctx, info), "public host should not connect")
+}
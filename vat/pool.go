@@ -0,0 +1,270 @@
+package vat
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+
+	core_api "github.com/wetware/pkg/api/core"
+	"github.com/wetware/pkg/auth"
+)
+
+const (
+	// DefaultMaxDials is the default number of sessions that a Pool
+	// dials concurrently.
+	DefaultMaxDials = 8
+
+	// DefaultSessionTTL is the default time after which a Pool logs
+	// into a peer again.
+	DefaultSessionTTL = time.Minute * 5
+)
+
+// ErrPoolClosed is returned when dialing through a closed Pool.
+var ErrPoolClosed = errors.New("pool closed")
+
+// Pool reuses sessions across calls to Dial.  Sessions are keyed by
+// peer ID, so a Pool SHOULD only be used to dial a single namespace.
+// A session is evicted when its connection is closed, and refreshed
+// when it is older than TTL.  Refreshing a session does not affect the
+// sessions that were previously returned by Dial; the underlying
+// connection is closed after all of them have been released.  The
+// zero-value is not ready to use; the Dialer field MUST be set.
+type Pool struct {
+	Dialer
+
+	// MaxDials limits the number of sessions that are dialed
+	// concurrently.  If zero, DefaultMaxDials is used.
+	MaxDials int
+
+	// TTL is the time after which a session is refreshed.  If zero,
+	// DefaultSessionTTL is used.
+	TTL time.Duration
+
+	once   sync.Once
+	sem    chan struct{}
+	mu     sync.Mutex
+	cs     map[peer.ID]*pooled
+	closed bool
+}
+
+type pooled struct {
+	ready   chan struct{} // closed when the dial completes
+	conn    *rpc.Conn
+	sess    auth.Session
+	err     error
+	expires time.Time
+
+	refs atomic.Int32 // pool's reference, plus one per leased capability
+	once sync.Once
+}
+
+func newPooled() *pooled {
+	c := &pooled{ready: make(chan struct{})}
+	c.refs.Store(1)
+	return c
+}
+
+func (p *Pool) setup() {
+	p.once.Do(func() {
+		n := p.MaxDials
+		if n <= 0 {
+			n = DefaultMaxDials
+		}
+
+		p.sem = make(chan struct{}, n)
+		p.cs = make(map[peer.ID]*pooled)
+	})
+}
+
+// Dial returns a session for the peer, reusing a pooled session if one
+// is live.  The caller MUST release the session when finished.  The
+// session remains usable until the pool evicts or refreshes it, so
+// callers SHOULD NOT retain it across unrelated operations.
+func (p *Pool) Dial(ctx context.Context, addr peer.AddrInfo, protos ...protocol.ID) (auth.Session, error) {
+	p.setup()
+
+	for {
+		p.mu.Lock()
+		if p.closed {
+			p.mu.Unlock()
+			return auth.Session{}, ErrPoolClosed
+		}
+
+		c, ok := p.cs[addr.ID]
+		if !ok {
+			c = newPooled()
+			p.cs[addr.ID] = c
+			p.mu.Unlock()
+
+			return p.dial(ctx, addr, c, protos)
+		}
+		p.mu.Unlock()
+
+		select {
+		case <-c.ready:
+		case <-ctx.Done():
+			return auth.Session{}, ctx.Err()
+		}
+
+		if c.err != nil {
+			return auth.Session{}, c.err
+		}
+
+		p.mu.Lock()
+		live := p.cs[addr.ID] == c && time.Now().Before(c.expires)
+		if live {
+			sess := c.lease()
+			p.mu.Unlock()
+			return sess, nil
+		}
+		p.mu.Unlock()
+
+		p.evict(addr.ID, c) // expired; dial again
+	}
+}
+
+func (p *Pool) dial(ctx context.Context, addr peer.AddrInfo, c *pooled, protos []protocol.ID) (auth.Session, error) {
+	defer close(c.ready)
+
+	select {
+	case p.sem <- struct{}{}:
+		defer func() { <-p.sem }()
+	case <-ctx.Done():
+		c.err = ctx.Err()
+		p.evict(addr.ID, c)
+		return auth.Session{}, c.err
+	}
+
+	if c.conn, c.err = p.DialRPC(ctx, addr, protos...); c.err != nil {
+		p.evict(addr.ID, c)
+		return auth.Session{}, c.err
+	}
+
+	if c.sess, c.err = p.DialConn(ctx, c.conn); c.err != nil {
+		p.evict(addr.ID, c)
+		return auth.Session{}, c.err
+	}
+
+	ttl := p.TTL
+	if ttl <= 0 {
+		ttl = DefaultSessionTTL
+	}
+	c.expires = time.Now().Add(ttl)
+
+	// Evict the session as soon as the peer disconnects.
+	go func(done <-chan struct{}) {
+		<-done
+		p.evict(addr.ID, c)
+		c.close() // leased capabilities are broken
+	}(c.conn.Done())
+
+	return c.lease(), nil
+}
+
+// evict the pooled session, if it has not already been replaced, and
+// release the pool's reference to it.
+func (p *Pool) evict(id peer.ID, c *pooled) {
+	p.mu.Lock()
+	if p.cs[id] != c {
+		p.mu.Unlock()
+		return
+	}
+	delete(p.cs, id)
+	p.mu.Unlock()
+
+	c.unref()
+}
+
+// Close the pool, releasing its sessions and closing the underlying
+// connections, including those of sessions that are still leased.
+// Subsequent calls to Dial return ErrPoolClosed.
+func (p *Pool) Close() error {
+	p.setup()
+
+	p.mu.Lock()
+	cs := p.cs
+	p.cs = make(map[peer.ID]*pooled)
+	p.closed = true
+	p.mu.Unlock()
+
+	for _, c := range cs {
+		<-c.ready
+		c.close()
+	}
+
+	return nil
+}
+
+// lease a copy of the session.  Each of its capabilities holds a
+// reference to c, so that the connection outlives the pool's reference
+// for as long as the session is in use.  The pool's reference MUST be
+// held when calling lease.
+func (c *pooled) lease() auth.Session {
+	sess := c.sess.AddRef()
+
+	caps := core_api.Session(sess).Message().CapTable()
+	for i := 0; i < caps.Len(); i++ {
+		if client := caps.At(i); client.IsValid() {
+			c.refs.Add(1)
+			caps.Set(capnp.CapabilityID(i), capnp.NewClient(&leaseHook{
+				client:  client, // steal the reference
+				release: c.unref,
+			}))
+		}
+	}
+
+	return sess
+}
+
+func (c *pooled) unref() {
+	if c.refs.Add(-1) == 0 {
+		c.close()
+	}
+}
+
+func (c *pooled) close() {
+	c.once.Do(func() {
+		if c.sess != (auth.Session{}) {
+			c.sess.Release()
+		}
+
+		if c.conn != nil {
+			c.conn.Close()
+		}
+	})
+}
+
+// leaseHook forwards calls to a capability that depends on a shared
+// connection, and calls release when the last client is released.
+type leaseHook struct {
+	client  capnp.Client
+	release func()
+}
+
+func (h *leaseHook) Send(ctx context.Context, s capnp.Send) (*capnp.Answer, capnp.ReleaseFunc) {
+	return h.client.SendCall(ctx, s)
+}
+
+func (h *leaseHook) Recv(ctx context.Context, r capnp.Recv) capnp.PipelineCaller {
+	return h.client.RecvCall(ctx, r)
+}
+
+func (h *leaseHook) Brand() capnp.Brand {
+	return capnp.Brand{}
+}
+
+func (h *leaseHook) Shutdown() {
+	h.client.Release()
+	h.release()
+}
+
+func (h *leaseHook) String() string {
+	return h.client.String()
+}
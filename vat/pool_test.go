@@ -0,0 +1,229 @@
+package vat_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	inproc "github.com/lthibault/go-libp2p-inproc-transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cluster_api "github.com/wetware/pkg/api/cluster"
+	core_api "github.com/wetware/pkg/api/core"
+	"github.com/wetware/pkg/auth"
+	"github.com/wetware/pkg/cap/view"
+	"github.com/wetware/pkg/cluster/routing"
+	"github.com/wetware/pkg/util/proto"
+	"github.com/wetware/pkg/vat"
+)
+
+func TestPool(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	t.Run("Reuse", func(t *testing.T) {
+		term, pool, info := newPoolTest(t, 0)
+
+		for i := 0; i < 3; i++ {
+			sess, err := pool.Dial(ctx, info, proto.Namespace("test")...)
+			require.NoError(t, err, "should dial session")
+			sess.Release()
+		}
+
+		assert.Equal(t, int32(1), term.logins.Load(), "should log in once")
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		term, pool, info := newPoolTest(t, 0)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				sess, err := pool.Dial(ctx, info, proto.Namespace("test")...)
+				if assert.NoError(t, err, "should dial session") {
+					sess.Release()
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(1), term.logins.Load(),
+			"concurrent dials should share a login")
+	})
+
+	t.Run("Evict", func(t *testing.T) {
+		term, pool, info := newPoolTest(t, 0)
+
+		sess, err := pool.Dial(ctx, info, proto.Namespace("test")...)
+		require.NoError(t, err, "should dial session")
+		sess.Release()
+
+		term.CloseConns()
+
+		require.Eventually(t, func() bool {
+			sess, err := pool.Dial(ctx, info, proto.Namespace("test")...)
+			if err == nil {
+				sess.Release()
+			}
+
+			return term.logins.Load() == 2
+		}, time.Second*5, time.Millisecond*10, "should log in again after disconnect")
+	})
+
+	t.Run("Refresh", func(t *testing.T) {
+		term, pool, info := newPoolTest(t, time.Nanosecond)
+
+		for i := 0; i < 2; i++ {
+			sess, err := pool.Dial(ctx, info, proto.Namespace("test")...)
+			require.NoError(t, err, "should dial session")
+			sess.Release()
+		}
+
+		assert.Equal(t, int32(2), term.logins.Load(),
+			"should refresh expired session")
+	})
+
+	t.Run("RefreshLeased", func(t *testing.T) {
+		term, pool, info := newPoolTest(t, time.Nanosecond)
+
+		old, err := pool.Dial(ctx, info, proto.Namespace("test")...)
+		require.NoError(t, err, "should dial session")
+
+		sess, err := pool.Dial(ctx, info, proto.Namespace("test")...)
+		require.NoError(t, err, "should refresh session")
+		defer sess.Release()
+		require.Equal(t, int32(2), term.logins.Load(), "should log in again")
+
+		f, release := old.View().Lookup(ctx, view.NewQuery(view.All()))
+		defer release()
+
+		_, err = f.Await(ctx)
+		require.NoError(t, err, "session should survive refresh")
+
+		old.Release()
+
+		require.Eventually(t, func() bool {
+			select {
+			case <-term.Conn(0).Done():
+				return true
+			default:
+				return false
+			}
+		}, time.Second*5, time.Millisecond*10, "should close connection after last release")
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		_, pool, info := newPoolTest(t, 0)
+		require.NoError(t, pool.Close(), "should close pool")
+
+		_, err := pool.Dial(ctx, info, proto.Namespace("test")...)
+		assert.ErrorIs(t, err, vat.ErrPoolClosed)
+	})
+}
+
+func newPoolTest(t *testing.T, ttl time.Duration) (*terminal, *vat.Pool, peer.AddrInfo) {
+	t.Helper()
+
+	server, client := newInprocHost(t), newInprocHost(t)
+
+	term := new(terminal)
+	for _, id := range proto.Namespace("test") {
+		server.SetStreamHandler(id, term.handle)
+	}
+
+	pool := &vat.Pool{
+		Dialer: vat.Dialer{
+			Host:    client,
+			Account: auth.SignerFromHost(client),
+		},
+		TTL: ttl,
+	}
+	t.Cleanup(func() { pool.Close() })
+
+	return term, pool, *host.InfoFromHost(server)
+}
+
+func newInprocHost(t *testing.T) host.Host {
+	t.Helper()
+
+	h, err := libp2p.New(
+		libp2p.NoTransports,
+		libp2p.NoListenAddrs,
+		libp2p.Transport(inproc.New()),
+		libp2p.ListenAddrStrings("/inproc/~"))
+	require.NoError(t, err, "must create host")
+	t.Cleanup(func() { h.Close() })
+
+	return h
+}
+
+// terminal counts logins, and returns a session holding an empty view,
+// which doubles as the session's executor.
+type terminal struct {
+	logins atomic.Int32
+
+	mu    sync.Mutex
+	conns []*rpc.Conn
+}
+
+func (term *terminal) handle(s network.Stream) {
+	term.mu.Lock()
+	defer term.mu.Unlock()
+
+	term.conns = append(term.conns, rpc.NewConn(vat.Transport(s), &rpc.Options{
+		BootstrapClient: capnp.Client(core_api.Terminal_ServerToClient(term)),
+	}))
+}
+
+func (term *terminal) Conn(i int) *rpc.Conn {
+	term.mu.Lock()
+	defer term.mu.Unlock()
+
+	return term.conns[i]
+}
+
+func (term *terminal) CloseConns() {
+	term.mu.Lock()
+	defer term.mu.Unlock()
+
+	for _, conn := range term.conns {
+		conn.Close()
+	}
+}
+
+func (term *terminal) Login(ctx context.Context, call core_api.Terminal_login) error {
+	term.logins.Add(1)
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	sess, err := res.NewSession()
+	if err != nil {
+		return err
+	}
+
+	v := view.Server{RoutingTable: routing.New(time.Now())}.View()
+	if err = sess.SetView(cluster_api.View(v.AddRef())); err != nil {
+		return err
+	}
+
+	// Any capability will do as the executor, which is what sturdy
+	// references resolve to in TestDialer_Resolve.
+	return sess.SetExec(core_api.Executor(v))
+}
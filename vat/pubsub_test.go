@@ -0,0 +1,98 @@
+package vat_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	inproc "github.com/lthibault/go-libp2p-inproc-transport"
+	"github.com/stretchr/testify/require"
+
+	core_api "github.com/wetware/pkg/api/core"
+	"github.com/wetware/pkg/auth"
+	"github.com/wetware/pkg/cap/csp"
+	csp_server "github.com/wetware/pkg/cap/csp/server"
+	"github.com/wetware/pkg/vat"
+)
+
+// TestPubSub checks that a WASM guest can publish and subscribe to a
+// topic through its session.
+func TestPubSub(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping end-to-end test in short mode")
+	}
+
+	bytecode := buildWASM(t, "../test/wasm/pubsub")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	h, err := libp2p.New(
+		libp2p.NoTransports,
+		libp2p.NoListenAddrs,
+		libp2p.Transport(inproc.New()),
+		libp2p.ListenAddrStrings("/inproc/~"))
+	require.NoError(t, err)
+	defer h.Close()
+
+	ec := make(chan csp_server.Runtime, 1)
+	sc := make(chan core_api.Session, 1)
+	cherr := make(chan error, 1)
+	go func() {
+		cherr <- vat.Config{
+			NS:        "test",
+			Host:      h,
+			Bootstrap: nopDiscovery{},
+			Ambient:   nopDiscovery{},
+			Auth:      auth.AllowAll,
+		}.Serve(ctx, ec, sc, h)
+	}()
+
+	var sess core_api.Session
+	select {
+	case sess = <-sc:
+	case err := <-cherr:
+		t.Fatalf("serve: %v", err)
+	}
+	require.True(t, sess.HasPubSub(), "session should provide pubsub")
+	require.True(t, sess.HasRegistry(), "session should provide registry")
+	require.True(t, sess.HasHost(), "root session should provide host")
+	require.True(t, sess.HasScheduler(), "session should provide scheduler")
+
+	e := <-ec
+	exec := e.Executor()
+	defer exec.Release()
+
+	proc, release := exec.Exec(ctx, sess, bytecode, 0)
+	defer release()
+
+	err = csp.Proc(proc).Wait(ctx)
+	require.NoError(t, err, "guest should exit cleanly")
+
+	cancel()
+	require.ErrorIs(t, <-cherr, context.Canceled)
+}
+
+// buildWASM compiles the guest program in dir, and returns its bytecode.
+// The test fails if the guest does not build.  Use -short to skip tests
+// that require a wasip1 toolchain.
+func buildWASM(t *testing.T, dir string) []byte {
+	t.Helper()
+
+	out := filepath.Join(t.TempDir(), "guest.wasm")
+	cmd := exec.Command("go", "build", "-o", out, ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOOS=wasip1", "GOARCH=wasm", "CGO_ENABLED=0")
+	b, err := cmd.CombinedOutput()
+	require.NoError(t, err, "should build guest:\n%s", b)
+
+	bytecode, err := os.ReadFile(out)
+	require.NoError(t, err, "should read guest bytecode")
+	return bytecode
+}
@@ -16,13 +16,17 @@ import (
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/record"
+	anchor_api "github.com/wetware/pkg/api/anchor"
 	capstore_api "github.com/wetware/pkg/api/capstore"
 	cluster_api "github.com/wetware/pkg/api/cluster"
 	core_api "github.com/wetware/pkg/api/core"
+	pubsub_api "github.com/wetware/pkg/api/pubsub"
+	registry_api "github.com/wetware/pkg/api/registry"
 	"github.com/wetware/pkg/auth"
 	"github.com/wetware/pkg/cap/anchor"
 	"github.com/wetware/pkg/cap/capstore"
 	"github.com/wetware/pkg/cap/csp"
+	"github.com/wetware/pkg/cap/host"
 	"github.com/wetware/pkg/cap/pubsub"
 	service "github.com/wetware/pkg/cap/registry"
 	"github.com/wetware/pkg/cap/view"
@@ -35,7 +39,7 @@ type ViewProvider interface {
 }
 
 type PubSubProvider interface {
-	PubSub() pubsub.Router
+	Router(account peer.ID) pubsub.Router
 }
 
 type AnchorProvider interface {
@@ -46,23 +50,36 @@ type RegistryProvider interface {
 	Registry() service.Registry
 }
 
+type SchedulerProvider interface {
+	Scheduler() csp.Scheduler
+}
+
+type HostProvider interface {
+	Host() host.Host
+}
+
 type ExecutorProvider interface {
 	Executor() csp.Executor
 }
 
 type CapStoreProvider interface {
-	CapStore() capstore.CapStore
+	CapStore(owner peer.ID) capstore.CapStore
 }
 
 // Server provides the Host capability.
 type Server struct {
-	NS               string
-	Host             local.Host
-	Auth             auth.Policy
-	ViewProvider     ViewProvider
-	ExecutorProvider ExecutorProvider
-	CapStoreProvider CapStoreProvider
-	Extra            map[string]capnp.Client
+	NS                string
+	Host              local.Host
+	Auth              auth.Policy
+	ViewProvider      ViewProvider
+	ExecutorProvider  ExecutorProvider
+	CapStoreProvider  CapStoreProvider
+	AnchorProvider    AnchorProvider
+	PubSubProvider    PubSubProvider
+	RegistryProvider  RegistryProvider
+	HostProvider      HostProvider
+	SchedulerProvider SchedulerProvider
+	Extra             map[string]capnp.Client
 
 	once sync.Once
 	ch   chan network.Stream
@@ -92,7 +109,14 @@ func (svr *Server) Export() capnp.Client {
 	return capnp.NewClient(core_api.Terminal_NewServer(svr))
 }
 
+// NewRootSession returns a session for the host's own account.
 func (svr *Server) NewRootSession() (core_api.Session, error) {
+	return svr.NewSession(svr.Host.ID())
+}
+
+// NewSession returns a session for the supplied account.  Account-
+// scoped capabilities, such as the CapStore, are bound to the account.
+func (svr *Server) NewSession(account peer.ID) (core_api.Session, error) {
 	_, seg := capnp.NewSingleSegmentMessage(nil)
 	sess, err := core_api.NewRootSession(seg) // TODO(optimization):  non-root?
 	if err != nil {
@@ -117,7 +141,12 @@ func (svr *Server) NewRootSession() (core_api.Session, error) {
 		// Capabilities
 		svr.BindView(sess),
 		svr.BindExec(sess),
-		svr.BindCapStore(sess),
+		svr.BindCapStore(sess, account),
+		svr.BindPubSub(sess, account),
+		svr.BindAnchor(sess),
+		svr.BindRegistry(sess),
+		svr.BindHost(sess, account),
+		svr.BindScheduler(sess),
 		svr.BindExtra(sess),
 	)
 
@@ -138,7 +167,7 @@ func (svr *Server) Login(ctx context.Context, call core_api.Terminal_login) erro
 		return fmt.Errorf("auth: %w", err)
 	}
 
-	root, err := svr.NewRootSession()
+	root, err := svr.NewSession(account)
 	if err != nil {
 		return err
 	}
@@ -208,11 +237,100 @@ func (svr *Server) BindExec(sess core_api.Session) error {
 	return sess.SetExec(core_api.Executor(exec))
 }
 
-func (svr *Server) BindCapStore(sess core_api.Session) error {
-	store := svr.CapStoreProvider.CapStore()
+func (svr *Server) BindCapStore(sess core_api.Session, account peer.ID) error {
+	store := svr.CapStoreProvider.CapStore(account)
 	return sess.SetCapStore(capstore_api.CapStore(store))
 }
 
+// BindPubSub binds the account's pubsub router to the session.  The
+// session's pubsub field is left null if the server has no PubSubProvider.
+func (svr *Server) BindPubSub(sess core_api.Session, account peer.ID) error {
+	if svr.PubSubProvider == nil {
+		return nil
+	}
+
+	router := svr.PubSubProvider.Router(account)
+	return sess.SetPubSub(pubsub_api.Router(router))
+}
+
+// BindAnchor binds the root of the host's anchor tree to the session.
+// The session's anchor field is left null if the server has no
+// AnchorProvider.
+func (svr *Server) BindAnchor(sess core_api.Session) error {
+	if svr.AnchorProvider == nil {
+		return nil
+	}
+
+	root := svr.AnchorProvider.Anchor()
+	return sess.SetAnchor(anchor_api.Anchor(root))
+}
+
+// BindRegistry binds the service registry to the session.  The session's
+// registry field is left null if the server has no RegistryProvider.
+func (svr *Server) BindRegistry(sess core_api.Session) error {
+	if svr.RegistryProvider == nil {
+		return nil
+	}
+
+	registry := svr.RegistryProvider.Registry()
+	return sess.SetRegistry(registry_api.Registry(registry))
+}
+
+// BindHost binds the host capability to the session, which allows the
+// host's heartbeat metadata to be administered.  It is only bound to
+// sessions for the host's own account, and is left null if the server
+// has no HostProvider.
+func (svr *Server) BindHost(sess core_api.Session, account peer.ID) error {
+	if svr.HostProvider == nil || account != svr.Host.ID() {
+		return nil
+	}
+
+	h := svr.HostProvider.Host()
+	return sess.SetHost(cluster_api.Host(h))
+}
+
+// BindScheduler binds the cluster scheduler to the session.  The
+// session's scheduler field is left null if the server has no
+// SchedulerProvider.
+func (svr *Server) BindScheduler(sess core_api.Session) error {
+	if svr.SchedulerProvider == nil {
+		return nil
+	}
+
+	sched := svr.SchedulerProvider.Scheduler()
+	return sess.SetScheduler(core_api.Scheduler(sched))
+}
+
+// Restore a host-local capability from its sturdy reference.  This
+// allows anchors and executors to be persisted in the CapStore.
+func (svr *Server) Restore(ctx context.Context, ref capstore_api.SturdyRef) (capnp.Client, error) {
+	switch ref.Which() {
+	case capstore_api.SturdyRef_Which_executor:
+		exec := svr.ExecutorProvider.Executor()
+		return capnp.Client(exec), nil
+
+	case capstore_api.SturdyRef_Which_anchor:
+		if svr.AnchorProvider == nil {
+			return capnp.Client{}, errors.New("anchors not supported")
+		}
+
+		path, err := ref.Anchor()
+		if err != nil {
+			return capnp.Client{}, err
+		}
+
+		root := svr.AnchorProvider.Anchor()
+		defer root.Release()
+
+		a, release := root.Walk(ctx, path)
+		defer release()
+
+		return capnp.Client(a).AddRef(), nil
+	}
+
+	return capnp.Client{}, fmt.Errorf("unknown sturdy ref: %s", ref.Which())
+}
+
 func (svr *Server) BindExtra(sess core_api.Session) error {
 	size := len(svr.Extra)
 	extra, err := sess.NewExtra(int32(size))
@@ -2,6 +2,7 @@ package vat
 
 import (
 	"context"
+	"os"
 	"strings"
 
 	"capnproto.org/go/capnp/v3/rpc"
@@ -10,6 +11,7 @@ import (
 	"github.com/libp2p/go-libp2p-kad-dht/dual"
 	local "github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/pnet"
 	quic "github.com/libp2p/go-libp2p/p2p/transport/quic"
 	tcp "github.com/libp2p/go-libp2p/p2p/transport/tcp"
 	"github.com/wetware/pkg/util/proto"
@@ -24,8 +26,8 @@ func DefaultDialOpts(opt ...p2p.Option) []p2p.Option {
 		opt...)
 }
 
-func DialP2P() (local.Host, error) {
-	return p2p.New(DefaultDialOpts()...)
+func DialP2P(opt ...p2p.Option) (local.Host, error) {
+	return p2p.New(DefaultDialOpts(opt...)...)
 }
 
 func DefaultListenOpts(opt ...p2p.Option) []p2p.Option {
@@ -40,6 +42,36 @@ func ListenP2P(listen ...string) (local.Host, error) {
 	return p2p.New(DefaultListenOpts(p2p.ListenAddrStrings(listen...))...)
 }
 
+// PrivateNetwork restricts the host to peers holding the pre-shared
+// key.  QUIC does not support private networks, so the host's only
+// transport is TCP.  If psk is empty, PrivateNetwork is a nop.
+func PrivateNetwork(psk pnet.PSK) p2p.Option {
+	if len(psk) == 0 {
+		return func(*p2p.Config) error { return nil }
+	}
+
+	return p2p.ChainOptions(
+		p2p.NoTransports,
+		p2p.Transport(tcp.NewTCPTransport),
+		p2p.PrivateNetwork(psk))
+}
+
+// LoadPSK reads a pre-shared key in the libp2p swarm.key format from
+// the file at path.  It returns nil if path is empty.
+func LoadPSK(path string) (pnet.PSK, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return pnet.DecodeV1PSK(f)
+}
+
 func NewDHT(ctx context.Context, h local.Host, ns string) (*dual.DHT, error) {
 	return dual.New(ctx, h,
 		dual.LanDHTOption(lanOpt(ns)...),
@@ -0,0 +1,61 @@
+package vat_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/pnet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/pkg/vat"
+)
+
+func TestPrivateNetwork(t *testing.T) {
+	t.Parallel()
+
+	var (
+		psk   = pnet.PSK(bytes.Repeat([]byte{1}, 32))
+		other = pnet.PSK(bytes.Repeat([]byte{2}, 32))
+	)
+
+	newHost := func(psk pnet.PSK) host.Host {
+		h, err := libp2p.New(vat.DefaultListenOpts(
+			libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"),
+			vat.PrivateNetwork(psk))...)
+		require.NoError(t, err, "must create host")
+		t.Cleanup(func() { h.Close() })
+		return h
+	}
+
+	var (
+		server = newHost(psk)
+		member = newHost(psk)
+		rogue  = newHost(other)
+		public = newHost(nil)
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	info := peer.AddrInfo{ID: server.ID(), Addrs: server.Addrs()}
+	assert.NoError(t, member.Connect(ctx, info), "member should connect")
+	assert.Error(t, rogue.Connect(ctx, info), "host with other PSK should not connect")
+	assert.Error(t, public.Connect(ctx, info), "public host should not connect")
+}
+
+func TestLoadPSK(t *testing.T) {
+	t.Parallel()
+
+	psk, err := vat.LoadPSK("")
+	require.NoError(t, err, "should not fail without path")
+	assert.Nil(t, psk, "should not load PSK without path")
+
+	_, err = vat.LoadPSK(t.TempDir() + "/missing")
+	assert.Error(t, err, "should fail to load missing file")
+}
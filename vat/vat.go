@@ -5,16 +5,18 @@ import (
 	"fmt"
 	"log/slog"
 	"runtime"
-	"sync"
 	"time"
 
 	"capnproto.org/go/capnp/v3"
 	"capnproto.org/go/capnp/v3/rpc"
+	ds "github.com/ipfs/go-datastore"
 	gossipsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/discovery"
 	local "github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+	"github.com/libp2p/go-libp2p/core/pnet"
 	"github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/pkg/errors"
 	"github.com/tetratelabs/wazero"
@@ -23,8 +25,12 @@ import (
 	core_api "github.com/wetware/pkg/api/core"
 	"github.com/wetware/pkg/auth"
 	"github.com/wetware/pkg/boot"
+	"github.com/wetware/pkg/cap/anchor"
 	capstore_server "github.com/wetware/pkg/cap/capstore/server"
 	csp_server "github.com/wetware/pkg/cap/csp/server"
+	"github.com/wetware/pkg/cap/host"
+	"github.com/wetware/pkg/cap/pubsub"
+	service "github.com/wetware/pkg/cap/registry"
 	"github.com/wetware/pkg/cluster"
 	"github.com/wetware/pkg/cluster/pulse"
 	"github.com/wetware/pkg/cluster/routing"
@@ -34,6 +40,10 @@ import (
 
 var _ rpc.Network = (*Server)(nil)
 
+// DefaultShutdownTimeout is the default time that Serve waits for
+// running processes to exit before killing them.
+const DefaultShutdownTimeout = time.Second * 15
+
 type Config struct {
 	NS                 string
 	Host               local.Host
@@ -42,22 +52,73 @@ type Config struct {
 	Auth               auth.Policy
 	OnJoin             func(auth.Session)
 	RuntimeConfig      wazero.RuntimeConfig
+
+	// PSK is the cluster's pre-shared key.  If set, heartbeats from
+	// peers that do not hold the key are rejected.  The host SHOULD be
+	// constructed with the PrivateNetwork option for the same key, and
+	// the bootstrap service with socket.WithSecret.
+	PSK pnet.PSK
+
+	// MaxProcs is the maximum number of processes that the executor
+	// runs concurrently.  If zero, the number is unlimited.
+	MaxProcs uint32
+
+	// TopicACL determines the rights each account has over pubsub
+	// topics.  If nil, accounts can publish and subscribe to any topic,
+	// and the first account to join a topic may install its validator.
+	TopicACL pubsub.ACL
+
+	// TopicRetention selects the pubsub topics that retain history, so
+	// that late subscribers can replay earlier messages.  If nil, no
+	// history is retained.
+	TopicRetention pubsub.RetentionPolicy
+
+	// Datastore persists host state, such as CapStore entries, across
+	// restarts.  If nil, state is held in memory.
+	Datastore ds.Datastore
+
+	// Root of the host's anchor tree.  It is exported through each
+	// session, and anchor sturdy refs are restored against it.  If nil,
+	// a new tree is created.
+	Root *anchor.Node
+
+	// ShutdownTimeout bounds the graceful shutdown that begins when the
+	// context passed to Serve expires.  Processes that are still running
+	// when it elapses are killed.  If zero, DefaultShutdownTimeout is
+	// used.
+	ShutdownTimeout time.Duration
 }
 
+// Serve the host until ctx expires, then shut it down gracefully.  The
+// host stops accepting connections, announces that it is leaving the
+// cluster, and waits for running processes to exit.  See ShutdownTimeout.
 func (conf Config) Serve(ctx context.Context, ec chan csp_server.Runtime, sc chan core_api.Session, h local.Host) error {
-	ctx, cancel := context.WithCancel(ctx)
+	return conf.serve(ctx, h, func(s core_api.Session, e csp_server.Runtime, _ *cluster.Router) {
+		sc <- s
+		ec <- e
+	})
+}
+
+// serve the host until ctx expires.  The ready callback is invoked
+// with the host's root session, executor and router once the host has
+// joined the cluster.
+func (conf Config) serve(ctx context.Context, h local.Host, ready func(core_api.Session, csp_server.Runtime, *cluster.Router)) error {
+	// Services outlive ctx, so that they remain available during the
+	// shutdown.  They are stopped when Serve returns.
+	run, cancel := context.WithCancel(context.WithoutCancel(ctx))
 	defer cancel()
 
-	ps, err := conf.NewPubSub(ctx)
+	ps, err := conf.NewPubSub(run)
 	if err != nil {
 		return err
 	}
 
 	rt := routing.New(time.Now())
+	secret := pulse.NewSecret(conf.PSK, conf.NS)
 
 	err = ps.RegisterTopicValidator(
 		conf.NS,
-		pulse.NewValidator(rt))
+		pulse.NewValidator(rt, pulse.WithSecret(secret)))
 	if err != nil {
 		return err
 	}
@@ -68,33 +129,76 @@ func (conf Config) Serve(ctx context.Context, ec chan csp_server.Runtime, sc cha
 		return err
 	}
 
+	e, err := conf.NewExecutor(run, h)
+	if err != nil {
+		return err
+	}
+
+	// Metadata set through the host capability is added after the
+	// static metadata, and is announced as soon as it changes.
+	hostSrv := &host.Server{Log: slog.Default()}
+
 	r := &cluster.Router{
 		Topic:        t,
-		Meta:         conf.Meta,
+		Meta:         pulse.Chain(conf.Meta, hostSrv, pulse.SysInfo{Workload: e}, secret.Preparer(h.ID())),
 		RoutingTable: rt,
 	}
 	defer r.Close()
 
-	e, err := conf.NewExecutor(ctx, h)
-	if err != nil {
-		return err
+	hostSrv.Announcer = r
+
+	store := &capstore_server.CapStore{
+		Logger:    slog.Default(),
+		Datastore: conf.Datastore,
 	}
+	defer store.Close()
 
-	server := &Server{
-		NS:               conf.NS,
-		Host:             conf.Host,
-		Auth:             conf.Auth,
-		ViewProvider:     r,
-		ExecutorProvider: e,
-		CapStoreProvider: &capstore_server.CapStore{
-			Map:    &sync.Map{},
-			Logger: slog.Default(),
+	root := conf.Root
+	if root == nil {
+		root = new(anchor.Node)
+	}
+
+	topics := &pubsub.Server{
+		Log:         slog.Default(),
+		TopicJoiner: ps,
+		NS:          conf.NS, // isolate guests from the heartbeat topic
+		ACL:         conf.TopicACL,
+		Retention:   conf.TopicRetention,
+	}
+	defer topics.Close()
+
+	// The scheduler places processes on the local executor directly,
+	// and dials remote hosts to reach theirs.
+	sched := csp_server.Scheduler{
+		Log:          slog.Default(),
+		NS:           conf.NS,
+		ViewProvider: r,
+		Executor:     e.Executor(),
+		Dialer: Dialer{
+			Host:    h,
+			Account: auth.SignerFromHost(h),
+			NS:      conf.NS,
 		},
-		// PubSubProvider: &pubsub.Server{TopicJoiner: ps},
-		// 	WithCloseOnContextDone(true),
+	}
+	defer sched.Executor.Release()
+
+	server := &Server{
+		NS:                conf.NS,
+		Host:              conf.Host,
+		Auth:              conf.Auth,
+		ViewProvider:      r,
+		ExecutorProvider:  e,
+		CapStoreProvider:  store,
+		AnchorProvider:    root,
+		PubSubProvider:    topics,
+		RegistryProvider:  &service.Server{Log: slog.Default()},
+		HostProvider:      hostSrv,
+		SchedulerProvider: sched,
 	}
 	defer server.Close()
 
+	store.Restorer = server
+
 	release, err := server.Join(ctx, r, server)
 	if err != nil {
 		return err
@@ -110,10 +214,18 @@ func (conf Config) Serve(ctx context.Context, ec chan csp_server.Runtime, sc cha
 	}
 
 	logger.Info("wetware started")
-	defer logger.Warn("wetware started")
 
-	sc <- s
-	ec <- e
+	ready(s, e, r)
+	err = accept(ctx, server, logger)
+
+	logger.Info("wetware stopping")
+	conf.shutdown(logger, release, r, e)
+	logger.Info("wetware stopped")
+
+	return err
+}
+
+func accept(ctx context.Context, server *Server, logger system.ErrorReporter) error {
 	for {
 		opts := &rpc.Options{
 			BootstrapClient: server.Export(),
@@ -131,6 +243,31 @@ func (conf Config) Serve(ctx context.Context, ec chan csp_server.Runtime, sc cha
 	}
 }
 
+// shutdown the host.  It stops accepting connections, announces that
+// the host is leaving, and drains the executor.  The announcement is
+// best-effort; peers that miss it evict the host when its TTL expires.
+func (conf Config) shutdown(logger system.ErrorReporter, release capnp.ReleaseFunc, r *cluster.Router, e csp_server.Runtime) {
+	timeout := conf.ShutdownTimeout
+	if timeout <= 0 {
+		timeout = DefaultShutdownTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	release() // remove stream handlers
+
+	if err := r.Leave(ctx); err != nil {
+		logger.Warn("failed to announce departure",
+			"error", err)
+	}
+
+	if err := e.Drain(ctx); err != nil {
+		logger.Warn("killed running processes",
+			"error", err)
+	}
+}
+
 func (conf Config) NewExecutor(ctx context.Context, h local.Host) (csp_server.Runtime, error) {
 	if conf.RuntimeConfig == nil {
 		if runtime.GOARCH == "amd64" || runtime.GOARCH == "arm64" {
@@ -152,11 +289,19 @@ func (conf Config) NewExecutor(ctx context.Context, h local.Host) (csp_server.Ru
 		return csp_server.Runtime{}, err
 	}
 
+	// Guests that dial the same peer repeatedly share a session.
+	pool := &Pool{Dialer: Dialer{
+		Host:    h,
+		Account: auth.SignerFromHost(h),
+	}}
+	context.AfterFunc(ctx, func() { pool.Close() })
+
 	return csp_server.Runtime{
-		Runtime: r,
-		Cache:   make(csp_server.BytecodeCache),
-		Tree:    csp_server.NewProcTree(ctx),
-		Log:     slog.Default(),
+		Runtime:  r,
+		Cache:    make(csp_server.BytecodeCache),
+		Tree:     csp_server.NewProcTree(ctx),
+		Log:      slog.Default(),
+		MaxProcs: conf.MaxProcs,
 		PeerDial: func(ctx context.Context, call core_api.Executor_dialPeer) error {
 			res, err := call.AllocResults()
 			if err != nil {
@@ -176,17 +321,15 @@ func (conf Config) NewExecutor(ctx context.Context, h local.Host) (csp_server.Ru
 				res.SetSelf(true)
 				return nil
 			}
-			d := Dialer{
-				Host:    h,
-				Account: auth.SignerFromHost(h),
-			}
-			sess, err := d.Dial(
+			sess, err := pool.Dial(
 				ctx,
 				h.Peerstore().PeerInfo(id),
 				proto.Namespace("ww")...)
 			if err != nil {
 				return err
 			}
+			defer sess.Release()
+
 			res.SetSelf(false)
 			return res.SetSession(core_api.Session(sess))
 		},
@@ -232,6 +375,8 @@ func (svr *Server) Join(ctx context.Context, r *cluster.Router, server *Server)
 }
 
 func (svr *Server) bind(ctx context.Context) capnp.ReleaseFunc {
+	svr.setup()
+
 	for _, id := range proto.Namespace(svr.NS) {
 		svr.Host.SetStreamHandler(id, svr.handler(ctx))
 	}
@@ -272,6 +417,8 @@ func (svr *Server) Dial(pid rpc.PeerID, opt *rpc.Options) (*rpc.Conn, error) {
 	peer := pid.Value.(peer.AddrInfo)
 	protos := proto.Namespace(svr.NS)
 
+	svr.Host.Peerstore().AddAddrs(peer.ID, peer.Addrs, peerstore.TempAddrTTL)
+
 	s, err := svr.Host.NewStream(ctx, peer.ID, protos...)
 	if err != nil {
 		return nil, err
@@ -307,6 +454,10 @@ func (svr *Server) Accept(ctx context.Context, opt *rpc.Options) (*rpc.Conn, err
 	}
 }
 
+// Third-party handoff is not supported by the capnp RPC layer, which
+// proxies capabilities through the introducer instead.  The methods
+// below satisfy rpc.Network until it is.
+
 // Introduce the two connections, in preparation for a third party
 // handoff. Afterwards, a Provide messsage should be sent to
 // provider, and a ThirdPartyCapId should be sent to recipient.
@@ -10,10 +10,12 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/peerstore"
 	inproc "github.com/lthibault/go-libp2p-inproc-transport"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	core_api "github.com/wetware/pkg/api/core"
 	"github.com/wetware/pkg/auth"
 	csp_server "github.com/wetware/pkg/cap/csp/server"
+	"github.com/wetware/pkg/util/proto"
 	"github.com/wetware/pkg/vat"
 )
 
@@ -53,6 +55,63 @@ func TestServer(t *testing.T) {
 	require.ErrorIs(t, err, context.Canceled)
 }
 
+func TestServe_shutdown(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	h, err := libp2p.New(
+		libp2p.NoTransports,
+		libp2p.NoListenAddrs,
+		libp2p.Transport(inproc.New()),
+		libp2p.ListenAddrStrings("/inproc/~"))
+	require.NoError(t, err)
+	defer h.Close()
+
+	ec := make(chan csp_server.Runtime, 1)
+	sc := make(chan core_api.Session, 1)
+	cherr := make(chan error, 1)
+	go func() {
+		cherr <- vat.Config{
+			NS:              "test",
+			Host:            h,
+			Bootstrap:       nopDiscovery{},
+			Ambient:         nopDiscovery{},
+			Auth:            auth.AllowAll,
+			ShutdownTimeout: time.Second,
+		}.Serve(ctx, ec, sc, h)
+	}()
+
+	sess := <-sc
+	defer auth.Session(sess).Release()
+	e := <-ec
+
+	cancel()
+
+	select {
+	case err := <-cherr:
+		require.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second * 5):
+		t.Fatal("Serve did not return after shutdown")
+	}
+
+	for _, id := range proto.Namespace("test") {
+		assert.NotContains(t, h.Mux().Protocols(), id,
+			"should stop accepting connections")
+	}
+
+	exec := e.Executor()
+	defer exec.Release()
+
+	proc, release := exec.Exec(context.Background(), core_api.Session{}, []byte("bytecode"), 0)
+	defer release()
+
+	err = proc.Wait(context.Background())
+	require.Error(t, err, "should refuse processes after shutdown")
+	assert.Contains(t, err.Error(), csp_server.ErrDraining.Error())
+}
+
 type nopDiscovery struct{}
 
 func (nopDiscovery) Advertise(context.Context, string, ...discovery.Option) (time.Duration, error) {